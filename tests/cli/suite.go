@@ -103,3 +103,23 @@ func (s *E2ESuite) RunTransaction(tx TestTransaction) {
 		assert.Equal(s.T, int(tx.ExpectedErr.ABCICode()), int(resp.Code), tx.Name)
 	}
 }
+
+// GenerateTransaction runs a test transaction with the generate-only flag and any additional
+// flags, and returns the resulting unsigned transaction without broadcasting it.
+func (s *E2ESuite) GenerateTransaction(tx TestTransaction, extraFlags ...string) sdk.Tx {
+	clientCtx := s.Network.Validators[0].ClientCtx
+	txFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.Network.Validators[0].Address),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+		fmt.Sprintf("--%s=%s", flags.FlagGas, "10000000"),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, "1000000uumee"),
+	}
+	txFlags = append(txFlags, extraFlags...)
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, tx.Command, append(tx.Args, txFlags...))
+	assert.NilError(s.T, err, tx.Name)
+
+	generated, err := clientCtx.TxConfig.TxJSONDecoder()(out.Bytes())
+	assert.NilError(s.T, err, tx.Name)
+	return generated
+}
@@ -1,8 +1,19 @@
 package cli
 
 import (
+	"fmt"
+	"os"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+)
+
+// Flag constants
+const (
+	FlagBinary     = "binary"
+	FlagOutputFile = "output-file"
 )
 
 // PrintOrErr formats and print proto message to the standard output, unless the error
@@ -13,3 +24,42 @@ func PrintOrErr(resp proto.Message, err error, cctx client.Context) error {
 	}
 	return cctx.PrintProto(resp)
 }
+
+// AddBinaryOutputFlags adds the --binary and --output-file flags to a query command.
+// They must be used together with PrintCmdOrErr.
+func AddBinaryOutputFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(FlagBinary, false,
+		"Write the response as raw protobuf bytes instead of text (mutually exclusive with --output)")
+	cmd.Flags().String(FlagOutputFile, "",
+		"Write the protobuf response to this file instead of stdout (requires --binary)")
+}
+
+// PrintCmdOrErr behaves like PrintOrErr, unless the --binary flag of the command is set.
+// In that case the marshaled proto message is written to the standard output, or to the
+// --output-file if one is given.
+func PrintCmdOrErr(cmd *cobra.Command, resp proto.Message, err error, cctx client.Context) error {
+	if err != nil {
+		return err
+	}
+	binary, _ := cmd.Flags().GetBool(FlagBinary)
+	outputFile, _ := cmd.Flags().GetString(FlagOutputFile)
+	if !binary {
+		if outputFile != "" {
+			return fmt.Errorf("--%s requires --%s", FlagOutputFile, FlagBinary)
+		}
+		return cctx.PrintProto(resp)
+	}
+	if cmd.Flags().Changed(tmcli.OutputFlag) {
+		return fmt.Errorf("--%s and --%s are mutually exclusive", FlagBinary, tmcli.OutputFlag)
+	}
+
+	bz, err := proto.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	if outputFile != "" {
+		return os.WriteFile(outputFile, bz, 0o600)
+	}
+	_, err = cmd.OutOrStdout().Write(bz)
+	return err
+}
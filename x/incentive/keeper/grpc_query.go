@@ -8,7 +8,6 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/umee-network/umee/v5/x/incentive"
-	leveragetypes "github.com/umee-network/umee/v5/x/leverage/types"
 )
 
 var _ incentive.QueryServer = Querier{}
@@ -264,34 +263,13 @@ func (q Querier) ActualRates(
 
 	k, ctx := q.Keeper, sdk.UnwrapSDKContext(goCtx)
 
-	// extimate the annual rewards a reference amount of bonded uTokens would earn in a year
-	referenceUToken, rewards, err := k.calculateReferenceAPY(ctx, req.UToken)
-	if err != nil {
-		return nil, err
-	}
-
-	// compute oracle price ratio of rewards to reference bond amount
-	referenceToken, err := k.leverageKeeper.ExchangeUToken(ctx, referenceUToken)
+	apy, err := k.calculateActualAPY(ctx, req.UToken)
 	if err != nil {
 		return nil, err
 	}
 
-	referenceBondValue, err := k.leverageKeeper.TotalTokenValue(
-		ctx, sdk.NewCoins(referenceToken), leveragetypes.PriceModeSpot,
-	)
-	if err != nil {
-		return nil, err
-	}
-	referenceRewardValue, err := k.leverageKeeper.TotalTokenValue(ctx, rewards, leveragetypes.PriceModeSpot)
-	if err != nil {
-		return nil, err
-	}
-	if referenceBondValue.IsZero() {
-		return nil, leveragetypes.ErrInvalidOraclePrice.Wrap(referenceToken.Denom)
-	}
-
 	return &incentive.QueryActualRatesResponse{
-		APY: referenceRewardValue.Quo(referenceBondValue),
+		APY: apy,
 	}, nil
 }
 
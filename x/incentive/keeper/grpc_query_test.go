@@ -9,6 +9,7 @@ import (
 	"github.com/umee-network/umee/v5/util/coin"
 	"github.com/umee-network/umee/v5/x/incentive"
 	"github.com/umee-network/umee/v5/x/leverage/fixtures"
+	leveragetypes "github.com/umee-network/umee/v5/x/leverage/types"
 )
 
 func TestAPYQuery(t *testing.T) {
@@ -114,4 +115,46 @@ func TestAPYQuery(t *testing.T) {
 	resp8, err := q.ActualRates(k.ctx, &req8)
 	require.NoError(t, err)
 	require.Equal(t, expect8, resp8, "multi-token USD rates for bonded umee")
+
+	// the leverage module sees the same rates through bond hooks
+	require.Equal(t, expect8.APY, k.BondHooks().IncentiveAPY(k.ctx, u_umee))
+	require.Equal(t, sdk.ZeroDec(), k.BondHooks().IncentiveAPY(k.ctx, u_atom))
+}
+
+func TestIncentiveEligibilityHook(t *testing.T) {
+	k := newTestKeeper(t)
+	k.initCommunityFund(
+		coin.New(umee, 1000_000000),
+	)
+
+	// no programs means no eligibility entries
+	alice := k.newBondedAccount(
+		coin.New(u_umee, 30_000000),
+	)
+	require.Empty(t, k.BondHooks().IncentiveEligibility(k.ctx, alice))
+
+	// two accounts share a program which pays 10 UMEE per half year to bonded u/umee
+	bob := k.newBondedAccount(
+		coin.New(u_umee, 70_000000),
+	)
+	k.addIncentiveProgram(u_umee, 100, 15778800, sdk.NewInt64Coin(umee, 10_000000), true)
+	k.advanceTimeTo(100)
+
+	expected := []leveragetypes.IncentiveEligibility{{
+		ProgramId:  1,
+		BondDenom:  u_umee,
+		Bonded:     coin.New(u_umee, 30_000000),
+		RewardRate: coin.New(umee, 6_000000), // 30% of 20 UMEE per year
+	}}
+	require.Equal(t, expected, k.BondHooks().IncentiveEligibility(k.ctx, alice))
+
+	expected[0].Bonded = coin.New(u_umee, 70_000000)
+	expected[0].RewardRate = coin.New(umee, 14_000000) // 70% of 20 UMEE per year
+	require.Equal(t, expected, k.BondHooks().IncentiveEligibility(k.ctx, bob))
+
+	// an account with nothing bonded earns nothing
+	carol := k.newAccount()
+	expected[0].Bonded = coin.Zero(u_umee)
+	expected[0].RewardRate = coin.Zero(umee)
+	require.Equal(t, expected, k.BondHooks().IncentiveEligibility(k.ctx, carol))
 }
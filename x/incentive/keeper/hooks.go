@@ -4,6 +4,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/umee-network/umee/v5/x/incentive"
 	leveragetypes "github.com/umee-network/umee/v5/x/leverage/types"
 )
 
@@ -37,3 +38,50 @@ func (h BondHooks) ForceUnbondTo(ctx sdk.Context, addr sdk.AccAddress, uToken sd
 	}
 	return h.k.reduceBondTo(ctx, addr, uToken)
 }
+
+// PendingRewards calculates the rewards pending for an account's bonded uTokens of a given denom,
+// without claiming them or updating its reward tracker.
+func (h BondHooks) PendingRewards(ctx sdk.Context, addr sdk.AccAddress, uDenom string) sdk.Coins {
+	return h.k.calculateSingleReward(ctx, addr, uDenom)
+}
+
+// IncentiveAPY estimates the annual incentive rewards earned by bonded uTokens of a given denom, as a
+// fraction of their value, assuming current incentive programs continue. Returns zero if the rewards
+// cannot be valued.
+func (h BondHooks) IncentiveAPY(ctx sdk.Context, uDenom string) sdk.Dec {
+	apy, err := h.k.calculateActualAPY(ctx, uDenom)
+	if err != nil {
+		return sdk.ZeroDec()
+	}
+	return apy
+}
+
+// IncentiveEligibility returns, for each ongoing incentive program, an account's bonded amount of the
+// program's uToken and the estimated annual rewards it earns from that program at current rates.
+func (h BondHooks) IncentiveEligibility(ctx sdk.Context, addr sdk.AccAddress) []leveragetypes.IncentiveEligibility {
+	programs, err := h.k.getAllIncentivePrograms(ctx, incentive.ProgramStatusOngoing)
+	if err != nil {
+		return []leveragetypes.IncentiveEligibility{}
+	}
+
+	result := make([]leveragetypes.IncentiveEligibility, 0, len(programs))
+	for _, p := range programs {
+		bonded := h.k.GetBonded(ctx, addr, p.UToken)
+		totalBonded := h.k.getTotalBonded(ctx, p.UToken)
+		rate := sdk.NewCoin(p.TotalRewards.Denom, sdk.ZeroInt())
+		if totalBonded.IsPositive() && p.Duration > 0 {
+			// seconds per year / duration = programsPerYear (assuming incentives stay constant),
+			// of which the account earns its share of the total bonded amount
+			programsPerYear := secondsPerYear.Quo(sdk.NewDec(p.Duration))
+			share := sdk.NewDecFromInt(bonded.Amount).QuoInt(totalBonded.Amount)
+			rate.Amount = programsPerYear.Mul(share).MulInt(p.TotalRewards.Amount).TruncateInt()
+		}
+		result = append(result, leveragetypes.IncentiveEligibility{
+			ProgramId:  p.ID,
+			BondDenom:  p.UToken,
+			Bonded:     bonded,
+			RewardRate: rate,
+		})
+	}
+	return result
+}
@@ -56,6 +56,39 @@ func (k Keeper) calculateReferenceAPY(ctx sdk.Context, denom string) (sdk.Coin,
 	return bond, rewards, nil
 }
 
+// calculateActualAPY is used for APY queries. For a given bonded uToken denom, returns the USD value of the
+// estimated annual rewards earned by a reference amount of that uToken, divided by the USD value of the
+// base tokens the reference amount could be exchanged for.
+func (k Keeper) calculateActualAPY(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	// extimate the annual rewards a reference amount of bonded uTokens would earn in a year
+	referenceUToken, rewards, err := k.calculateReferenceAPY(ctx, denom)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	// compute oracle price ratio of rewards to reference bond amount
+	referenceToken, err := k.leverageKeeper.ExchangeUToken(ctx, referenceUToken)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	referenceBondValue, err := k.leverageKeeper.TotalTokenValue(
+		ctx, sdk.NewCoins(referenceToken), leveragetypes.PriceModeSpot,
+	)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+	referenceRewardValue, err := k.leverageKeeper.TotalTokenValue(ctx, rewards, leveragetypes.PriceModeSpot)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+	if referenceBondValue.IsZero() {
+		return sdk.ZeroDec(), leveragetypes.ErrInvalidOraclePrice.Wrap(referenceToken.Denom)
+	}
+
+	return referenceRewardValue.Quo(referenceBondValue), nil
+}
+
 // updateRewardTracker updates the reward tracker matching a specific account + bonded uToken denom
 // by setting it to the current value of that uToken denom's reward accumulator. Used after claiming
 // rewards or when setting bonded amount from zero to a nonzero amount (i.e. initializing reward tracker).
@@ -8,10 +8,17 @@ import (
 	"github.com/umee-network/umee/v5/x/leverage/keeper"
 )
 
+// BeginBlocker implements BeginBlock for the x/leverage module.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.UpdateCircuitBreakers(ctx)
+	util.Panic(k.CacheExchangeRates(ctx))
+}
+
 // EndBlocker implements EndBlock for the x/leverage module.
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 	util.Panic(k.SweepBadDebts(ctx))
 	util.Panic(k.AccrueAllInterest(ctx))
+	k.CheckLiquidationWatchlist(ctx)
 
 	return []abci.ValidatorUpdate{}
 }
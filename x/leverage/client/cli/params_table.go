@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/umee-network/umee/v5/x/leverage/types"
+)
+
+// paramEntry is a single flattened module parameter.
+type paramEntry struct {
+	Key   string
+	Value string
+}
+
+// GetCmdQueryParamsTable creates a Cobra command to query for the x/leverage
+// module parameters and print them as a flat list of keys and values.
+func GetCmdQueryParamsTable() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params-table",
+		Args:  cobra.NoArgs,
+		Short: "Query the x/leverage module parameters as a flat list of keys and values",
+		Long: `Query the x/leverage module parameters as a flat list of keys and values.
+Each parameter is printed on its own line as "key = value". Nested fields use dotted keys.
+With --output json, parameters are printed as a single JSON object of keys to values.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Params(cmd.Context(), &types.QueryParams{})
+			if err != nil {
+				return err
+			}
+
+			entries := flattenParams("", reflect.ValueOf(resp.Params))
+			if clientCtx.OutputFormat == "json" {
+				m := make(map[string]string, len(entries))
+				for _, e := range entries {
+					m[e.Key] = e.Value
+				}
+				bz, err := json.Marshal(m)
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintBytes(bz)
+			}
+
+			lines := make([]string, len(entries))
+			for i, e := range entries {
+				lines[i] = fmt.Sprintf("%s = %s", e.Key, e.Value)
+			}
+			return clientCtx.PrintString(strings.Join(lines, "\n") + "\n")
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// flattenParams walks the exported fields of a struct in declaration order, keyed by their
+// JSON names. Fields which implement fmt.Stringer (such as sdk.Dec and enums) are leaves.
+// Other struct fields are flattened recursively, with keys joined by dots.
+func flattenParams(prefix string, v reflect.Value) []paramEntry {
+	entries := []paramEntry{}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" {
+			name = field.Name
+		}
+		key := name
+		if prefix != "" {
+			key = prefix + "." + name
+		}
+
+		fv := v.Field(i)
+		if fv.Kind() == reflect.Pointer {
+			if fv.IsNil() {
+				entries = append(entries, paramEntry{Key: key, Value: "null"})
+				continue
+			}
+			fv = fv.Elem()
+		}
+		if s, ok := fv.Interface().(fmt.Stringer); ok {
+			entries = append(entries, paramEntry{Key: key, Value: s.String()})
+			continue
+		}
+		if fv.Kind() == reflect.Struct {
+			entries = append(entries, flattenParams(key, fv)...)
+			continue
+		}
+		entries = append(entries, paramEntry{Key: key, Value: fmt.Sprint(fv.Interface())})
+	}
+	return entries
+}
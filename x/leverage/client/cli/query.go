@@ -1,11 +1,20 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/gogo/protobuf/proto"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/umee-network/umee/v5/util/cli"
 	"github.com/umee-network/umee/v5/x/leverage/types"
@@ -13,7 +22,34 @@ import (
 
 // Flag constants
 const (
-	FlagDenom = "denom"
+	FlagDenom           = "denom"
+	FlagCheckIncentives = "check-incentives"
+	FlagGuardPrice      = "guard-price"
+	FlagInterval        = "interval"
+	FlagWebhook         = "webhook"
+	FlagDisplayUnits    = "display-units"
+	FlagNet             = "net"
+	FlagMinValue        = "min-value"
+	FlagBorrowable      = "borrowable"
+	FlagCollateral      = "collateral"
+	FlagSuppliable      = "suppliable"
+	FlagLimit           = "limit"
+	FlagUtilization     = "utilization"
+	FlagSafetyMargin    = "safety-margin"
+	FlagMax             = "max"
+	FlagBuckets         = "buckets"
+	FlagRecipient       = "recipient"
+	FlagDenomPrefix     = "denom-prefix"
+	FlagFor             = "for"
+	FlagSince           = "since"
+	FlagQuote           = "quote"
+	FlagIncludeZero     = "include-zero"
+	FlagLookback        = "lookback"
+	FlagRewardDenom     = "reward-denom"
+	FlagHoldingPeriod   = "holding-period"
+	FlagMinWeight       = "min-weight"
+	FlagMaxWeight       = "max-weight"
+	FlagHaircut         = "haircut"
 )
 
 // GetQueryCmd returns the CLI query commands for the x/leverage module.
@@ -28,6 +64,7 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetCmdQueryParams(),
+		GetCmdQueryParamsTable(),
 		GetCmdQueryRegisteredTokens(),
 		GetCmdQueryMarketSummary(),
 		GetCmdQueryAccountBalances(),
@@ -36,6 +73,62 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryBadDebts(),
 		GetCmdQueryMaxWithdraw(),
 		GetCmdQueryMaxBorrow(),
+		GetCmdQueryEffectiveReserveFactor(),
+		GetCmdQueryPendingIncentives(),
+		GetCmdQueryRepayToFreeCollateral(),
+		GetCmdQueryRegistryHash(),
+		GetCmdQueryMarketSummaries(),
+		GetCmdQueryAccountEquity(),
+		GetCmdQueryAccrualInfo(),
+		GetCmdQueryAccountMarkets(),
+		GetCmdQueryDebtByCollateral(),
+		GetCmdWatchLiquidations(),
+		GetCmdQueryBorrowUtilization(),
+		GetCmdQueryFullLiquidationPlan(),
+		GetCmdQueryInterestIndex(),
+		GetCmdQueryAverageBorrowAPY(),
+		GetCmdQueryBorrowLimitBreakdown(),
+		GetCmdQueryCanWithdraw(),
+		GetCmdQueryReserveCoverage(),
+		GetCmdQueryUTokenSupply(),
+		GetCmdQueryAccountNetAPY(),
+		GetCmdQueryFreeCollateral(),
+		GetCmdQueryIncentiveEligibility(),
+		GetCmdQueryTopBorrowers(),
+		GetCmdQueryTopSuppliers(),
+		GetCmdQueryLiquidationBuffer(),
+		GetCmdQueryCollateralPriceFloors(),
+		GetCmdQueryBorrowAPY(),
+		GetCmdQueryModuleConfig(),
+		GetCmdQueryRequiredCollateral(),
+		GetCmdQueryHealthDistribution(),
+		GetCmdQueryInterestParams(),
+		GetCmdQueryGlobalStats(),
+		GetCmdQueryCollateralizePreview(),
+		GetCmdQueryAccountLiquidationView(),
+		GetCmdQueryLifetimeReserves(),
+		GetCmdExportReport(),
+		GetCmdQueryLiquidationThresholdBreakdown(),
+		GetCmdQueryBestLiquidation(),
+		GetCmdQueryCanFullExit(),
+		GetCmdQueryAPYSeries(),
+		GetCmdQueryLiquidationRewardsPaid(),
+		GetCmdQueryAccountSummaries(),
+		GetCmdQueryProtocolCollateralComposition(),
+		GetCmdQuerySolvencyPriceFloor(),
+		GetCmdQueryStopLoss(),
+		GetCmdQueryAccountEffectiveBorrowRate(),
+		GetCmdQueryReserveState(),
+		GetCmdQueryBorrowableMarkets(),
+		GetCmdQueryExchangeRateTrend(),
+		GetCmdQueryDebtReserveRatioSeries(),
+		GetCmdQueryAggregateBorrowUtilization(),
+		GetCmdQuerySupplyAPY(),
+		GetCmdQueryRemainingCapacity(),
+		GetCmdQueryAccrualStaleness(),
+		GetCmdQueryBadDebtHistory(),
+		GetCmdQueryAccountBorrowCap(),
+		GetCmdQueryAccountCollateralQuality(),
 	)
 
 	return cmd
@@ -56,11 +149,12 @@ func GetCmdQueryParams() *cobra.Command {
 
 			queryClient := types.NewQueryClient(clientCtx)
 			resp, err := queryClient.Params(cmd.Context(), &types.QueryParams{})
-			return cli.PrintOrErr(resp, err, clientCtx)
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
 		},
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
 
 	return cmd
 }
@@ -72,6 +166,13 @@ func GetCmdQueryRegisteredTokens() *cobra.Command {
 		Use:   "registered-tokens [base_denom]",
 		Args:  cobra.MaximumNArgs(1),
 		Short: "Query for all the current registered tokens",
+		Long: `Query for all the current registered tokens, or a single token by base denom.
+The --borrowable, --collateral and --suppliable flags restrict results to tokens with those
+capabilities. The --min-weight and --max-weight flags restrict results to tokens whose collateral
+weight is within an inclusive range. When several are set, only tokens matching all of them are shown.
+
+Example:
+$ umeed query leverage registered-tokens --min-weight 0.5`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -83,12 +184,33 @@ func GetCmdQueryRegisteredTokens() *cobra.Command {
 			if len(args) == 1 {
 				req.BaseDenom = args[0]
 			}
+			if req.BorrowEnabled, err = cmd.Flags().GetBool(FlagBorrowable); err != nil {
+				return err
+			}
+			if req.CollateralEnabled, err = cmd.Flags().GetBool(FlagCollateral); err != nil {
+				return err
+			}
+			if req.SupplyEnabled, err = cmd.Flags().GetBool(FlagSuppliable); err != nil {
+				return err
+			}
+			if req.MinCollateralWeight, err = optionalDecFlag(cmd, FlagMinWeight); err != nil {
+				return err
+			}
+			if req.MaxCollateralWeight, err = optionalDecFlag(cmd, FlagMaxWeight); err != nil {
+				return err
+			}
 			resp, err := queryClient.RegisteredTokens(cmd.Context(), req)
-			return cli.PrintOrErr(resp, err, clientCtx)
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
 		},
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+	cmd.Flags().Bool(FlagBorrowable, false, "Only show tokens which can be borrowed")
+	cmd.Flags().Bool(FlagCollateral, false, "Only show tokens which can be used as collateral")
+	cmd.Flags().Bool(FlagSuppliable, false, "Only show tokens which can be supplied")
+	cmd.Flags().String(FlagMinWeight, "", "Only show tokens with at least this collateral weight")
+	cmd.Flags().String(FlagMaxWeight, "", "Only show tokens with at most this collateral weight")
 
 	return cmd
 }
@@ -111,11 +233,12 @@ func GetCmdQueryMarketSummary() *cobra.Command {
 				Denom: args[0],
 			}
 			resp, err := queryClient.MarketSummary(cmd.Context(), req)
-			return cli.PrintOrErr(resp, err, clientCtx)
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
 		},
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
 
 	return cmd
 }
@@ -134,15 +257,32 @@ func GetCmdQueryAccountBalances() *cobra.Command {
 			}
 
 			queryClient := types.NewQueryClient(clientCtx)
+			net, err := cmd.Flags().GetBool(FlagNet)
+			if err != nil {
+				return err
+			}
+			denomPrefix, err := cmd.Flags().GetString(FlagDenomPrefix)
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed(FlagDenomPrefix) && strings.TrimSpace(denomPrefix) == "" {
+				return fmt.Errorf("--%s cannot be empty", FlagDenomPrefix)
+			}
 			req := &types.QueryAccountBalances{
-				Address: args[0],
+				Address:     args[0],
+				Net:         net,
+				DenomPrefix: denomPrefix,
 			}
-			resp, err := queryClient.AccountBalances(cmd.Context(), req)
-			return cli.PrintOrErr(resp, err, clientCtx)
+			var header metadata.MD
+			resp, err := queryClient.AccountBalances(cmd.Context(), req, grpc.Header(&header))
+			return printAsOfHeight(cmd, resp, header, err, clientCtx)
 		},
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+	cmd.Flags().Bool(FlagNet, false, "Include the net value of denoms which are both supplied and borrowed")
+	cmd.Flags().String(FlagDenomPrefix, "", "Include the total supplied amount of all denoms starting with a prefix, such as ibc/")
 
 	return cmd
 }
@@ -160,16 +300,31 @@ func GetCmdQueryAccountSummary() *cobra.Command {
 				return err
 			}
 
+			quote, err := cmd.Flags().GetString(FlagQuote)
+			if err != nil {
+				return err
+			}
+			haircut, err := cmd.Flags().GetBool(FlagHaircut)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 			req := &types.QueryAccountSummary{
-				Address: args[0],
+				Address:               args[0],
+				QuoteDenom:            quote,
+				ApplyLiquidityHaircut: haircut,
 			}
-			resp, err := queryClient.AccountSummary(cmd.Context(), req)
-			return cli.PrintOrErr(resp, err, clientCtx)
+			var header metadata.MD
+			resp, err := queryClient.AccountSummary(cmd.Context(), req, grpc.Header(&header))
+			return printAsOfHeight(cmd, resp, header, err, clientCtx)
 		},
 	}
 
+	cmd.Flags().String(FlagQuote, "", "Express values in units of a registered base denom's symbol instead of USD")
+	cmd.Flags().Bool(FlagHaircut, false, "Reduce supplied and collateral amounts by each token's liquidity haircut")
 	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
 
 	return cmd
 }
@@ -181,20 +336,31 @@ func GetCmdQueryLiquidationTargets() *cobra.Command {
 		Use:   "liquidation-targets",
 		Args:  cobra.ExactArgs(0),
 		Short: "Query for all borrower addresses eligible for liquidation",
+		Long: `Query for all borrower addresses eligible for liquidation. With --reward-denom, only borrowers
+with collateral which can be received as that liquidation reward are returned.
+
+Example:
+$ umeed query leverage liquidation-targets --reward-denom uatom`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
+			rewardDenom, err := cmd.Flags().GetString(FlagRewardDenom)
+			if err != nil {
+				return err
+			}
 
 			queryClient := types.NewQueryClient(clientCtx)
-			req := &types.QueryLiquidationTargets{}
+			req := &types.QueryLiquidationTargets{RewardDenom: rewardDenom}
 			resp, err := queryClient.LiquidationTargets(cmd.Context(), req)
-			return cli.PrintOrErr(resp, err, clientCtx)
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
 		},
 	}
 
+	cmd.Flags().String(FlagRewardDenom, "", "Only return borrowers with collateral in this base token or uToken denom")
 	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
 
 	return cmd
 }
@@ -215,11 +381,12 @@ func GetCmdQueryBadDebts() *cobra.Command {
 			queryClient := types.NewQueryClient(clientCtx)
 			req := &types.QueryBadDebts{}
 			resp, err := queryClient.BadDebts(cmd.Context(), req)
-			return cli.PrintOrErr(resp, err, clientCtx)
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
 		},
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
 
 	return cmd
 }
@@ -247,12 +414,14 @@ func GetCmdQueryMaxWithdraw() *cobra.Command {
 			if err := req.ValidateBasic(); err != nil {
 				return err
 			}
-			resp, err := queryClient.MaxWithdraw(cmd.Context(), req)
-			return cli.PrintOrErr(resp, err, clientCtx)
+			var header metadata.MD
+			resp, err := queryClient.MaxWithdraw(cmd.Context(), req, grpc.Header(&header))
+			return printAsOfHeight(cmd, resp, header, err, clientCtx)
 		},
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
 
 	return cmd
 }
@@ -277,12 +446,1753 @@ func GetCmdQueryMaxBorrow() *cobra.Command {
 			if len(args) > 1 {
 				req.Denom = args[1]
 			}
-			resp, err := queryClient.MaxBorrow(cmd.Context(), req)
-			return cli.PrintOrErr(resp, err, clientCtx)
+			var header metadata.MD
+			resp, err := queryClient.MaxBorrow(cmd.Context(), req, grpc.Header(&header))
+			return printAsOfHeight(cmd, resp, header, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryEffectiveReserveFactor creates a Cobra command to query for
+// the reserve factor currently in effect for a given token.
+func GetCmdQueryEffectiveReserveFactor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "effective-reserve-factor [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the reserve factor currently applied to a specified denomination",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryEffectiveReserveFactor{
+				Denom: args[0],
+			}
+			resp, err := queryClient.EffectiveReserveFactor(cmd.Context(), req)
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPendingIncentives creates a Cobra command to query for the
+// incentive rewards pending on an account's collateral.
+func GetCmdQueryPendingIncentives() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-incentives [addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the incentive rewards pending on each collateral uToken of an address",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryPendingIncentives{
+				Address: args[0],
+			}
+			resp, err := queryClient.PendingIncentives(cmd.Context(), req)
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRepayToFreeCollateral creates a Cobra command to query for the
+// debt an address must repay before all of a given collateral can be withdrawn.
+func GetCmdQueryRepayToFreeCollateral() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "repay-to-free-collateral [addr] [denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query for the debt an address must repay to make all of a given collateral withdrawable",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryRepayToFreeCollateral{
+				Address: args[0],
+				Denom:   args[1],
+			}
+			resp, err := queryClient.RepayToFreeCollateral(cmd.Context(), req)
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRegistryHash creates a Cobra command to query for the hash
+// of the token registry and the height it was last modified.
+func GetCmdQueryRegistryHash() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "registry-hash",
+		Args:  cobra.NoArgs,
+		Short: "Query for the hash of the token registry and the height it was last modified",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.RegistryHash(cmd.Context(), &types.QueryRegistryHash{})
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryMarketSummaries creates a Cobra command to query for the
+// Market Summaries of multiple tokens.
+func GetCmdQueryMarketSummaries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "market-summaries",
+		Args:  cobra.NoArgs,
+		Short: "Query for the market summaries of the denominations given by --denom, or of all registered tokens",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			denoms, err := cmd.Flags().GetStringArray(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryMarketSummaries{
+				Denoms: denoms,
+			}
+			resp, err := queryClient.MarketSummaries(cmd.Context(), req)
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	cmd.Flags().StringArray(FlagDenom, nil, "Base denom to query. May be repeated")
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAccountEquity creates a Cobra command to query for the net
+// equity and leverage ratio of an account.
+func GetCmdQueryAccountEquity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-equity [addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the net equity USD value and leverage ratio of an address",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryAccountEquity{
+				Address: args[0],
+			}
+			var header metadata.MD
+			resp, err := queryClient.AccountEquity(cmd.Context(), req, grpc.Header(&header))
+			return printAsOfHeight(cmd, resp, header, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAccrualInfo creates a Cobra command to query for the last and next
+// interest accrual of each registered token.
+func GetCmdQueryAccrualInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accrual-info",
+		Args:  cobra.NoArgs,
+		Short: "Query for the last and next interest accrual of each registered token",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.AccrualInfo(cmd.Context(), &types.QueryAccrualInfo{})
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAccountMarkets creates a Cobra command to query for the markets
+// in which an account has supplied, collateral, or borrowed amounts.
+func GetCmdQueryAccountMarkets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-markets [addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the denoms an address has supplied, collateralized, or borrowed",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryAccountMarkets{
+				Address: args[0],
+			}
+			minValue, err := cmd.Flags().GetString(FlagMinValue)
+			if err != nil {
+				return err
+			}
+			if minValue != "" {
+				v, err := sdk.NewDecFromStr(minValue)
+				if err != nil {
+					return err
+				}
+				req.MinValue = &v
+			}
+			var header metadata.MD
+			resp, err := queryClient.AccountMarkets(cmd.Context(), req, grpc.Header(&header))
+			return printAsOfHeight(cmd, resp, header, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+	cmd.Flags().String(FlagMinValue, "", "Omit markets whose supplied plus borrowed USD value is below this value")
+
+	return cmd
+}
+
+// GetCmdQueryDebtByCollateral creates a Cobra command to query for the total
+// borrowed value attributed to each borrower's dominant collateral denom.
+func GetCmdQueryDebtByCollateral() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "debt-by-collateral",
+		Args:  cobra.NoArgs,
+		Short: "Query for total borrowed value grouped by each borrower's largest collateral denom",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.DebtByCollateral(cmd.Context(), &types.QueryDebtByCollateral{})
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBorrowUtilization creates a Cobra command to query for the borrow
+// utilization of an account, which is its borrowed value divided by its borrow limit.
+func GetCmdQueryBorrowUtilization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "borrow-utilization [addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the borrowed value of an address as a fraction of its borrow limit",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryBorrowUtilization{
+				Address: args[0],
+			}
+			var header metadata.MD
+			resp, err := queryClient.BorrowUtilization(cmd.Context(), req, grpc.Header(&header))
+			return printAsOfHeight(cmd, resp, header, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryFullLiquidationPlan creates a Cobra command to query for the sequence
+// of liquidations which fully liquidates an account up to its close factor.
+func GetCmdQueryFullLiquidationPlan() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "full-liquidation-plan [addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the liquidations which repay an address's debt up to its close factor",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryFullLiquidationPlan{
+				Address: args[0],
+			}
+			var header metadata.MD
+			resp, err := queryClient.FullLiquidationPlan(cmd.Context(), req, grpc.Header(&header))
+			return printAsOfHeight(cmd, resp, header, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryInterestIndex creates a Cobra command to query for the interest
+// scalar of a specified denomination.
+func GetCmdQueryInterestIndex() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "interest-index [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the interest scalar of a specified denomination and the height it was last updated",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryInterestIndex{
+				Denom: args[0],
+			}
+			resp, err := queryClient.InterestIndex(cmd.Context(), req)
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
 		},
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
 
 	return cmd
 }
+
+// GetCmdQueryAverageBorrowAPY creates a Cobra command to query for the trailing
+// average borrow APY of a specified denomination.
+func GetCmdQueryAverageBorrowAPY() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "average-borrow-apy [denom] [lookback]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query for the time-weighted average borrow APY of a specified denomination over a lookback duration",
+		Long: `Query for the time-weighted average borrow APY of a specified denomination over a lookback duration
+such as 24h. Borrow APY is sampled in hourly intervals, and at most one week of samples is stored,
+so the lookback is rounded up to whole hours and cannot exceed 168h.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			lookback, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryAverageBorrowAPY{
+				Denom:    args[0],
+				Lookback: lookback,
+			}
+			resp, err := queryClient.AverageBorrowAPY(cmd.Context(), req)
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBorrowLimitBreakdown creates a Cobra command to query for the contribution
+// of each of an account's collateral denoms to its borrow limit.
+func GetCmdQueryBorrowLimitBreakdown() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "borrow-limit-breakdown [addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the borrow limit provided by each collateral denom of an address",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryBorrowLimitBreakdown{
+				Address: args[0],
+			}
+			var header metadata.MD
+			resp, err := queryClient.BorrowLimitBreakdown(cmd.Context(), req, grpc.Header(&header))
+			return printAsOfHeight(cmd, resp, header, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryCanWithdraw creates a Cobra command to query whether an account
+// could withdraw a given amount of uTokens.
+func GetCmdQueryCanWithdraw() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "can-withdraw [addr] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query whether an address could withdraw an amount of uTokens, and if not, why",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			asset, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryCanWithdraw{
+				Address: args[0],
+				Asset:   asset,
+			}
+			var header metadata.MD
+			resp, err := queryClient.CanWithdraw(cmd.Context(), req, grpc.Header(&header))
+			return printAsOfHeight(cmd, resp, header, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryReserveCoverage creates a Cobra command to query for the reserves
+// of a specified denomination as a fraction of its total borrowed amount.
+func GetCmdQueryReserveCoverage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reserve-coverage [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the reserves of a specified denomination divided by its total borrowed amount",
+		Long: `Query for the reserves of a specified denomination divided by its total borrowed amount.
+The coverage is -1 if nothing is borrowed.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryReserveCoverage{
+				Denom: args[0],
+			}
+			resp, err := queryClient.ReserveCoverage(cmd.Context(), req)
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryUTokenSupply creates a Cobra command to query for the total
+// supply of a specified uToken.
+func GetCmdQueryUTokenSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "utoken-supply [utoken-denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the total supply of a specified uToken",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryUTokenSupply{
+				Denom: args[0],
+			}
+			resp, err := queryClient.UTokenSupply(cmd.Context(), req)
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAccountNetAPY creates a Cobra command to query for the estimated
+// net APY of an address.
+func GetCmdQueryAccountNetAPY() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-net-apy [addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the estimated annual net income of an address as a fraction of its equity",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryAccountNetAPY{
+				Address: args[0],
+			}
+			var header metadata.MD
+			resp, err := queryClient.AccountNetAPY(cmd.Context(), req, grpc.Header(&header))
+			return printAsOfHeight(cmd, resp, header, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryFreeCollateral creates a Cobra command to query for the collateral
+// of an address which could be decollateralized without exceeding its borrow limit.
+func GetCmdQueryFreeCollateral() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "free-collateral [addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the collateral of an address which is not needed to cover its borrows",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryFreeCollateral{
+				Address: args[0],
+			}
+			var header metadata.MD
+			resp, err := queryClient.FreeCollateral(cmd.Context(), req, grpc.Header(&header))
+			return printAsOfHeight(cmd, resp, header, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryIncentiveEligibility creates a Cobra command to query for whether
+// an address's collateral earns rewards from each ongoing incentive program.
+func GetCmdQueryIncentiveEligibility() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "incentive-eligibility [addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for whether an address earns rewards from each ongoing incentive program",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryIncentiveEligibility{
+				Address: args[0],
+			}
+			var header metadata.MD
+			resp, err := queryClient.IncentiveEligibility(cmd.Context(), req, grpc.Header(&header))
+			return printAsOfHeight(cmd, resp, header, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTopBorrowers creates a Cobra command to query for the borrowers
+// with the largest borrowed value, along with their health factors.
+func GetCmdQueryTopBorrowers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "top-borrowers",
+		Args:  cobra.NoArgs,
+		Short: "Query for the borrowers with the largest borrowed value",
+		Long: `Query for the borrowers with the largest borrowed value, and their health factors.
+At most 100 borrowers are returned. The queried node must have liquidator queries enabled.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			limit, err := cmd.Flags().GetUint32(FlagLimit)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.TopBorrowers(cmd.Context(), &types.QueryTopBorrowers{Limit: limit})
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+	cmd.Flags().Uint32(FlagLimit, 10, "Maximum number of borrowers to return")
+
+	return cmd
+}
+
+// GetCmdQueryTopSuppliers creates a Cobra command to query for the suppliers
+// with the largest supplied value, optionally of a single token.
+func GetCmdQueryTopSuppliers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "top-suppliers",
+		Args:  cobra.NoArgs,
+		Short: "Query for the suppliers with the largest supplied value",
+		Long: `Query for the suppliers with the largest supplied value, including collateral.
+If --denom is set, only supply of that base token is counted. At most 100 suppliers are returned.
+The queried node must have liquidator queries enabled.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			limit, err := cmd.Flags().GetUint32(FlagLimit)
+			if err != nil {
+				return err
+			}
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryTopSuppliers{Limit: limit, Denom: denom}
+			resp, err := queryClient.TopSuppliers(cmd.Context(), req)
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+	cmd.Flags().Uint32(FlagLimit, 10, "Maximum number of suppliers to return")
+	cmd.Flags().String(FlagDenom, "", "Only count supply of this base token denom")
+
+	return cmd
+}
+
+// GetCmdQueryLiquidationBuffer creates a Cobra command to query for the
+// liquidation buffer of all registered tokens, or a single token.
+func GetCmdQueryLiquidationBuffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquidation-buffer [denom]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query for the liquidation threshold minus collateral weight of all registered tokens",
+		Long: `Query for the liquidation threshold minus collateral weight of all registered tokens,
+or a single token by base denom. This is the portion of collateral value which a borrower at
+their borrow limit can lose before becoming eligible for liquidation.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryLiquidationBuffer{}
+			if len(args) == 1 {
+				req.Denom = args[0]
+			}
+			resp, err := queryClient.LiquidationBuffer(cmd.Context(), req)
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryCollateralPriceFloors creates a Cobra command to query for the
+// collateral price floors of an address.
+func GetCmdQueryCollateralPriceFloors() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collateral-price-floors [addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the spot price of each of an address's collateral tokens below which it can be liquidated",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryCollateralPriceFloors{
+				Address: args[0],
+			}
+			var header metadata.MD
+			resp, err := queryClient.CollateralPriceFloors(cmd.Context(), req, grpc.Header(&header))
+			return printAsOfHeight(cmd, resp, header, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBorrowAPY creates a Cobra command to query for the borrow APY
+// of a specified denomination.
+func GetCmdQueryBorrowAPY() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "borrow-apy [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the borrow APY of a specified denomination",
+		Long: `Query for the borrow APY of a specified denomination at its current supply utilization.
+If --utilization is set, the borrow APY is instead computed at that supply utilization, which must
+be between 0 and 1.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryBorrowAPY{
+				Denom: args[0],
+			}
+			utilization, err := cmd.Flags().GetString(FlagUtilization)
+			if err != nil {
+				return err
+			}
+			if utilization != "" {
+				u, err := sdk.NewDecFromStr(utilization)
+				if err != nil {
+					return err
+				}
+				req.Utilization = &u
+			}
+			resp, err := queryClient.BorrowAPY(cmd.Context(), req)
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+	cmd.Flags().String(FlagUtilization, "", "Supply utilization between 0 and 1 at which to compute the borrow APY")
+
+	return cmd
+}
+
+// GetCmdQueryModuleConfig creates a Cobra command to query for the x/leverage
+// module's consensus version and enabled features.
+func GetCmdQueryModuleConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-config",
+		Args:  cobra.NoArgs,
+		Short: "Query the x/leverage module's consensus version and which optional features are enabled",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.ModuleConfig(cmd.Context(), &types.QueryModuleConfig{})
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRequiredCollateral creates a Cobra command to query for the amount
+// of a token which must be collateralized to enable a borrow.
+func GetCmdQueryRequiredCollateral() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "required-collateral [borrow-amount] [collateral-denom] [addr]",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "Query for the amount of a token which must be supplied and collateralized to enable a borrow",
+		Long: `Query for the amount of a token which must be supplied and collateralized to enable a borrow.
+If an address is given, its existing collateral and borrows are included. The --safety-margin flag
+sets a fraction of the resulting borrow limit to leave unused after the borrow.
+
+Example:
+$ umeed query leverage required-collateral 10000000uatom uumee --safety-margin 0.1`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			borrow, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			margin, err := cmd.Flags().GetString(FlagSafetyMargin)
+			if err != nil {
+				return err
+			}
+			safetyMargin, err := sdk.NewDecFromStr(margin)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryRequiredCollateral{
+				Borrow:          borrow,
+				CollateralDenom: args[1],
+				SafetyMargin:    &safetyMargin,
+			}
+			if len(args) == 3 {
+				req.Address = args[2]
+			}
+			resp, err := queryClient.RequiredCollateral(cmd.Context(), req)
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+	cmd.Flags().String(FlagSafetyMargin, "0", "Fraction of the resulting borrow limit to leave unused")
+
+	return cmd
+}
+
+// GetCmdQueryHealthDistribution creates a Cobra command to query for the number
+// of borrowers, and their borrowed value, in buckets of health factor.
+func GetCmdQueryHealthDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "health-distribution",
+		Args:  cobra.NoArgs,
+		Short: "Query for the number of borrowers and their borrowed value in ranges of health factor",
+		Long: `Query for the number of borrowers and their borrowed value in ranges of health factor.
+--buckets is a comma separated list of strictly increasing health factors separating the buckets,
+such as "1,1.1,1.5", which is the default. This iterates over every borrower, so the queried node
+must have liquidator queries enabled.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			buckets, err := cmd.Flags().GetStringSlice(FlagBuckets)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryHealthDistribution{}
+			for _, b := range buckets {
+				bound, err := sdk.NewDecFromStr(b)
+				if err != nil {
+					return err
+				}
+				req.Bounds = append(req.Bounds, bound)
+			}
+			resp, err := queryClient.HealthDistribution(cmd.Context(), req)
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+	cmd.Flags().StringSlice(FlagBuckets, nil, "Comma separated health factors separating buckets")
+
+	return cmd
+}
+
+// GetCmdQueryInterestParams creates a Cobra command to query for the borrow
+// interest rate curve coefficients of a specified denomination.
+func GetCmdQueryInterestParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "interest-params [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the borrow interest rate curve coefficients of a specified denomination",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.InterestParams(cmd.Context(), &types.QueryInterestParams{Denom: args[0]})
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryGlobalStats creates a Cobra command to query for aggregate
+// statistics of the x/leverage module.
+func GetCmdQueryGlobalStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "global-stats",
+		Args:  cobra.NoArgs,
+		Short: "Query for the module's numbers of borrowers, suppliers and markets, and total value supplied and borrowed",
+		Long: `Query for the module's numbers of borrowers, suppliers and markets, and total value supplied and borrowed.
+Counts are exact, though suppliers only include accounts with uToken collateral. USD values use spot prices
+and skip tokens missing prices, so they are approximate.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.GlobalStats(cmd.Context(), &types.QueryGlobalStats{})
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryCollateralizePreview creates a Cobra command to query for the
+// borrow limit an address would have after collateralizing an amount of uTokens.
+func GetCmdQueryCollateralizePreview() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collateralize-preview [addr] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query for the borrow limit an address would have after collateralizing an amount of uTokens",
+		Long: `Query for the borrow limit an address would have after collateralizing an amount of uTokens,
+and its increase over the current borrow limit. The what_if field is true when the address does not
+hold the full uToken amount in its wallet, in which case the preview assumes the balance exists.
+
+Example:
+$ umeed query leverage collateralize-preview umee1... 1000000u/uumee`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			asset, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryCollateralizePreview{
+				Address: args[0],
+				Asset:   asset,
+			}
+			var header metadata.MD
+			resp, err := queryClient.CollateralizePreview(cmd.Context(), req, grpc.Header(&header))
+			return printAsOfHeight(cmd, resp, header, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAccountLiquidationView creates a Cobra command to query for an
+// address's liquidation threshold, borrowed value, and shortfall.
+func GetCmdQueryAccountLiquidationView() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-liquidation-view [addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the liquidation threshold, borrowed value, and shortfall of an address",
+		Long: `Query for the liquidation threshold, borrowed value, and shortfall of an address.
+The liquidation threshold weights collateral by each token's liquidation threshold rather than its
+collateral weight, and all values use spot prices. These are the values compared by liquidation.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryAccountLiquidationView{
+				Address: args[0],
+			}
+			var header metadata.MD
+			resp, err := queryClient.AccountLiquidationView(cmd.Context(), req, grpc.Header(&header))
+			return printAsOfHeight(cmd, resp, header, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryLifetimeReserves creates a Cobra command to query for the
+// cumulative reserves generated by a specified denomination.
+func GetCmdQueryLifetimeReserves() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lifetime-reserves [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the cumulative reserves generated by a specified denomination",
+		Long: `Query for the cumulative reserves generated by a specified denomination.
+Unlike current reserves, lifetime reserves are not reduced by reserve withdrawals or bad debt repayment.
+They are counted from the block height in the since_height field, where zero means genesis.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.LifetimeReserves(cmd.Context(), &types.QueryLifetimeReserves{Denom: args[0]})
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryLiquidationThresholdBreakdown creates a Cobra command to query for
+// the liquidation threshold provided by each collateral denom of an address.
+func GetCmdQueryLiquidationThresholdBreakdown() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquidation-threshold-breakdown [addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the liquidation threshold provided by each collateral denom of an address",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryLiquidationThresholdBreakdown{
+				Address: args[0],
+			}
+			var header metadata.MD
+			resp, err := queryClient.LiquidationThresholdBreakdown(cmd.Context(), req, grpc.Header(&header))
+			return printAsOfHeight(cmd, resp, header, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBestLiquidation creates a Cobra command to query for the single
+// liquidation of an account which maximizes liquidator profit.
+func GetCmdQueryBestLiquidation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "best-liquidation [addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the repayment and reward denoms which maximize the profit of liquidating an address",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryBestLiquidation{
+				Address: args[0],
+			}
+			var header metadata.MD
+			resp, err := queryClient.BestLiquidation(cmd.Context(), req, grpc.Header(&header))
+			return printAsOfHeight(cmd, resp, header, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryCanFullExit creates a Cobra command to query whether the module has
+// enough liquidity for an account to withdraw all of its supplied tokens.
+func GetCmdQueryCanFullExit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "can-full-exit [addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query whether an address could repay all borrows and withdraw all supplied tokens, and if not, which are short",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryCanFullExit{
+				Address: args[0],
+			}
+			var header metadata.MD
+			resp, err := queryClient.CanFullExit(cmd.Context(), req, grpc.Header(&header))
+			return printAsOfHeight(cmd, resp, header, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAPYSeries creates a Cobra command to query for the historical supply and
+// borrow APY of a specified denomination, averaged over intervals of equal length.
+func GetCmdQueryAPYSeries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apy-series [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the historical supply and borrow APY of a specified denomination",
+		Long: `Query for the historical supply and borrow APY of a specified denomination, as one point
+per interval from --since up to the latest block. APY is sampled in hourly intervals, and at most
+one week of samples is stored, so --since is rounded down to a whole hour and cannot be more than
+a week ago, and --interval must be a whole number of hours. If --since is omitted, the series starts
+at the oldest stored sample. Intervals in which no interest accrued are omitted.
+
+Example:
+$ umeed query leverage apy-series uumee --since 2023-06-01T00:00:00Z --interval 6h`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			interval, err := cmd.Flags().GetDuration(FlagInterval)
+			if err != nil {
+				return err
+			}
+			req := &types.QueryAPYSeries{
+				Denom:    args[0],
+				Interval: interval,
+			}
+			since, err := cmd.Flags().GetString(FlagSince)
+			if err != nil {
+				return err
+			}
+			if since != "" {
+				if req.Since, err = time.Parse(time.RFC3339, since); err != nil {
+					return fmt.Errorf("invalid --%s: %w", FlagSince, err)
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.APYSeries(cmd.Context(), req)
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	cmd.Flags().String(FlagSince, "", "Start of the series as an RFC3339 timestamp (default: oldest stored sample)")
+	cmd.Flags().Duration(FlagInterval, time.Hour, "Length of time covered by each point, in whole hours")
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryLiquidationRewardsPaid creates a Cobra command to query for the
+// cumulative liquidation rewards paid in a specified denomination.
+func GetCmdQueryLiquidationRewardsPaid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquidation-rewards-paid [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the cumulative liquidation rewards paid to liquidators in a specified denomination",
+		Long: `Query for the cumulative liquidation rewards paid to liquidators in a specified denomination.
+Rewards paid as the token's uTokens are counted in base tokens, at their exchange rate when paid.
+They are counted from the block height in the since_height field, where zero means genesis.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.LiquidationRewardsPaid(cmd.Context(),
+				&types.QueryLiquidationRewardsPaid{Denom: args[0]})
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAccountSummaries creates a Cobra command to query for USD
+// position values and borrowing limits of multiple addresses.
+func GetCmdQueryAccountSummaries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-summaries [addr]...",
+		Args:  cobra.RangeArgs(1, types.MaxAccountSummaries),
+		Short: "Query for position USD values and borrowing limits for multiple addresses",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			quote, err := cmd.Flags().GetString(FlagQuote)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryAccountSummaries{
+				Addresses:  args,
+				QuoteDenom: quote,
+			}
+			var header metadata.MD
+			resp, err := queryClient.AccountSummaries(cmd.Context(), req, grpc.Header(&header))
+			return printAsOfHeight(cmd, resp, header, err, clientCtx)
+		},
+	}
+
+	cmd.Flags().String(FlagQuote, "", "Express values in units of a registered base denom's symbol instead of USD")
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryProtocolCollateralComposition creates a Cobra command to query for the
+// USD value of each collateral denom and its share of all collateral in the module.
+func GetCmdQueryProtocolCollateralComposition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "protocol-collateral-composition",
+		Args:  cobra.NoArgs,
+		Short: "Query for the value and share of each collateral denom across all accounts",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.ProtocolCollateralComposition(
+				cmd.Context(), &types.QueryProtocolCollateralComposition{},
+			)
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySolvencyPriceFloor creates a Cobra command to query for the lowest
+// price of a token at which all collateral in the module covers all borrows.
+func GetCmdQuerySolvencyPriceFloor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "solvency-price-floor [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the lowest price of a token at which all collateral in the module covers all borrows",
+		Long: `Query for the lowest price of a token at which all collateral in the module covers all borrows.
+Other token prices are held at their current spot prices, and collateral is counted at full value.
+A null price floor means no price of the token would make collateral cover borrows.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.SolvencyPriceFloor(cmd.Context(),
+				&types.QuerySolvencyPriceFloor{Denom: args[0]})
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryStopLoss creates a Cobra command to query for the stop-loss
+// recorded by an address and whether it can currently be executed.
+func GetCmdQueryStopLoss() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stop-loss [addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the stop-loss recorded by an address and its current health factor",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.StopLoss(cmd.Context(), &types.QueryStopLoss{Address: args[0]})
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAccountEffectiveBorrowRate creates a Cobra command to query for the
+// borrow APY an address pays across all of its borrows.
+func GetCmdQueryAccountEffectiveBorrowRate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-effective-borrow-rate [addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the value-weighted borrow APY an address pays across all of its borrows",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryAccountEffectiveBorrowRate{
+				Address: args[0],
+			}
+			var header metadata.MD
+			resp, err := queryClient.AccountEffectiveBorrowRate(cmd.Context(), req, grpc.Header(&header))
+			return printAsOfHeight(cmd, resp, header, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryReserveState creates a Cobra command to query for the reserves
+// and bad debt of every token which has either.
+func GetCmdQueryReserveState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reserve-state",
+		Args:  cobra.NoArgs,
+		Short: "Query for the reserves, bad debt and net coverage of each token",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.ReserveState(cmd.Context(), &types.QueryReserveState{})
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBorrowableMarkets creates a Cobra command to query for the maximum
+// amount of each borrowable token an address can currently borrow.
+func GetCmdQueryBorrowableMarkets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "borrowable-markets [addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the maximum amount of each token an address can currently borrow",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			includeZero, err := cmd.Flags().GetBool(FlagIncludeZero)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryBorrowableMarkets{
+				Address:     args[0],
+				IncludeZero: includeZero,
+			}
+			var header metadata.MD
+			resp, err := queryClient.BorrowableMarkets(cmd.Context(), req, grpc.Header(&header))
+			return printAsOfHeight(cmd, resp, header, err, clientCtx)
+		},
+	}
+
+	cmd.Flags().Bool(FlagIncludeZero, false, "Include markets in which the address cannot currently borrow")
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryExchangeRateTrend creates a Cobra command to query for the trend of a
+// uToken exchange rate over a lookback period.
+func GetCmdQueryExchangeRateTrend() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exchange-rate-trend [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the current uToken exchange rate of a specified denomination and its growth over --lookback",
+		Long: `Query for the current uToken exchange rate of a specified denomination, the rate recorded one
+--lookback period ago, and the annualized growth between them. Exchange rates are recorded with the
+hourly APY samples, and at most one week of samples is stored, so --lookback cannot exceed 168h.
+
+Example:
+$ umeed query leverage exchange-rate-trend uumee --lookback 24h`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			lookback, err := cmd.Flags().GetDuration(FlagLookback)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryExchangeRateTrend{
+				Denom:    args[0],
+				Lookback: lookback,
+			}
+			resp, err := queryClient.ExchangeRateTrend(cmd.Context(), req)
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	cmd.Flags().Duration(FlagLookback, 24*time.Hour, "How far back to compare the exchange rate, at most 168h")
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDebtReserveRatioSeries creates a Cobra command to query for the historical
+// ratio of a token's total borrowed amount to its reserves.
+func GetCmdQueryDebtReserveRatioSeries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "debt-reserve-ratio-series [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the historical ratio of total borrowed to reserves of a specified denomination",
+		Long: `Query for the historical ratio of total borrowed to reserves of a specified denomination, as one
+point per interval from --since up to the queried block, using the amounts last recorded in each interval.
+Amounts are recorded with the hourly APY samples, and at most one week of samples is stored, so --since is
+rounded down to a whole hour and cannot be more than a week before the queried block, and --interval must
+be a whole number of hours. Earlier periods can be queried from an archive node with --height.
+
+Example:
+$ umeed query leverage debt-reserve-ratio-series uumee --since 2023-06-01T00:00:00Z --interval 6h`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			interval, err := cmd.Flags().GetDuration(FlagInterval)
+			if err != nil {
+				return err
+			}
+			req := &types.QueryDebtReserveRatioSeries{
+				Denom:    args[0],
+				Interval: interval,
+			}
+			since, err := cmd.Flags().GetString(FlagSince)
+			if err != nil {
+				return err
+			}
+			if since != "" {
+				if req.Since, err = time.Parse(time.RFC3339, since); err != nil {
+					return fmt.Errorf("invalid --%s: %w", FlagSince, err)
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			var header metadata.MD
+			resp, err := queryClient.DebtReserveRatioSeries(cmd.Context(), req, grpc.Header(&header))
+			return printAsOfHeight(cmd, resp, header, err, clientCtx)
+		},
+	}
+
+	cmd.Flags().String(FlagSince, "", "Start of the series as an RFC3339 timestamp (default: oldest stored sample)")
+	cmd.Flags().Duration(FlagInterval, time.Hour, "Length of time covered by each point, in whole hours")
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAggregateBorrowUtilization creates a Cobra command to query for the total
+// borrowed value of all accounts as a fraction of their total borrow limit.
+func GetCmdQueryAggregateBorrowUtilization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate-borrow-utilization",
+		Args:  cobra.NoArgs,
+		Short: "Query for the total borrowed value of all accounts as a fraction of their total borrow limit",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.AggregateBorrowUtilization(cmd.Context(), &types.QueryAggregateBorrowUtilization{})
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySupplyAPY creates a Cobra command to query for the supply APY of a specified
+// denomination, optionally net of its withdraw fee over a holding period.
+func GetCmdQuerySupplyAPY() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply-apy [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the supply APY of a specified denomination",
+		Long: `Query for the current supply APY of a specified denomination. If --holding-period is set,
+the token's withdraw fee is amortized over that period and subtracted, giving the APY realized by a
+supplier who withdraws after holding for that long. Without a withdraw fee, the gross APY is returned.
+
+Example:
+$ umeed query leverage supply-apy uumee --holding-period 720h`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			holdingPeriod, err := cmd.Flags().GetDuration(FlagHoldingPeriod)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QuerySupplyAPY{
+				Denom:         args[0],
+				HoldingPeriod: holdingPeriod,
+			}
+			resp, err := queryClient.SupplyAPY(cmd.Context(), req)
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	cmd.Flags().Duration(FlagHoldingPeriod, 0, "Time supplied tokens are held before withdrawal, e.g. 720h")
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRemainingCapacity creates a Cobra command to query for the USD value an address
+// can still borrow, and how much of each borrowable token that allows.
+func GetCmdQueryRemainingCapacity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remaining-capacity [addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the remaining borrow capacity of an address in USD and per borrowable token",
+		Long: `Query for the USD value an address can still borrow before reaching its borrow limit, and for
+each borrow-enabled token, the amount it can borrow given both that limit and the market's liquidity.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryRemainingCapacity{
+				Address: args[0],
+			}
+			var header metadata.MD
+			resp, err := queryClient.RemainingCapacity(cmd.Context(), req, grpc.Header(&header))
+			return printAsOfHeight(cmd, resp, header, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAccrualStaleness creates a Cobra command to query for the blocks and time
+// elapsed since interest was last accrued on a specified denomination.
+func GetCmdQueryAccrualStaleness() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accrual-staleness [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the blocks and time elapsed since a denomination last accrued interest",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryAccrualStaleness{
+				Denom: args[0],
+			}
+			resp, err := queryClient.AccrualStaleness(cmd.Context(), req)
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBadDebtHistory creates a Cobra command to query for the history of
+// account debts being marked as bad debt.
+func GetCmdQueryBadDebtHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bad-debt-history",
+		Args:  cobra.NoArgs,
+		Short: "Query for the most recent records of account debts being marked as bad debt",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryBadDebtHistory{
+				Pagination: pageReq,
+			}
+			resp, err := queryClient.BadDebtHistory(cmd.Context(), req)
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "bad-debt-history")
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAccountBorrowCap creates a Cobra command to query the borrow cap
+// set by governance on an account.
+func GetCmdQueryAccountBorrowCap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-borrow-cap [addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the maximum USD value an account can borrow, or zero if no cap is set",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryAccountBorrowCap{
+				Address: args[0],
+			}
+			resp, err := queryClient.AccountBorrowCap(cmd.Context(), req)
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAccountCollateralQuality creates a Cobra command to query the value-weighted
+// average collateral weight and liquidation threshold of an account's collateral.
+func GetCmdQueryAccountCollateralQuality() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-collateral-quality [addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the value-weighted average collateral weight and liquidation threshold of an address",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryAccountCollateralQuality{
+				Address: args[0],
+			}
+			resp, err := queryClient.AccountCollateralQuality(cmd.Context(), req)
+			return cli.PrintCmdOrErr(cmd, resp, err, clientCtx)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cli.AddBinaryOutputFlags(cmd)
+
+	return cmd
+}
+
+// printAsOfHeight prints a query response along with the height at which it was
+// served, or returns a clear error if the requested height has been pruned.
+func printAsOfHeight(
+	cmd *cobra.Command, resp proto.Message, header metadata.MD, err error, clientCtx client.Context,
+) error {
+	if err != nil {
+		// the node rejects heights it has pruned or not yet committed as invalid requests
+		if clientCtx.Height > 0 && errors.Is(err, sdkerrors.ErrInvalidRequest) {
+			return fmt.Errorf("state at height %d is not available (pruned or not yet committed): %w",
+				clientCtx.Height, err)
+		}
+		return err
+	}
+	if heights := header.Get(grpctypes.GRPCBlockHeightHeader); len(heights) > 0 {
+		cmd.PrintErrf("served at height: %s\n", heights[0])
+	}
+	return cli.PrintCmdOrErr(cmd, resp, nil, clientCtx)
+}
+
+// optionalDecFlag parses a decimal string flag, returning nil if the flag is empty.
+func optionalDecFlag(cmd *cobra.Command, flag string) (*sdk.Dec, error) {
+	s, err := cmd.Flags().GetString(flag)
+	if err != nil || s == "" {
+		return nil, err
+	}
+	d, err := sdk.NewDecFromStr(s)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
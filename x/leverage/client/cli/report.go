@@ -0,0 +1,160 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/umee-network/umee/v5/x/leverage/types"
+)
+
+// marketReport is the section of an export report describing a single registered token.
+type marketReport struct {
+	Denom            string          `json:"denom"`
+	Summary          json.RawMessage `json:"summary"`
+	ReserveCoverage  json.RawMessage `json:"reserve_coverage"`
+	LifetimeReserves json.RawMessage `json:"lifetime_reserves"`
+}
+
+// exportReport is the JSON document written by the export-report command. Query responses
+// are embedded using their protobuf JSON encoding.
+type exportReport struct {
+	Height           int64           `json:"height"`
+	RegisteredTokens json.RawMessage `json:"registered_tokens"`
+	Markets          []marketReport  `json:"markets"`
+	GlobalStats      json.RawMessage `json:"global_stats"`
+}
+
+// GetCmdExportReport creates a Cobra command which queries the token registry,
+// every market summary and its reserves, and global stats, and writes them to
+// a single JSON file.
+func GetCmdExportReport() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-report [file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Write the token registry, market summaries, reserves, and global stats to a single JSON file",
+		Long: `Write the token registry, market summaries, reserves, and global stats to a single JSON file.
+All queries are served at the same block height: the one given by --height, or otherwise the latest
+height at the time of the first query. The height used is recorded in the report.
+
+Example:
+$ umeed query leverage export-report report.json --height 1000000`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			if clientCtx.Height < 0 {
+				return fmt.Errorf("invalid --%s %d: must not be negative", flags.FlagHeight, clientCtx.Height)
+			}
+
+			report, err := buildExportReport(cmd.Context(), clientCtx)
+			if err != nil {
+				return err
+			}
+			bz, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+			if err := os.WriteFile(args[0], bz, 0o600); err != nil {
+				return err
+			}
+
+			cmd.PrintErrf("report at height %d written to %s\n", report.Height, args[0])
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// buildExportReport runs the queries of an export report. If the client context has no height,
+// the height which served the first query is used for all others, so the report is consistent.
+func buildExportReport(ctx context.Context, clientCtx client.Context) (*exportReport, error) {
+	queryClient := types.NewQueryClient(clientCtx)
+	var header metadata.MD
+	tokens, err := queryClient.RegisteredTokens(ctx, &types.QueryRegisteredTokens{}, grpc.Header(&header))
+	if err != nil {
+		return nil, err
+	}
+	if clientCtx.Height == 0 {
+		heights := header.Get(grpctypes.GRPCBlockHeightHeader)
+		if len(heights) == 0 {
+			return nil, fmt.Errorf("response is missing the %s header", grpctypes.GRPCBlockHeightHeader)
+		}
+		height, err := strconv.ParseInt(heights[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		clientCtx = clientCtx.WithHeight(height)
+		queryClient = types.NewQueryClient(clientCtx)
+	}
+
+	report := &exportReport{
+		Height:  clientCtx.Height,
+		Markets: []marketReport{},
+	}
+	if report.RegisteredTokens, err = marshalReportJSON(clientCtx, tokens); err != nil {
+		return nil, err
+	}
+
+	summaries, err := queryClient.MarketSummaries(ctx, &types.QueryMarketSummaries{})
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range summaries.Summaries {
+		market := marketReport{Denom: s.Denom}
+		if market.Summary, err = marshalReportJSON(clientCtx, &s.Summary); err != nil {
+			return nil, err
+		}
+
+		coverage, err := queryClient.ReserveCoverage(ctx, &types.QueryReserveCoverage{Denom: s.Denom})
+		if err != nil {
+			return nil, err
+		}
+		if market.ReserveCoverage, err = marshalReportJSON(clientCtx, coverage); err != nil {
+			return nil, err
+		}
+
+		lifetime, err := queryClient.LifetimeReserves(ctx, &types.QueryLifetimeReserves{Denom: s.Denom})
+		if err != nil {
+			return nil, err
+		}
+		if market.LifetimeReserves, err = marshalReportJSON(clientCtx, lifetime); err != nil {
+			return nil, err
+		}
+
+		report.Markets = append(report.Markets, market)
+	}
+
+	stats, err := queryClient.GlobalStats(ctx, &types.QueryGlobalStats{})
+	if err != nil {
+		return nil, err
+	}
+	if report.GlobalStats, err = marshalReportJSON(clientCtx, stats); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// marshalReportJSON encodes a query response using its protobuf JSON encoding.
+func marshalReportJSON(clientCtx client.Context, resp proto.Message) (json.RawMessage, error) {
+	bz, err := clientCtx.Codec.MarshalJSON(resp)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(bz), nil
+}
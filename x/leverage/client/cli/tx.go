@@ -4,10 +4,15 @@ import (
 	"fmt"
 	"strings"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/spf13/cobra"
 
 	"github.com/umee-network/umee/v5/x/leverage/types"
@@ -32,8 +37,19 @@ func GetTxCmd() *cobra.Command {
 		GetCmdBorrow(),
 		GetCmdMaxBorrow(),
 		GetCmdRepay(),
+		GetCmdRepayWithdraw(),
+		GetCmdBorrowWithTopUp(),
+		GetCmdQuickBorrow(),
 		GetCmdLiquidate(),
 		GetCmdSupplyCollateral(),
+		GetCmdWithdrawReserves(),
+		GetCmdSetOracleSymbol(),
+		GetCmdSetAccountBorrowCap(),
+		GetCmdSetStopLoss(),
+		GetCmdRemoveStopLoss(),
+		GetCmdExecuteStopLoss(),
+		GetCmdLeverageLoop(),
+		GetCmdSwapCollateral(),
 	)
 
 	return cmd
@@ -52,7 +68,7 @@ func GetCmdSupply() *cobra.Command {
 				return err
 			}
 
-			asset, err := sdk.ParseCoinNormalized(args[0])
+			asset, err := parseAmount(cmd, clientCtx, args[0])
 			if err != nil {
 				return err
 			}
@@ -63,6 +79,7 @@ func GetCmdSupply() *cobra.Command {
 		},
 	}
 
+	addDisplayUnitsFlag(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -81,17 +98,42 @@ func GetCmdWithdraw() *cobra.Command {
 				return err
 			}
 
-			asset, err := sdk.ParseCoinNormalized(args[0])
+			asset, err := parseAmount(cmd, clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			checkIncentives, err := cmd.Flags().GetBool(FlagCheckIncentives)
 			if err != nil {
 				return err
 			}
+			if checkIncentives {
+				if err := checkWithdrawImpact(cmd, clientCtx, asset); err != nil {
+					return err
+				}
+			}
 
 			msg := types.NewMsgWithdraw(clientCtx.GetFromAddress(), asset)
+			recipient, err := cmd.Flags().GetString(FlagRecipient)
+			if err != nil {
+				return err
+			}
+			if recipient != "" {
+				recipientAddr, err := sdk.AccAddressFromBech32(recipient)
+				if err != nil {
+					return err
+				}
+				msg = types.NewMsgWithdrawTo(clientCtx.GetFromAddress(), recipientAddr, asset)
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().Bool(FlagCheckIncentives, false,
+		"Abort if the withdrawal requires collateral bonded to incentive programs")
+	cmd.Flags().String(FlagRecipient, "", "Address to receive the withdrawn base tokens instead of the sender")
+	addDisplayUnitsFlag(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -198,7 +240,7 @@ func GetCmdBorrow() *cobra.Command {
 				return err
 			}
 
-			asset, err := sdk.ParseCoinNormalized(args[0])
+			asset, err := parseAmount(cmd, clientCtx, args[0])
 			if err != nil {
 				return err
 			}
@@ -209,6 +251,7 @@ func GetCmdBorrow() *cobra.Command {
 		},
 	}
 
+	addDisplayUnitsFlag(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -251,22 +294,437 @@ func GetCmdRepay() *cobra.Command {
 				return err
 			}
 
-			asset, err := sdk.ParseCoinNormalized(args[0])
+			asset, err := parseAmount(cmd, clientCtx, args[0])
 			if err != nil {
 				return err
 			}
 
 			msg := types.NewMsgRepay(clientCtx.GetFromAddress(), asset)
+			recipient, err := cmd.Flags().GetString(FlagFor)
+			if err != nil {
+				return err
+			}
+			if recipient != "" {
+				recipientAddr, err := sdk.AccAddressFromBech32(recipient)
+				if err != nil {
+					return err
+				}
+				msg = types.NewMsgRepayFor(clientCtx.GetFromAddress(), recipientAddr, asset)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagFor, "", "Address whose borrow is repaid using the sender's funds")
+	addDisplayUnitsFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRepayWithdraw creates a Cobra command to generate or broadcast a
+// transaction with a MsgRepayWithdraw message.
+func GetCmdRepayWithdraw() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "repay-withdraw [repay-amount] [withdraw-amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Repay a borrowed asset and withdraw supplied uTokens in a single atomic step",
+		Long: `Repay a borrowed asset and withdraw supplied uTokens in a single atomic step.
+The borrow limit is only checked after both the repayment and withdrawal. If the withdrawal
+fails, the repayment is reverted as well.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			repay, err := parseAmount(cmd, clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			withdraw, err := parseAmount(cmd, clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRepayWithdraw(clientCtx.GetFromAddress(), repay, withdraw)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addDisplayUnitsFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdBorrowWithTopUp creates a Cobra command to generate or broadcast a
+// transaction with a MsgBorrowWithTopUp message.
+func GetCmdBorrowWithTopUp() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "borrow-with-top-up [borrow-amount] [collateral-amount] [target-health-factor]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Borrow an asset, first collateralizing only as much of a token as needed to reach a target health factor",
+		Long: `Borrow an asset, first collateralizing only as much of a token as needed to reach a target health factor.
+Collateral-amount is the maximum amount of base tokens to supply and collateralize; any unused portion
+stays in the wallet. Health factor is liquidation threshold divided by borrowed value, at spot prices.
+
+Example:
+$ umeed tx leverage borrow-with-top-up 100uatom 5000uumee 1.5`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			borrow, err := parseAmount(cmd, clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			collateral, err := parseAmount(cmd, clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+			target, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBorrowWithTopUp(clientCtx.GetFromAddress(), borrow, collateral, target)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addDisplayUnitsFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSetStopLoss creates a Cobra command to generate or broadcast a
+// transaction with a MsgSetStopLoss message.
+func GetCmdSetStopLoss() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-stop-loss [executor] [target-health-factor]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Allow an executor to withdraw and repay on your behalf once your health factor falls to a target",
+		Long: `Allow an executor to withdraw and repay on your behalf once your health factor falls to a target.
+Once your health factor is at or below the target, the executor may withdraw your supplied uTokens
+to your wallet and repay your borrows from your wallet, as long as your health factor increases.
+Assets never leave your account except to repay your own borrows. The target must be greater than 1.
+Setting a new stop-loss replaces any existing one.
+
+Example:
+$ umeed tx leverage set-stop-loss umee1... 1.2`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			executor, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			target, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetStopLoss(clientCtx.GetFromAddress(), executor, target)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRemoveStopLoss creates a Cobra command to generate or broadcast a
+// transaction with a MsgSetStopLoss message which removes a stop-loss.
+func GetCmdRemoveStopLoss() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-stop-loss",
+		Args:  cobra.NoArgs,
+		Short: "Remove your stop-loss, revoking its executor",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetStopLoss(clientCtx.GetFromAddress(), nil, sdk.ZeroDec())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdExecuteStopLoss creates a Cobra command to generate or broadcast a
+// transaction with a MsgExecuteStopLoss message.
+func GetCmdExecuteStopLoss() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute-stop-loss [borrower] [withdraw-amount] [repay-amount]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Withdraw and repay on behalf of a borrower whose stop-loss names you as executor",
+		Long: `Withdraw and repay on behalf of a borrower whose stop-loss names you as executor.
+The borrower's supplied uTokens are withdrawn to the borrower's wallet, then its borrow is repaid
+from its wallet. The borrower's health factor must be at or below its stop-loss target beforehand,
+and must increase unless all borrows are repaid.
+
+Example:
+$ umeed tx leverage execute-stop-loss umee1... 100u/uatom 100uatom`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			borrower, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			withdraw, err := parseAmount(cmd, clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+			repay, err := parseAmount(cmd, clientCtx, args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExecuteStopLoss(clientCtx.GetFromAddress(), borrower, withdraw, repay)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addDisplayUnitsFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdLeverageLoop creates a Cobra command to generate or broadcast a
+// transaction with a MsgLeverageLoop message.
+func GetCmdLeverageLoop() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "leverage-loop [amount] [target-leverage]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Supply and collateralize an asset, then loop borrowing and supplying it up to a target leverage",
+		Long: `Supply and collateralize an asset, then loop borrowing and supplying it up to a target leverage.
+Leverage is the total amount supplied by the loop divided by the initial amount. The loop stops
+early if the borrow limit or the market's liquidity allows no further meaningful borrow, and the
+response reports the leverage achieved and the limiting factor.
+
+Example:
+$ umeed tx leverage leverage-loop 1000uumee 1.25`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			asset, err := parseAmount(cmd, clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			target, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgLeverageLoop(clientCtx.GetFromAddress(), asset, target)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addDisplayUnitsFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSwapCollateral creates a Cobra command to generate or broadcast a
+// transaction with a MsgSwapCollateral message.
+func GetCmdSwapCollateral() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-collateral [withdraw-amount] [collateral-amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Withdraw collateral uTokens and supply and collateralize a different asset in one atomic step",
+		Long: `Withdraw collateral uTokens and supply and collateralize a different asset in one atomic step.
+The borrow limit is only checked once both have happened, so collateral can be rotated without
+passing through an intermediate state. If either step fails, neither takes effect.
+
+Example:
+$ umeed tx leverage swap-collateral 100u/uumee 10uatom`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			withdraw, err := parseAmount(cmd, clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			collateral, err := parseAmount(cmd, clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSwapCollateral(clientCtx.GetFromAddress(), withdraw, collateral)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	addDisplayUnitsFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuickBorrow creates a Cobra command to generate or broadcast a single
+// transaction with a MsgSupplyCollateral message followed by a MsgBorrow message.
+func GetCmdQuickBorrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quick-borrow [collateral-amount] [borrow-amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Supply and collateralize an asset, then borrow another, in a single transaction",
+		Long: `Supply and collateralize an asset, then borrow another, in a single transaction.
+Before building the transaction, the borrow is checked against an estimate of the resulting borrow
+limit using spot prices, reduced by --safety-margin (a fraction of the borrow limit to leave unused).
+If the borrow exceeds the estimated maximum, the maximum is reported and the command aborts, unless
+--max is set, in which case the estimated maximum is borrowed instead.
+
+Example:
+$ umeed tx leverage quick-borrow 1000000000uumee 10000000uatom --safety-margin 0.1`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			collateral, err := parseAmount(cmd, clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			borrow, err := parseAmount(cmd, clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+			margin, err := cmd.Flags().GetString(FlagSafetyMargin)
+			if err != nil {
+				return err
+			}
+			safetyMargin, err := sdk.NewDecFromStr(margin)
+			if err != nil {
+				return err
+			}
+			if safetyMargin.IsNegative() || safetyMargin.GTE(sdk.OneDec()) {
+				return fmt.Errorf("--%s must be at least 0 and less than 1: %s", FlagSafetyMargin, safetyMargin)
+			}
+			useMax, err := cmd.Flags().GetBool(FlagMax)
+			if err != nil {
+				return err
+			}
+
+			maxBorrow, err := quickBorrowMaximum(cmd, clientCtx, collateral, borrow.Denom, safetyMargin)
+			if err != nil {
+				return err
+			}
+			if borrow.Amount.GT(maxBorrow.Amount) {
+				if !useMax {
+					return fmt.Errorf("borrow of %s exceeds the estimated maximum of %s; use --%s to borrow the maximum",
+						borrow, maxBorrow, FlagMax)
+				}
+				borrow = maxBorrow
+			}
+			if !borrow.IsPositive() {
+				return fmt.Errorf("estimated maximum borrow is zero")
+			}
+
+			msgs := []sdk.Msg{
+				types.NewMsgSupplyCollateral(clientCtx.GetFromAddress(), collateral),
+				types.NewMsgBorrow(clientCtx.GetFromAddress(), borrow),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	addDisplayUnitsFlag(cmd)
+	cmd.Flags().String(FlagSafetyMargin, "0.05", "Fraction of the resulting borrow limit to leave unused")
+	cmd.Flags().Bool(FlagMax, false, "Borrow the estimated maximum if the requested borrow exceeds it")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
+// quickBorrowMaximum estimates the maximum amount of a token the sender could borrow after supplying and
+// collateralizing an asset, using spot prices, leaving a fraction of the resulting borrow limit unused.
+func quickBorrowMaximum(cmd *cobra.Command, clientCtx client.Context, collateral sdk.Coin, borrowDenom string,
+	safetyMargin sdk.Dec,
+) (sdk.Coin, error) {
+	queryClient := types.NewQueryClient(clientCtx)
+	summary, err := queryClient.AccountSummary(cmd.Context(), &types.QueryAccountSummary{
+		Address: clientCtx.GetFromAddress().String(),
+	})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	collateralToken, collateralPrice, err := queryTokenAndPrice(cmd, queryClient, collateral.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	borrowToken, borrowPrice, err := queryTokenAndPrice(cmd, queryClient, borrowDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	collateralValue := sdk.NewDecFromInt(collateral.Amount).Mul(collateralPrice).
+		QuoInt(sdkmath.NewIntWithDecimal(1, int(collateralToken.Exponent)))
+	borrowLimit := summary.BorrowLimit.Add(collateralValue.Mul(collateralToken.CollateralWeight))
+	available := borrowLimit.Mul(sdk.OneDec().Sub(safetyMargin)).Sub(summary.BorrowedValue)
+	if !available.IsPositive() {
+		return sdk.NewCoin(borrowDenom, sdk.ZeroInt()), nil
+	}
+	amount := available.MulInt(sdkmath.NewIntWithDecimal(1, int(borrowToken.Exponent))).Quo(borrowPrice)
+	return sdk.NewCoin(borrowDenom, amount.TruncateInt()), nil
+}
+
+// queryTokenAndPrice queries a registered token's settings and its spot price.
+func queryTokenAndPrice(cmd *cobra.Command, queryClient types.QueryClient, denom string) (types.Token, sdk.Dec,
+	error,
+) {
+	tokens, err := queryClient.RegisteredTokens(cmd.Context(), &types.QueryRegisteredTokens{BaseDenom: denom})
+	if err != nil {
+		return types.Token{}, sdk.Dec{}, err
+	}
+	if len(tokens.Registry) != 1 {
+		return types.Token{}, sdk.Dec{}, types.ErrNotRegisteredToken.Wrap(denom)
+	}
+	market, err := queryClient.MarketSummary(cmd.Context(), &types.QueryMarketSummary{Denom: denom})
+	if err != nil {
+		return types.Token{}, sdk.Dec{}, err
+	}
+	if market.OraclePrice == nil || !market.OraclePrice.IsPositive() {
+		return types.Token{}, sdk.Dec{}, types.ErrInvalidOraclePrice.Wrap(denom)
+	}
+	return tokens.Registry[0], *market.OraclePrice, nil
+}
+
 // GetCmdLiquidate creates a Cobra command to generate or broadcast a
 // transaction with a MsgLiquidate message.
 func GetCmdLiquidate() *cobra.Command {
@@ -277,9 +735,13 @@ func GetCmdLiquidate() *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`
 Liquidate up to a specified amount of a borrower's debt for a chosen reward denomination.
+An optional guard price, formatted as [price][base denom], causes the liquidation to revert
+if the oracle price (in USD per symbol denom unit) of the guard denom is above the guard price.
 
 Example:
-$ umeed tx leverage liquidate %s  50000000uumee u/uumee --from mykey`,
+$ umeed tx leverage liquidate %s  50000000uumee u/uumee --from mykey
+$ umeed tx leverage liquidate %s  50000000uumee u/uumee --guard-price 0.005uumee --from mykey`,
+				"umee1qqy7cst5qm83ldupph2dcq0wypprkfpc9l3jg2",
 				"umee1qqy7cst5qm83ldupph2dcq0wypprkfpc9l3jg2",
 			),
 		),
@@ -303,6 +765,20 @@ $ umeed tx leverage liquidate %s  50000000uumee u/uumee --from mykey`,
 			rewardDenom := args[2]
 
 			msg := types.NewMsgLiquidate(clientCtx.GetFromAddress(), borrowerAddr, asset, rewardDenom)
+
+			guard, err := cmd.Flags().GetString(FlagGuardPrice)
+			if err != nil {
+				return err
+			}
+			if guard != "" {
+				guardPrice, err := sdk.ParseDecCoin(guard)
+				if err != nil {
+					return err
+				}
+				msg.GuardDenom = guardPrice.Denom
+				msg.GuardPrice = &guardPrice.Amount
+			}
+
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -311,6 +787,8 @@ $ umeed tx leverage liquidate %s  50000000uumee u/uumee --from mykey`,
 		},
 	}
 
+	cmd.Flags().String(FlagGuardPrice, "",
+		"Revert if the oracle price of a base denom is above this value, formatted as [price][base denom]")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -344,3 +822,244 @@ func GetCmdSupplyCollateral() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdWithdrawReserves creates a Cobra command to generate or broadcast a
+// transaction with a governance proposal containing a MsgWithdrawReserves message.
+func GetCmdWithdrawReserves() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-reserves [recipient] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a governance proposal to send reserves of a base token to a recipient",
+		Long: `Submit a governance proposal to send reserves of a base token to a recipient.
+The reserves are only withdrawn if the proposal passes. An amount of zero (e.g. 0uumee)
+withdraws all reserves of the denom.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			asset, err := parseAmount(cmd, clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+			deposit, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			depositCoins, err := sdk.ParseCoinsNormalized(deposit)
+			if err != nil {
+				return err
+			}
+
+			authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+			msg := types.NewMsgWithdrawReserves(authority, title, description, args[0], asset)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			proposal, err := govv1.NewMsgSubmitProposal(
+				[]sdk.Msg{msg}, depositCoins, clientCtx.GetFromAddress().String(), "",
+			)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "The proposal title")
+	cmd.Flags().String(govcli.FlagDescription, "", "The proposal description")
+	cmd.Flags().String(govcli.FlagDeposit, "", "The proposal deposit")
+	addDisplayUnitsFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSetOracleSymbol creates a Cobra command to generate or broadcast a
+// transaction with a governance proposal containing a MsgSetOracleSymbol message.
+func GetCmdSetOracleSymbol() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-oracle-symbol [denom] [symbol-denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a governance proposal to change the oracle symbol used to price a registered token",
+		Long: `Submit a governance proposal to change the oracle symbol used to price a registered token.
+The symbol is only changed if the proposal passes, and cannot already belong to another registered token.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+			deposit, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			depositCoins, err := sdk.ParseCoinsNormalized(deposit)
+			if err != nil {
+				return err
+			}
+
+			authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+			msg := types.NewMsgSetOracleSymbol(authority, title, description, args[0], args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			proposal, err := govv1.NewMsgSubmitProposal(
+				[]sdk.Msg{msg}, depositCoins, clientCtx.GetFromAddress().String(), "",
+			)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "The proposal title")
+	cmd.Flags().String(govcli.FlagDescription, "", "The proposal description")
+	cmd.Flags().String(govcli.FlagDeposit, "", "The proposal deposit")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSetAccountBorrowCap creates a Cobra command to generate or broadcast a
+// transaction with a governance proposal containing a MsgSetAccountBorrowCap message.
+func GetCmdSetAccountBorrowCap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-account-borrow-cap [address] [borrow-cap]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a governance proposal to set the maximum USD value an account can borrow",
+		Long: `Submit a governance proposal to set the maximum USD value an account can borrow.
+A borrow cap of zero clears any existing cap on the account.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			borrowCap, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+			deposit, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			depositCoins, err := sdk.ParseCoinsNormalized(deposit)
+			if err != nil {
+				return err
+			}
+
+			authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+			msg := types.NewMsgSetAccountBorrowCap(authority, title, description, args[0], borrowCap)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			proposal, err := govv1.NewMsgSubmitProposal(
+				[]sdk.Msg{msg}, depositCoins, clientCtx.GetFromAddress().String(), "",
+			)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "The proposal title")
+	cmd.Flags().String(govcli.FlagDescription, "", "The proposal description")
+	cmd.Flags().String(govcli.FlagDeposit, "", "The proposal deposit")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// checkWithdrawImpact queries whether withdrawing a uToken amount would require collateral
+// bonded to incentive programs, and returns an error if it would.
+func checkWithdrawImpact(cmd *cobra.Command, clientCtx client.Context, asset sdk.Coin) error {
+	queryClient := types.NewQueryClient(clientCtx)
+	resp, err := queryClient.WithdrawImpact(cmd.Context(), &types.QueryWithdrawImpact{
+		Address: clientCtx.GetFromAddress().String(),
+		Asset:   asset,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.ForfeitsIncentives {
+		return fmt.Errorf(
+			"withdrawing %s takes %s from collateral, but %s is bonded to incentive programs; "+
+				"unbonding it would forfeit incentive eligibility and %s pending rewards",
+			asset, resp.FromCollateral, resp.Bonded, resp.PendingRewards)
+	}
+	return nil
+}
+
+// addDisplayUnitsFlag adds the --display-units flag to a command which takes an amount argument.
+func addDisplayUnitsFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool(FlagDisplayUnits, false,
+		"Interpret the amount in display units (e.g. 1.5uumee means 1.5 UMEE) using the registered token exponent")
+}
+
+// parseAmount parses a coin argument. If the --display-units flag is set, the numeric part of the argument is
+// interpreted in the token's display denomination, and converted to base units using the exponent of the
+// token found by querying the token registry. The denom may be a base token or a uToken.
+func parseAmount(cmd *cobra.Command, clientCtx client.Context, arg string) (sdk.Coin, error) {
+	displayUnits, err := cmd.Flags().GetBool(FlagDisplayUnits)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if !displayUnits {
+		return sdk.ParseCoinNormalized(arg)
+	}
+
+	display, err := sdk.ParseDecCoin(arg)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	baseDenom := display.Denom
+	if types.HasUTokenPrefix(baseDenom) {
+		baseDenom = types.ToTokenDenom(baseDenom)
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	resp, err := queryClient.RegisteredTokens(cmd.Context(), &types.QueryRegisteredTokens{BaseDenom: baseDenom})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if len(resp.Registry) != 1 {
+		return sdk.Coin{}, types.ErrNotRegisteredToken.Wrap(baseDenom)
+	}
+
+	amount, err := resp.Registry[0].DisplayToBaseAmount(display.Amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.NewCoin(display.Denom, amount), nil
+}
@@ -0,0 +1,148 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/umee-network/umee/v5/x/leverage/types"
+)
+
+const (
+	// maxWatchBackoff is the longest time the liquidation watcher waits after repeated RPC errors.
+	maxWatchBackoff = 5 * time.Minute
+	// webhookTimeout is the maximum duration of a single webhook request.
+	webhookTimeout = 10 * time.Second
+)
+
+// GetCmdWatchLiquidations creates a Cobra command which polls for liquidation
+// targets and reports newly eligible borrowers.
+func GetCmdWatchLiquidations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch-liquidations",
+		Args:  cobra.NoArgs,
+		Short: "Poll for liquidation targets and print borrowers which become eligible for liquidation",
+		Long: `Poll for liquidation targets and print borrowers which become eligible for liquidation.
+Each newly eligible borrower is printed once, until it stops being a target. If --webhook is set,
+new targets are also sent to that URL as a JSON POST request of the form {"targets": [...]}.
+RPC errors are retried with exponential backoff. The queried node must have liquidator queries enabled.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			interval, err := cmd.Flags().GetDuration(FlagInterval)
+			if err != nil {
+				return err
+			}
+			if interval <= 0 {
+				return fmt.Errorf("--%s must be positive", FlagInterval)
+			}
+			webhook, err := cmd.Flags().GetString(FlagWebhook)
+			if err != nil {
+				return err
+			}
+
+			ctx := cmd.Context()
+			if ctx == nil {
+				ctx = context.Background()
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			w := liquidationWatcher{seen: map[string]bool{}}
+			wait, backoff := time.Duration(0), interval
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-time.After(wait):
+				}
+
+				resp, err := queryClient.LiquidationTargets(ctx, &types.QueryLiquidationTargets{})
+				if err != nil {
+					cmd.PrintErrf("failed to query liquidation targets, retrying in %s: %s\n", backoff, err)
+					wait, backoff = backoff, nextWatchBackoff(backoff)
+					continue
+				}
+				wait, backoff = interval, interval
+
+				newTargets := w.update(resp.Targets)
+				for _, t := range newTargets {
+					fmt.Fprintln(cmd.OutOrStdout(), t)
+				}
+				if webhook != "" && len(newTargets) > 0 {
+					if err := postTargets(ctx, webhook, newTargets); err != nil {
+						cmd.PrintErrf("failed to notify webhook: %s\n", err)
+					}
+				}
+			}
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Duration(FlagInterval, 10*time.Second, "Time between liquidation target queries")
+	cmd.Flags().String(FlagWebhook, "", "URL to receive new liquidation targets as a JSON POST request")
+
+	return cmd
+}
+
+// liquidationWatcher tracks the liquidation targets seen in the most recent query.
+type liquidationWatcher struct {
+	seen map[string]bool
+}
+
+// update replaces the watcher's set of seen targets and returns the targets which were
+// not seen in the previous update, in their original order.
+func (w *liquidationWatcher) update(targets []string) []string {
+	current := make(map[string]bool, len(targets))
+	newTargets := []string{}
+	for _, t := range targets {
+		if !w.seen[t] && !current[t] {
+			newTargets = append(newTargets, t)
+		}
+		current[t] = true
+	}
+	w.seen = current
+	return newTargets
+}
+
+// nextWatchBackoff doubles a backoff duration, up to maxWatchBackoff.
+func nextWatchBackoff(d time.Duration) time.Duration {
+	if d*2 > maxWatchBackoff {
+		return maxWatchBackoff
+	}
+	return d * 2
+}
+
+// postTargets sends liquidation targets to a webhook URL as JSON.
+func postTargets(ctx context.Context, url string, targets []string) error {
+	body, err := json.Marshal(map[string][]string{"targets": targets})
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %s", resp.Status)
+	}
+	return nil
+}
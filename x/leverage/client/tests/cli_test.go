@@ -21,5 +21,10 @@ func TestIntegrationTestSuite(t *testing.T) {
 
 	// queries
 	s.TestInvalidQueries()
+	s.TestQueryParamsTable()
+
+	// generated transactions
+	s.TestTxTimeoutHeight()
+	s.TestQuickBorrow()
 	s.TestLeverageScenario()
 }
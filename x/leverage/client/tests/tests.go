@@ -1,7 +1,15 @@
 package tests
 
 import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	"gotest.tools/v3/assert"
 
 	appparams "github.com/umee-network/umee/v5/app/params"
 	itestsuite "github.com/umee-network/umee/v5/tests/cli"
@@ -67,6 +75,107 @@ func (s *IntegrationTests) TestInvalidQueries() {
 	s.RunTestQueries(invalidQueries...)
 }
 
+func (s *IntegrationTests) TestTxTimeoutHeight() {
+	val := s.Network.Validators[0]
+	const timeoutHeight = 1000
+
+	txs := []itestsuite.TestTransaction{
+		{
+			Name:    "supply",
+			Command: cli.GetCmdSupply(),
+			Args:    []string{"1000uumee"},
+		},
+		{
+			Name:    "supply collateral",
+			Command: cli.GetCmdSupplyCollateral(),
+			Args:    []string{"1000uumee"},
+		},
+		{
+			Name:    "borrow",
+			Command: cli.GetCmdBorrow(),
+			Args:    []string{"100uumee"},
+		},
+		{
+			Name:    "repay",
+			Command: cli.GetCmdRepay(),
+			Args:    []string{"100uumee"},
+		},
+		{
+			Name:    "withdraw max",
+			Command: cli.GetCmdMaxWithdraw(),
+			Args:    []string{"uumee"},
+		},
+		{
+			Name:    "liquidate",
+			Command: cli.GetCmdLiquidate(),
+			Args:    []string{val.Address.String(), "100uumee", "uumee"},
+		},
+	}
+
+	// Generated transactions carry the timeout height, so they cannot be included after it
+	for _, tx := range txs {
+		generated := s.GenerateTransaction(tx, fmt.Sprintf("--%s=%d", flags.FlagTimeoutHeight, timeoutHeight))
+		withTimeout, ok := generated.(sdk.TxWithTimeoutHeight)
+		assert.Assert(s.T, ok, tx.Name)
+		assert.Equal(s.T, uint64(timeoutHeight), withTimeout.GetTimeoutHeight(), tx.Name)
+	}
+}
+
+func (s *IntegrationTests) TestQueryParamsTable() {
+	clientCtx := s.Network.Validators[0].ClientCtx
+	params := types.DefaultParams()
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryParamsTable(), []string{})
+	assert.NilError(s.T, err)
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Equal(s.T, len(params.ParamSetPairs()), len(lines))
+	assert.Equal(s.T, "complete_liquidation_threshold = "+params.CompleteLiquidationThreshold.String(), lines[0])
+	assert.Equal(s.T, "zero_price_policy = "+params.ZeroPricePolicy.String(), lines[5])
+	assert.Equal(s.T, "borrow_paused = false", lines[8])
+
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryParamsTable(),
+		[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	assert.NilError(s.T, err)
+	table := map[string]string{}
+	assert.NilError(s.T, json.Unmarshal(out.Bytes(), &table))
+	assert.Equal(s.T, len(params.ParamSetPairs()), len(table))
+	assert.Equal(s.T, params.SmallLiquidationSize.String(), table["small_liquidation_size"])
+	assert.Equal(s.T, "0", table["borrow_cooldown_blocks"])
+}
+
+func (s *IntegrationTests) TestQuickBorrow() {
+	clientCtx := s.Network.Validators[0].ClientCtx
+
+	// a borrow within the estimated limit generates a supply collateral and a borrow message
+	generated := s.GenerateTransaction(itestsuite.TestTransaction{
+		Name:    "quick borrow",
+		Command: cli.GetCmdQuickBorrow(),
+		Args:    []string{"1000000000uumee", "100uumee"},
+	})
+	msgs := generated.GetMsgs()
+	assert.Equal(s.T, 2, len(msgs))
+	assert.DeepEqual(s.T, sdk.NewInt64Coin(appparams.BondDenom, 1000000000), msgs[0].(*types.MsgSupplyCollateral).Asset)
+	assert.DeepEqual(s.T, sdk.NewInt64Coin(appparams.BondDenom, 100), msgs[1].(*types.MsgBorrow).Asset)
+
+	// a borrow above the estimated limit is rejected, and the maximum is reported
+	_, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQuickBorrow(), []string{
+		"1000000000uumee", "1000000000000uumee",
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.Network.Validators[0].Address),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	})
+	assert.ErrorContains(s.T, err, "exceeds the estimated maximum")
+
+	// with --max, the estimated maximum is borrowed instead
+	generated = s.GenerateTransaction(itestsuite.TestTransaction{
+		Name:    "quick borrow max",
+		Command: cli.GetCmdQuickBorrow(),
+		Args:    []string{"1000000000uumee", "1000000000000uumee", fmt.Sprintf("--%s", cli.FlagMax)},
+	})
+	borrowed := generated.GetMsgs()[1].(*types.MsgBorrow).Asset
+	assert.Assert(s.T, borrowed.IsPositive())
+	assert.Assert(s.T, borrowed.Amount.LT(sdk.NewInt(1000000000000)))
+}
+
 func (s *IntegrationTests) TestLeverageScenario() {
 	val := s.Network.Validators[0]
 
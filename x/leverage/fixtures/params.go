@@ -14,5 +14,19 @@ func Params() types.Params {
 		OracleRewardFactor:           sdk.MustNewDecFromStr("0.01"),
 		SmallLiquidationSize:         sdk.MustNewDecFromStr("100.00"),
 		DirectLiquidationFee:         sdk.MustNewDecFromStr("0.1"),
+		ZeroPricePolicy:              types.ZeroPricePolicy_ZERO_PRICE_POLICY_EXCLUDE,
+		BorrowCooldownBlocks:         0,
+		MaxWithdrawRatePerBlock:      sdk.ZeroDec(),
+		BorrowPaused:                 false,
+		SupplyPaused:                 false,
+		LiquidationDustThreshold:     sdk.ZeroDec(),
+		CompoundingMode:              types.CompoundingMode_COMPOUNDING_MODE_CONTINUOUS,
+		ProtocolLiquidationShare:     sdk.ZeroDec(),
+		MaxAccountLeverage:           sdk.ZeroDec(),
+		UtilizationSmoothingFactor:   sdk.OneDec(),
+		CircuitBreakerDeviation:      sdk.ZeroDec(),
+		CircuitBreakerCooldownBlocks: 0,
+		LiquidationGracePeriod:       0,
+		BadDebtHistorySize:           1000,
 	}
 }
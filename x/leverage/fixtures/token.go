@@ -36,5 +36,7 @@ func Token(base, symbol string, exponent uint32) types.Token {
 		MinCollateralLiquidity: sdk.MustNewDecFromStr("0"),
 		MaxSupply:              sdk.NewInt(100_000_000000),
 		HistoricMedians:        24,
+		WithdrawFee:            sdk.ZeroDec(),
+		LiquidityHaircut:       sdk.ZeroDec(),
 	}
 }
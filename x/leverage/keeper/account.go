@@ -0,0 +1,383 @@
+package keeper
+
+import (
+	"errors"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v5/util/coin"
+	"github.com/umee-network/umee/v5/x/leverage/types"
+)
+
+// AccountEquity computes an account's net equity in USD, which is the value of everything it has supplied
+// (including collateral) minus the value of its borrows, using spot prices. Also returns the account's total
+// collateral value and its leverage ratio (collateral value / equity). The leverage ratio is
+// types.InfiniteLeverageRatio when equity is not positive. Assets missing oracle prices are skipped.
+func (k Keeper) AccountEquity(ctx sdk.Context, addr sdk.AccAddress) (equity, collateralValue, leverage sdk.Dec,
+	err error,
+) {
+	supplied, err := k.GetAllSupplied(ctx, addr)
+	if err != nil {
+		return sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), err
+	}
+	collateral := k.GetBorrowerCollateral(ctx, addr)
+	borrowed := k.GetBorrowerBorrows(ctx, addr)
+
+	suppliedValue, err := k.VisibleTokenValue(ctx, supplied, types.PriceModeSpot)
+	if err != nil {
+		return sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), err
+	}
+	borrowedValue, err := k.VisibleTokenValue(ctx, borrowed, types.PriceModeSpot)
+	if err != nil {
+		return sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), err
+	}
+	collateralValue, err = k.VisibleCollateralValue(ctx, collateral)
+	if err != nil {
+		return sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), err
+	}
+
+	equity = suppliedValue.Sub(borrowedValue)
+	if !equity.IsPositive() {
+		return equity, collateralValue, types.InfiniteLeverageRatio, nil
+	}
+	return equity, collateralValue, collateralValue.Quo(equity), nil
+}
+
+// AccountNetAPY estimates an account's annual net income in USD, which is its supply interest income plus
+// incentive income on bonded collateral minus its borrow interest cost, at current interest rates and spot
+// prices. Also returns each of those components, and the account's net APY, which is net income divided by
+// equity. The net APY is zero when equity is not positive. Assets missing oracle prices are skipped.
+func (k Keeper) AccountNetAPY(ctx sdk.Context, addr sdk.AccAddress) (netAPY, equity, supplyIncome,
+	incentiveIncome, borrowCost sdk.Dec, err error,
+) {
+	zero := sdk.ZeroDec()
+	supplied, err := k.GetAllSupplied(ctx, addr)
+	if err != nil {
+		return zero, zero, zero, zero, zero, err
+	}
+
+	supplyIncome = sdk.ZeroDec()
+	for _, c := range supplied {
+		v, err := k.VisibleTokenValue(ctx, sdk.NewCoins(c), types.PriceModeSpot)
+		if err != nil {
+			return zero, zero, zero, zero, zero, err
+		}
+		supplyIncome = supplyIncome.Add(v.Mul(k.DeriveSupplyAPY(ctx, c.Denom)))
+	}
+
+	incentiveIncome = sdk.ZeroDec()
+	for _, c := range k.GetBorrowerCollateral(ctx, addr) {
+		v, err := k.incentiveIncome(ctx, addr, c.Denom)
+		if err != nil {
+			return zero, zero, zero, zero, zero, err
+		}
+		incentiveIncome = incentiveIncome.Add(v)
+	}
+
+	borrowCost = sdk.ZeroDec()
+	for _, c := range k.GetBorrowerBorrows(ctx, addr) {
+		v, err := k.VisibleTokenValue(ctx, sdk.NewCoins(c), types.PriceModeSpot)
+		if err != nil {
+			return zero, zero, zero, zero, zero, err
+		}
+		borrowCost = borrowCost.Add(v.Mul(k.DeriveBorrowAPY(ctx, c.Denom)))
+	}
+
+	equity, _, _, err = k.AccountEquity(ctx, addr)
+	if err != nil {
+		return zero, zero, zero, zero, zero, err
+	}
+
+	netAPY = sdk.ZeroDec()
+	if equity.IsPositive() {
+		netAPY = supplyIncome.Add(incentiveIncome).Sub(borrowCost).Quo(equity)
+	}
+	return netAPY, equity, supplyIncome, incentiveIncome, borrowCost, nil
+}
+
+// AccountEffectiveBorrowRate computes the borrow APY an account pays across all of its borrows, which is its
+// annual borrow interest cost divided by its borrowed value, at current interest rates and spot prices. Also
+// returns the borrowed value, the borrow cost, and the borrowed value and APY of each borrowed denom. The
+// rate is zero when the account has no borrowed value. Borrows missing oracle prices are skipped.
+func (k Keeper) AccountEffectiveBorrowRate(ctx sdk.Context, addr sdk.AccAddress) (rate, borrowedValue,
+	borrowCost sdk.Dec, components []types.BorrowRateComponent, err error,
+) {
+	zero := sdk.ZeroDec()
+	borrowedValue = sdk.ZeroDec()
+	borrowCost = sdk.ZeroDec()
+	components = []types.BorrowRateComponent{}
+	for _, c := range k.GetBorrowerBorrows(ctx, addr) {
+		v, err := k.VisibleTokenValue(ctx, sdk.NewCoins(c), types.PriceModeSpot)
+		if err != nil {
+			return zero, zero, zero, nil, err
+		}
+		apy := k.DeriveBorrowAPY(ctx, c.Denom)
+		borrowedValue = borrowedValue.Add(v)
+		borrowCost = borrowCost.Add(v.Mul(apy))
+		components = append(components, types.BorrowRateComponent{
+			Denom:         c.Denom,
+			BorrowedValue: v,
+			Borrow_APY:    apy,
+		})
+	}
+
+	rate = sdk.ZeroDec()
+	if borrowedValue.IsPositive() {
+		rate = borrowCost.Quo(borrowedValue)
+	}
+	return rate, borrowedValue, borrowCost, components, nil
+}
+
+// BorrowableMarkets computes, for each registered token with borrowing enabled, the maximum amount an
+// account can borrow given its borrow limit and the token's liquidity, as MsgMaxBorrow does. Tokens
+// with NoSelfBorrow which the account has collateralized, or which are missing required prices, have
+// zero capacity. Markets with zero capacity are omitted unless includeZero is true.
+func (k Keeper) BorrowableMarkets(ctx sdk.Context, addr sdk.AccAddress, includeZero bool,
+) ([]types.BorrowableMarket, error) {
+	markets := []types.BorrowableMarket{}
+	for _, t := range k.GetAllRegisteredTokens(ctx) {
+		if t.Blacklist || !t.EnableMsgBorrow {
+			continue
+		}
+		maxBorrow, err := k.tokenMaxBorrow(ctx, addr, t.BaseDenom)
+		if err != nil {
+			return nil, err
+		}
+		if maxBorrow.IsPositive() || includeZero {
+			markets = append(markets, types.BorrowableMarket{Denom: t.BaseDenom, MaxBorrow: maxBorrow})
+		}
+	}
+	return markets, nil
+}
+
+// tokenMaxBorrow returns the minimum of userMaxBorrow and moduleMaxBorrow for a single borrowable token,
+// or zero if the account cannot borrow it due to NoSelfBorrow. Missing prices result in zero.
+func (k Keeper) tokenMaxBorrow(ctx sdk.Context, addr sdk.AccAddress, denom string) (sdk.Coin, error) {
+	if err := k.validateNotSelfBorrow(ctx, addr, denom); err != nil {
+		if errors.Is(err, types.ErrSelfBorrow) {
+			return coin.Zero(denom), nil
+		}
+		return sdk.Coin{}, err
+	}
+	userMax, err := k.userMaxBorrow(ctx, addr, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if userMax.IsZero() {
+		return coin.Zero(denom), nil
+	}
+	moduleMax, err := k.moduleMaxBorrow(ctx, denom)
+	if nonOracleError(err) {
+		return sdk.Coin{}, err
+	}
+	if err != nil {
+		return coin.Zero(denom), nil
+	}
+	return sdk.NewCoin(denom, sdk.MinInt(userMax.Amount, moduleMax)), nil
+}
+
+// RemainingCapacity computes the USD value an account can still borrow, which is its borrow limit minus
+// its borrowed value as computed by BorrowUtilization, floored at zero. It also returns the amount of each
+// borrow-enabled token the account can borrow, as BorrowableMarkets does with zero capacity markets included.
+func (k Keeper) RemainingCapacity(ctx sdk.Context, addr sdk.AccAddress) (remaining, borrowedValue,
+	borrowLimit sdk.Dec, markets []types.BorrowableMarket, err error,
+) {
+	_, borrowedValue, borrowLimit, _, err = k.BorrowUtilization(ctx, addr)
+	if err != nil {
+		return sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), nil, err
+	}
+	markets, err = k.BorrowableMarkets(ctx, addr, true)
+	if err != nil {
+		return sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), nil, err
+	}
+	remaining = sdk.MaxDec(borrowLimit.Sub(borrowedValue), sdk.ZeroDec())
+	return remaining, borrowedValue, borrowLimit, markets, nil
+}
+
+// AccountMarkets returns the base token denoms in which an account has nonzero supplied, collateral,
+// or borrowed amounts, sorted by denom. Only the account's own balances, collateral and borrows are
+// read, so the cost does not depend on the size of the token registry.
+func (k Keeper) AccountMarkets(ctx sdk.Context, addr sdk.AccAddress) []types.AccountMarket {
+	markets := map[string]*types.AccountMarket{}
+	market := func(denom string) *types.AccountMarket {
+		if _, ok := markets[denom]; !ok {
+			markets[denom] = &types.AccountMarket{Denom: denom}
+		}
+		return markets[denom]
+	}
+
+	for _, c := range k.GetBorrowerCollateral(ctx, addr) {
+		m := market(types.ToTokenDenom(c.Denom))
+		m.Supplied = true
+		m.Collateral = true
+	}
+	for _, c := range k.bankKeeper.GetAllBalances(ctx, addr) {
+		if types.HasUTokenPrefix(c.Denom) {
+			market(types.ToTokenDenom(c.Denom)).Supplied = true
+		}
+	}
+	for _, c := range k.GetBorrowerBorrows(ctx, addr) {
+		market(c.Denom).Borrowed = true
+	}
+
+	result := make([]types.AccountMarket, 0, len(markets))
+	for _, m := range markets {
+		result = append(result, *m)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Denom < result[j].Denom
+	})
+	return result
+}
+
+// FilterAccountMarkets removes the markets in which an account's supplied value plus borrowed value,
+// using spot prices, is below minValue. Markets with missing prices are kept. Returns the remaining
+// markets and the number of markets removed.
+func (k Keeper) FilterAccountMarkets(ctx sdk.Context, addr sdk.AccAddress, markets []types.AccountMarket,
+	minValue sdk.Dec,
+) ([]types.AccountMarket, uint64, error) {
+	result := []types.AccountMarket{}
+	omitted := uint64(0)
+	for _, m := range markets {
+		supplied, err := k.GetSupplied(ctx, addr, m.Denom)
+		if err != nil {
+			return nil, 0, err
+		}
+		value, err := k.TokenValue(ctx, supplied.Add(k.GetBorrow(ctx, addr, m.Denom)), types.PriceModeSpot)
+		if nonOracleError(err) {
+			return nil, 0, err
+		}
+		if err == nil && value.LT(minValue) {
+			omitted++
+			continue
+		}
+		result = append(result, m)
+	}
+	return result, omitted, nil
+}
+
+// BorrowUtilization computes an account's borrowed value as a fraction of its borrow limit, using the same
+// prices as borrow limit checks. The utilization is zero when the borrow limit is zero, and is capped at
+// types.MaxBorrowUtilization when the account is over its borrow limit. Assets missing prices are skipped.
+func (k Keeper) BorrowUtilization(ctx sdk.Context, addr sdk.AccAddress) (utilization, borrowedValue,
+	borrowLimit sdk.Dec, overLimit bool, err error,
+) {
+	borrowed := k.GetBorrowerBorrows(ctx, addr)
+	collateral := k.GetBorrowerCollateral(ctx, addr)
+
+	borrowedValue, err = k.VisibleTokenValue(ctx, borrowed, types.PriceModeHigh)
+	if err != nil {
+		return sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), false, err
+	}
+	borrowLimit, err = k.VisibleBorrowLimit(ctx, collateral)
+	if err != nil {
+		return sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), false, err
+	}
+
+	overLimit = borrowedValue.GT(borrowLimit)
+	switch {
+	case !borrowLimit.IsPositive():
+		utilization = sdk.ZeroDec()
+	case overLimit:
+		utilization = types.MaxBorrowUtilization
+	default:
+		utilization = borrowedValue.Quo(borrowLimit)
+	}
+	return utilization, borrowedValue, borrowLimit, overLimit, nil
+}
+
+// netPositions computes, for each denom present in both supplied and borrowed, the spot value of the
+// supplied amount minus the spot value of the borrowed amount. Denoms missing oracle prices are skipped.
+// Results are sorted by denom, because sdk.Coins are.
+func (k Keeper) netPositions(ctx sdk.Context, supplied, borrowed sdk.Coins) ([]types.NetPosition, error) {
+	positions := []types.NetPosition{}
+	for _, b := range borrowed {
+		s := sdk.NewCoin(b.Denom, supplied.AmountOf(b.Denom))
+		if s.IsZero() {
+			continue
+		}
+
+		suppliedValue, err := k.VisibleTokenValue(ctx, sdk.NewCoins(s), types.PriceModeSpot)
+		if err != nil {
+			return nil, err
+		}
+		borrowedValue, err := k.VisibleTokenValue(ctx, sdk.NewCoins(b), types.PriceModeSpot)
+		if err != nil {
+			return nil, err
+		}
+		if suppliedValue.IsZero() && borrowedValue.IsZero() {
+			// price is missing
+			continue
+		}
+
+		net := suppliedValue.Sub(borrowedValue)
+		positions = append(positions, types.NetPosition{
+			Denom:       b.Denom,
+			NetValue:    net,
+			NetPositive: !net.IsNegative(),
+		})
+	}
+	return positions, nil
+}
+
+// CollateralPriceFloors computes, for each of an account's collateral tokens, the spot price below which
+// the account would become eligible for liquidation if all other prices stayed the same. Liquidation
+// threshold and borrowed value are both linear in a single token's price, so each floor is found by
+// solving for the price at which they are equal. Borrows of the same token are accounted for.
+// A floor of zero means that no drop in that token's price alone makes the account eligible.
+// Returns an error if any of the account's non-blacklisted collateral or borrowed tokens are missing prices.
+func (k Keeper) CollateralPriceFloors(ctx sdk.Context, addr sdk.AccAddress) ([]types.CollateralPriceFloor, error) {
+	borrowed := k.GetBorrowerBorrows(ctx, addr)
+	collateral := k.GetBorrowerCollateral(ctx, addr)
+
+	borrowedValue, err := k.TotalTokenValue(ctx, borrowed, types.PriceModeSpot)
+	if err != nil {
+		return nil, err
+	}
+	liquidationThreshold, err := k.CalculateLiquidationThreshold(ctx, collateral)
+	if err != nil {
+		return nil, err
+	}
+
+	floors := []types.CollateralPriceFloor{}
+	for _, c := range collateral {
+		denom := types.ToTokenDenom(c.Denom)
+		ts, err := k.GetTokenSettings(ctx, denom)
+		if err != nil {
+			return nil, err
+		}
+		if ts.Blacklist {
+			// blacklisted collateral does not count towards liquidation threshold
+			floors = append(floors, types.CollateralPriceFloor{Denom: denom, PriceFloor: sdk.ZeroDec()})
+			continue
+		}
+		price, _, err := k.TokenPrice(ctx, denom, types.PriceModeSpot)
+		if err != nil {
+			return nil, err
+		}
+		thisThreshold, err := k.CalculateLiquidationThreshold(ctx, sdk.NewCoins(c))
+		if err != nil {
+			return nil, err
+		}
+		thisBorrowed, err := k.TotalTokenValue(ctx, sdk.NewCoins(sdk.NewCoin(denom, borrowed.AmountOf(denom))),
+			types.PriceModeSpot)
+		if err != nil {
+			return nil, err
+		}
+
+		// at price p, the account is eligible for liquidation once
+		//   otherThreshold + p * thresholdPerPrice < otherBorrowed + p * borrowedPerPrice
+		thresholdPerPrice := thisThreshold.Quo(price)
+		borrowedPerPrice := thisBorrowed.Quo(price)
+		otherDeficit := borrowedValue.Sub(thisBorrowed).Sub(liquidationThreshold.Sub(thisThreshold))
+		slope := thresholdPerPrice.Sub(borrowedPerPrice)
+
+		floor := sdk.ZeroDec()
+		if slope.IsPositive() && otherDeficit.IsPositive() {
+			floor = otherDeficit.Quo(slope)
+		}
+		floors = append(floors, types.CollateralPriceFloor{Denom: denom, PriceFloor: floor})
+	}
+	return floors, nil
+}
@@ -99,8 +99,10 @@ func (k Keeper) SupplyUtilization(ctx sdk.Context, denom string) sdk.Dec {
 // collateral sdk.Coins, using each token's uToken exchange rate and collateral weight.
 // The lower of spot price or historic price is used for each collateral token.
 // An error is returned if any input coins are not uTokens or if value calculation fails.
+// Under ZeroPricePolicy TREAT_AS_UNBORROWABLE, collateral missing prices is valued at zero instead.
 func (k Keeper) CalculateBorrowLimit(ctx sdk.Context, collateral sdk.Coins) (sdk.Dec, error) {
 	limit := sdk.ZeroDec()
+	policy := k.GetParams(ctx).ZeroPricePolicy
 
 	for _, coin := range collateral {
 		// convert uToken collateral to base assets
@@ -118,7 +120,7 @@ func (k Keeper) CalculateBorrowLimit(ctx sdk.Context, collateral sdk.Coins) (sdk
 		if !ts.Blacklist {
 			// get USD value of base assets using the chosen price mode
 			v, err := k.TokenValue(ctx, baseAsset, types.PriceModeLow)
-			if err != nil {
+			if err = collateralPriceError(err, policy); err != nil {
 				return sdk.ZeroDec(), err
 			}
 			// add each collateral coin's weighted value to borrow limit
@@ -135,8 +137,10 @@ func (k Keeper) CalculateBorrowLimit(ctx sdk.Context, collateral sdk.Coins) (sdk
 // An error is returned if any input coins are not uTokens.
 // This function skips assets that are missing prices, which will lead to a lower borrow
 // limit when prices are down instead of a complete loss of borrowing ability.
+// Under ZeroPricePolicy ERROR, missing prices cause an error instead.
 func (k Keeper) VisibleBorrowLimit(ctx sdk.Context, collateral sdk.Coins) (sdk.Dec, error) {
 	limit := sdk.ZeroDec()
+	policy := k.GetParams(ctx).ZeroPricePolicy
 
 	for _, coin := range collateral {
 		// convert uToken collateral to base assets
@@ -159,7 +163,7 @@ func (k Keeper) VisibleBorrowLimit(ctx sdk.Context, collateral sdk.Coins) (sdk.D
 				// add collateral coin's weighted value to borrow limit
 				limit = limit.Add(v.Mul(ts.CollateralWeight))
 			}
-			if nonOracleError(err) {
+			if err = visiblePriceError(err, policy); err != nil {
 				return sdk.ZeroDec(), err
 			}
 		}
@@ -168,13 +172,78 @@ func (k Keeper) VisibleBorrowLimit(ctx sdk.Context, collateral sdk.Coins) (sdk.D
 	return limit, nil
 }
 
+// BorrowLimitBreakdown returns the contribution of each of an account's collateral uToken denoms to
+// its borrow limit, sorted by denom, as well as the total borrow limit. Contributions are computed
+// in the same way as VisibleBorrowLimit, so they sum to the total.
+func (k Keeper) BorrowLimitBreakdown(ctx sdk.Context, addr sdk.AccAddress) (
+	[]types.BorrowLimitContribution, sdk.Dec, error,
+) {
+	policy := k.GetParams(ctx).ZeroPricePolicy
+	contributions := []types.BorrowLimitContribution{}
+	total := sdk.ZeroDec()
+
+	for _, coin := range k.GetBorrowerCollateral(ctx, addr) {
+		// convert uToken collateral to base assets
+		baseAsset, err := k.ExchangeUToken(ctx, coin)
+		if err != nil {
+			return nil, sdk.ZeroDec(), err
+		}
+
+		ts, err := k.GetTokenSettings(ctx, baseAsset.Denom)
+		if err != nil {
+			return nil, sdk.ZeroDec(), err
+		}
+
+		contribution := types.BorrowLimitContribution{
+			Denom:            coin.Denom,
+			CollateralValue:  sdk.ZeroDec(),
+			CollateralWeight: ts.CollateralWeight,
+			BorrowLimit:      sdk.ZeroDec(),
+		}
+		// blacklisted tokens contribute nothing
+		if !ts.Blacklist {
+			v, err := k.TokenValue(ctx, baseAsset, types.PriceModeLow)
+			if err == nil {
+				contribution.CollateralValue = v
+				contribution.BorrowLimit = v.Mul(ts.CollateralWeight)
+			}
+			if err = visiblePriceError(err, policy); err != nil {
+				return nil, sdk.ZeroDec(), err
+			}
+		}
+		total = total.Add(contribution.BorrowLimit)
+		contributions = append(contributions, contribution)
+	}
+
+	return contributions, total, nil
+}
+
+// previewCollateralize returns an account's current borrow limit and the borrow limit it would have
+// after collateralizing an additional amount of uTokens. Both are computed as in VisibleBorrowLimit.
+func (k Keeper) previewCollateralize(ctx sdk.Context, addr sdk.AccAddress, uToken sdk.Coin) (
+	current, preview sdk.Dec, err error,
+) {
+	collateral := k.GetBorrowerCollateral(ctx, addr)
+	current, err = k.VisibleBorrowLimit(ctx, collateral)
+	if err != nil {
+		return sdk.ZeroDec(), sdk.ZeroDec(), err
+	}
+	preview, err = k.VisibleBorrowLimit(ctx, collateral.Add(uToken))
+	if err != nil {
+		return sdk.ZeroDec(), sdk.ZeroDec(), err
+	}
+	return current, preview, nil
+}
+
 // CalculateLiquidationThreshold determines the maximum borrowed value (in USD) that a
 // borrower with given collateral could reach before being eligible for liquidation, using
 // each token's oracle price, uToken exchange rate, and liquidation threshold.
 // An error is returned if any input coins are not uTokens or if value
-// calculation fails. Always uses spot prices.
+// calculation fails. Always uses spot prices. Under ZeroPricePolicy TREAT_AS_UNBORROWABLE,
+// collateral missing prices contributes zero instead of causing an error.
 func (k Keeper) CalculateLiquidationThreshold(ctx sdk.Context, collateral sdk.Coins) (sdk.Dec, error) {
 	totalThreshold := sdk.ZeroDec()
+	policy := k.GetParams(ctx).ZeroPricePolicy
 
 	for _, coin := range collateral {
 		// convert uToken collateral to base assets
@@ -192,7 +261,7 @@ func (k Keeper) CalculateLiquidationThreshold(ctx sdk.Context, collateral sdk.Co
 		if !ts.Blacklist {
 			// get USD value of base assets
 			v, err := k.TokenValue(ctx, baseAsset, types.PriceModeSpot)
-			if err != nil {
+			if err = collateralPriceError(err, policy); err != nil {
 				return sdk.ZeroDec(), err
 			}
 
@@ -204,6 +273,89 @@ func (k Keeper) CalculateLiquidationThreshold(ctx sdk.Context, collateral sdk.Co
 	return totalThreshold, nil
 }
 
+// LiquidationThresholdBreakdown returns the contribution of each of an account's collateral uToken
+// denoms to its liquidation threshold, sorted by denom, as well as the total liquidation threshold.
+// Contributions are computed in the same way as CalculateLiquidationThreshold, so they sum to the total.
+func (k Keeper) LiquidationThresholdBreakdown(ctx sdk.Context, addr sdk.AccAddress) (
+	[]types.LiquidationThresholdContribution, sdk.Dec, error,
+) {
+	policy := k.GetParams(ctx).ZeroPricePolicy
+	contributions := []types.LiquidationThresholdContribution{}
+	total := sdk.ZeroDec()
+
+	for _, coin := range k.GetBorrowerCollateral(ctx, addr) {
+		// convert uToken collateral to base assets
+		baseAsset, err := k.ExchangeUToken(ctx, coin)
+		if err != nil {
+			return nil, sdk.ZeroDec(), err
+		}
+
+		ts, err := k.GetTokenSettings(ctx, baseAsset.Denom)
+		if err != nil {
+			return nil, sdk.ZeroDec(), err
+		}
+
+		contribution := types.LiquidationThresholdContribution{
+			Denom:                     coin.Denom,
+			CollateralValue:           sdk.ZeroDec(),
+			TokenLiquidationThreshold: ts.LiquidationThreshold,
+			LiquidationThreshold:      sdk.ZeroDec(),
+		}
+		// blacklisted tokens contribute nothing
+		if !ts.Blacklist {
+			v, err := k.TokenValue(ctx, baseAsset, types.PriceModeSpot)
+			if err == nil {
+				contribution.CollateralValue = v
+				contribution.LiquidationThreshold = v.Mul(ts.LiquidationThreshold)
+			}
+			if err = collateralPriceError(err, policy); err != nil {
+				return nil, sdk.ZeroDec(), err
+			}
+		}
+		total = total.Add(contribution.LiquidationThreshold)
+		contributions = append(contributions, contribution)
+	}
+
+	return contributions, total, nil
+}
+
+// AccountCollateralQuality returns the value-weighted average collateral weight and liquidation threshold
+// of an account's collateral. Collateral weight is weighted by the values used in BorrowLimitBreakdown,
+// and liquidation threshold by those used in LiquidationThresholdBreakdown, so the averages multiplied by
+// total collateral value reproduce the account's borrow limit and liquidation threshold. Collateral which
+// is blacklisted or missing prices is excluded. Both averages are zero if no collateral has value.
+func (k Keeper) AccountCollateralQuality(ctx sdk.Context, addr sdk.AccAddress) (
+	collateralWeight, liquidationThreshold sdk.Dec, err error,
+) {
+	collateralWeight, liquidationThreshold = sdk.ZeroDec(), sdk.ZeroDec()
+
+	weightContributions, borrowLimit, err := k.BorrowLimitBreakdown(ctx, addr)
+	if err != nil {
+		return sdk.ZeroDec(), sdk.ZeroDec(), err
+	}
+	weightedValue := sdk.ZeroDec()
+	for _, c := range weightContributions {
+		weightedValue = weightedValue.Add(c.CollateralValue)
+	}
+	if weightedValue.IsPositive() {
+		collateralWeight = borrowLimit.Quo(weightedValue)
+	}
+
+	thresholdContributions, threshold, err := k.LiquidationThresholdBreakdown(ctx, addr)
+	if err != nil {
+		return sdk.ZeroDec(), sdk.ZeroDec(), err
+	}
+	spotValue := sdk.ZeroDec()
+	for _, c := range thresholdContributions {
+		spotValue = spotValue.Add(c.CollateralValue)
+	}
+	if spotValue.IsPositive() {
+		liquidationThreshold = threshold.Quo(spotValue)
+	}
+
+	return collateralWeight, liquidationThreshold, nil
+}
+
 // checkSupplyUtilization returns the appropriate error if a token denom's
 // supply utilization has exceeded MaxSupplyUtilization
 func (k Keeper) checkSupplyUtilization(ctx sdk.Context, denom string) error {
@@ -219,6 +371,31 @@ func (k Keeper) checkSupplyUtilization(ctx sdk.Context, denom string) error {
 	return nil
 }
 
+// checkAccountLeverage returns ErrMaxAccountLeverage if an account's leverage, which is its collateral
+// value divided by its equity (collateral value minus borrowed value), would exceed a maximum. Accounts
+// whose borrowed value is at least their collateral value have unlimited leverage. Uses spot prices.
+func (k Keeper) checkAccountLeverage(ctx sdk.Context, collateral, borrowed sdk.Coins, maxLeverage sdk.Dec) error {
+	collateralValue, err := k.CalculateCollateralValue(ctx, collateral)
+	if err != nil {
+		return err
+	}
+	borrowedValue, err := k.TotalTokenValue(ctx, borrowed, types.PriceModeSpot)
+	if err != nil {
+		return err
+	}
+
+	equity := collateralValue.Sub(borrowedValue)
+	if !equity.IsPositive() {
+		return types.ErrMaxAccountLeverage.Wrapf(
+			"collateral value: %s, borrowed value: %s", collateralValue, borrowedValue)
+	}
+	leverage := collateralValue.Quo(equity)
+	if leverage.GT(maxLeverage) {
+		return types.ErrMaxAccountLeverage.Wrapf("leverage: %s, max: %s", leverage, maxLeverage)
+	}
+	return nil
+}
+
 // moduleMaxBorrow calculates maximum amount of Token to borrow from the module.
 // The calculation first finds the maximum amount of Token that can be borrowed from the module,
 // respecting the min_collateral_liquidity parameter, then determines the maximum amount of Token that can be borrowed
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v5/util/sdkutil"
+	"github.com/umee-network/umee/v5/x/leverage/types"
+)
+
+// UpdateCircuitBreakers clears expired price circuit breakers, then compares the spot price of each
+// registered token to its historic price, pausing borrowing and liquidations involving any token
+// whose price deviates by more than CircuitBreakerDeviation. Tokens which are blacklisted, exempt
+// from historic pricing, or missing either price are not checked. Called by BeginBlock.
+func (k Keeper) UpdateCircuitBreakers(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	height := ctx.BlockHeight()
+
+	for _, token := range k.GetAllRegisteredTokens(ctx) {
+		expiry := k.getCircuitBreakerExpiry(ctx, token.BaseDenom)
+		if expiry > 0 && height >= expiry {
+			k.setCircuitBreakerExpiry(ctx, token.BaseDenom, 0)
+			expiry = 0
+		}
+
+		if !params.CircuitBreakerDeviation.IsPositive() || token.Blacklist || token.HistoricMedians == 0 {
+			continue
+		}
+		spotPrice, _, err := k.TokenPrice(ctx, token.BaseDenom, types.PriceModeSpot)
+		if err != nil {
+			continue
+		}
+		historicPrice, _, err := k.TokenPrice(ctx, token.BaseDenom, types.PriceModeHistoric)
+		if err != nil || !historicPrice.IsPositive() {
+			continue
+		}
+		deviation := spotPrice.Sub(historicPrice).Abs().Quo(historicPrice)
+		if deviation.LTE(params.CircuitBreakerDeviation) {
+			continue
+		}
+
+		// the token is paused for the current block and the following cooldown blocks
+		newExpiry := height + int64(params.CircuitBreakerCooldownBlocks) + 1
+		k.setCircuitBreakerExpiry(ctx, token.BaseDenom, newExpiry)
+		if expiry == 0 {
+			// Because this action is not caused by a message, logging and
+			// events are here instead of msg_server.go
+			k.Logger(ctx).Info(
+				"price circuit breaker triggered",
+				"denom", token.BaseDenom,
+				"spot_price", spotPrice.String(),
+				"historic_price", historicPrice.String(),
+				"expiry_height", newExpiry,
+			)
+			sdkutil.Emit(&ctx, &types.EventCircuitBreakerTriggered{
+				Denom:         token.BaseDenom,
+				SpotPrice:     spotPrice,
+				HistoricPrice: historicPrice,
+				ExpiryHeight:  newExpiry,
+			})
+		}
+	}
+}
+
+// validateCircuitBreaker returns an error if a base token is paused by its price circuit breaker.
+func (k Keeper) validateCircuitBreaker(ctx sdk.Context, denom string) error {
+	if expiry := k.getCircuitBreakerExpiry(ctx, denom); ctx.BlockHeight() < expiry {
+		return types.ErrCircuitBreaker.Wrapf("%s until height %d", denom, expiry)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v5/util/coin"
+	"github.com/umee-network/umee/v5/x/leverage/types"
+)
+
+func (s *IntegrationTestSuite) TestCircuitBreaker() {
+	app, ctx, srv, require := s.app, s.ctx, s.msgSrvr, s.Require()
+
+	// pause tokens whose spot price deviates from historic price by more than 30%, for two more blocks
+	params := app.LeverageKeeper.GetParams(ctx)
+	params.CircuitBreakerDeviation = sdk.MustNewDecFromStr("0.3")
+	params.CircuitBreakerCooldownBlocks = 2
+	app.LeverageKeeper.SetParams(ctx, params)
+
+	// a supplier provides UMEE liquidity, and a borrower collateralizes 10 ATOM
+	supplier := s.newAccount(coin.New(umeeDenom, 100_000000))
+	s.supply(supplier, coin.New(umeeDenom, 100_000000))
+	borrower := s.newAccount(coin.New(atomDenom, 10_000000))
+	s.supply(borrower, coin.New(atomDenom, 10_000000))
+	s.collateralize(borrower, coin.New("u/"+atomDenom, 10_000000))
+	liquidator := s.newAccount(coin.New(umeeDenom, 10_000000))
+
+	h := ctx.BlockHeight()
+	atHeight := func(height int64) sdk.Context {
+		c := ctx.WithBlockHeight(height)
+		app.LeverageKeeper.UpdateCircuitBreakers(c)
+		return c
+	}
+	expiry := func(c sdk.Context) int64 {
+		resp, err := s.queryClient.MarketSummary(c.Context(), &types.QueryMarketSummary{Denom: umeeDenom})
+		require.NoError(err)
+		return resp.CircuitBreakerExpiryHeight
+	}
+	borrow := func(c sdk.Context) error {
+		_, err := srv.Borrow(c, &types.MsgBorrow{Borrower: borrower.String(), Asset: coin.New(umeeDenom, 1_000000)})
+		return err
+	}
+	liquidate := func(c sdk.Context) error {
+		_, err := srv.Liquidate(c, &types.MsgLiquidate{
+			Liquidator:  liquidator.String(),
+			Borrower:    borrower.String(),
+			Repayment:   coin.New(umeeDenom, 1_000000),
+			RewardDenom: atomDenom,
+		})
+		return err
+	}
+
+	// at normal prices, UMEE is not paused, but PUMP (historic price 1.00, spot price 2.00) is
+	c := atHeight(h)
+	require.Zero(expiry(c))
+	pump, err := s.queryClient.MarketSummary(c.Context(), &types.QueryMarketSummary{Denom: pumpDenom})
+	require.NoError(err)
+	require.Equal(h+3, pump.CircuitBreakerExpiryHeight)
+
+	// UMEE spikes from 4.21 to 6.00 for two blocks, extending the pause each block
+	s.mockOracle.symbolExchangeRates["UMEE"] = sdk.MustNewDecFromStr("6.00")
+	c = atHeight(h + 1)
+	require.Equal(h+4, expiry(c))
+	c = atHeight(h + 2)
+	require.Equal(h+5, expiry(c))
+
+	// borrowing and liquidations involving UMEE are paused
+	require.ErrorIs(borrow(c), types.ErrCircuitBreaker)
+	require.ErrorIs(liquidate(c), types.ErrCircuitBreaker)
+
+	// the price recovers, but the pause lasts until its expiry
+	s.mockOracle.Reset()
+	c = atHeight(h + 4)
+	require.Equal(h+5, expiry(c))
+	require.ErrorIs(borrow(c), types.ErrCircuitBreaker)
+
+	// the circuit breaker clears at its expiry height, and borrowing resumes
+	c = atHeight(h + 5)
+	require.Zero(expiry(c))
+	require.NoError(borrow(c))
+	// liquidations are no longer paused, so the healthy borrower is rejected for other reasons
+	require.ErrorIs(liquidate(c), types.ErrLiquidationIneligible)
+
+	// a zero deviation disables the circuit breaker
+	params.CircuitBreakerDeviation = sdk.ZeroDec()
+	app.LeverageKeeper.SetParams(ctx, params)
+	s.mockOracle.symbolExchangeRates["UMEE"] = sdk.MustNewDecFromStr("6.00")
+	c = atHeight(h + 6)
+	require.Zero(expiry(c))
+	require.NoError(borrow(c))
+}
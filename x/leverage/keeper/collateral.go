@@ -26,6 +26,19 @@ func (k Keeper) liquidateCollateral(ctx sdk.Context, borrower, liquidator sdk.Ac
 	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, liquidator, sdk.NewCoins(token))
 }
 
+// reserveLiquidationCut adds the protocol's cut of a liquidation reward to reserves. A base token cut
+// is already in the module account after a direct liquidation, while a uToken cut is donated from the
+// borrower's collateral.
+func (k Keeper) reserveLiquidationCut(ctx sdk.Context, borrower sdk.AccAddress, cut sdk.Coin) error {
+	if cut.IsZero() {
+		return nil
+	}
+	if types.HasUTokenPrefix(cut.Denom) {
+		return k.DonateCollateral(ctx, borrower, cut)
+	}
+	return k.addReserves(ctx, cut)
+}
+
 // burnCollateral removes some uTokens from an account's collateral and burns them. This occurs
 // during direct liquidations and during donateCollateral.
 func (k Keeper) burnCollateral(ctx sdk.Context, addr sdk.AccAddress, uToken sdk.Coin) error {
@@ -65,8 +78,10 @@ func (k Keeper) GetTotalCollateral(ctx sdk.Context, denom string) sdk.Coin {
 // CalculateCollateralValue uses the price oracle to determine the value (in USD) provided by
 // collateral sdk.Coins, using each token's uToken exchange rate. Always uses spot price.
 // An error is returned if any input coins are not uTokens or if value calculation fails.
+// Under ZeroPricePolicy TREAT_AS_UNBORROWABLE, collateral missing prices is valued at zero instead.
 func (k Keeper) CalculateCollateralValue(ctx sdk.Context, collateral sdk.Coins) (sdk.Dec, error) {
 	total := sdk.ZeroDec()
+	policy := k.GetParams(ctx).ZeroPricePolicy
 
 	for _, coin := range collateral {
 		// convert uToken collateral to base assets
@@ -77,7 +92,7 @@ func (k Keeper) CalculateCollateralValue(ctx sdk.Context, collateral sdk.Coins)
 
 		// get USD value of base assets
 		v, err := k.TokenValue(ctx, baseAsset, types.PriceModeSpot)
-		if err != nil {
+		if err = collateralPriceError(err, policy); err != nil {
 			return sdk.ZeroDec(), err
 		}
 
@@ -91,9 +106,11 @@ func (k Keeper) CalculateCollateralValue(ctx sdk.Context, collateral sdk.Coins)
 // VisibleCollateralValue uses the price oracle to determine the value (in USD) provided by
 // collateral sdk.Coins, using each token's uToken exchange rate. Always uses spot price.
 // Unlike CalculateCollateralValue, this function will not return an error if value calculation
-// fails on a token - instead, that token will contribute zero value to the total.
+// fails on a token - instead, that token will contribute zero value to the total. Under
+// ZeroPricePolicy ERROR, missing prices cause an error instead.
 func (k Keeper) VisibleCollateralValue(ctx sdk.Context, collateral sdk.Coins) (sdk.Dec, error) {
 	total := sdk.ZeroDec()
+	policy := k.GetParams(ctx).ZeroPricePolicy
 
 	for _, coin := range collateral {
 		// convert uToken collateral to base assets
@@ -108,7 +125,7 @@ func (k Keeper) VisibleCollateralValue(ctx sdk.Context, collateral sdk.Coins) (s
 			// for coins that did not error, add their value to the total
 			total = total.Add(v)
 		}
-		if nonOracleError(err) {
+		if err = visiblePriceError(err, policy); err != nil {
 			return sdk.ZeroDec(), err
 		}
 	}
@@ -116,6 +133,22 @@ func (k Keeper) VisibleCollateralValue(ctx sdk.Context, collateral sdk.Coins) (s
 	return total, nil
 }
 
+// applyLiquidityHaircut reduces each base token or uToken amount in coins by its token's
+// LiquidityHaircut, rounding down, for use in risk-conservative valuations. Coins reduced
+// to zero are omitted.
+func (k Keeper) applyLiquidityHaircut(ctx sdk.Context, coins sdk.Coins) (sdk.Coins, error) {
+	haircut := sdk.NewCoins()
+	for _, coin := range coins {
+		token, err := k.marketToken(ctx, coin.Denom)
+		if err != nil {
+			return nil, err
+		}
+		remaining := sdk.OneDec().Sub(token.LiquidityHaircut).MulInt(coin.Amount).TruncateInt()
+		haircut = haircut.Add(sdk.NewCoin(coin.Denom, remaining))
+	}
+	return haircut, nil
+}
+
 // GetAllTotalCollateral returns total collateral across all uTokens.
 func (k Keeper) GetAllTotalCollateral(ctx sdk.Context) sdk.Coins {
 	total := sdk.NewCoins()
@@ -176,6 +209,68 @@ func (k *Keeper) VisibleCollateralShare(ctx sdk.Context, denom string) (sdk.Dec,
 	return thisValue.Quo(totalValue), nil
 }
 
+// SolvencyPriceFloor computes the lowest spot price of a base token at which the value of all collateral
+// in the module covers the value of all borrows, assuming other prices are unchanged. Collateral is counted
+// at full value, and other tokens missing prices are skipped. The returned floor is nil if no price of the
+// token would make collateral cover borrows. Also returns the token's current spot price and the current
+// total collateral and borrowed values. Uses module-wide totals, so its cost is proportional to the number
+// of registered tokens rather than the number of positions.
+func (k Keeper) SolvencyPriceFloor(ctx sdk.Context, denom string) (
+	floor *sdk.Dec, price, collateralValue, borrowedValue sdk.Dec, err error,
+) {
+	zero := sdk.ZeroDec()
+	price, _, err = k.TokenPrice(ctx, denom, types.PriceModeSpot)
+	if err != nil {
+		return nil, zero, zero, zero, err
+	}
+
+	collateral := k.GetAllTotalCollateral(ctx)
+	borrowed := sdk.NewCoins()
+	for _, t := range k.GetAllRegisteredTokens(ctx) {
+		borrowed = borrowed.Add(k.GetTotalBorrowed(ctx, t.BaseDenom))
+	}
+
+	// separate the positions of this token from all others
+	uDenom := types.ToUTokenDenom(denom)
+	thisCollateral := sdk.NewCoins(sdk.NewCoin(uDenom, collateral.AmountOf(uDenom)))
+	thisBorrowed := sdk.NewCoins(sdk.NewCoin(denom, borrowed.AmountOf(denom)))
+
+	thisCollateralValue, err := k.CalculateCollateralValue(ctx, thisCollateral)
+	if err != nil {
+		return nil, zero, zero, zero, err
+	}
+	otherCollateralValue, err := k.VisibleCollateralValue(ctx, collateral.Sub(thisCollateral...))
+	if err != nil {
+		return nil, zero, zero, zero, err
+	}
+	thisBorrowedValue, err := k.TokenValue(ctx, sdk.NewCoin(denom, borrowed.AmountOf(denom)), types.PriceModeSpot)
+	if err != nil {
+		return nil, zero, zero, zero, err
+	}
+	otherBorrowedValue, err := k.VisibleTokenValue(ctx, borrowed.Sub(thisBorrowed...), types.PriceModeSpot)
+	if err != nil {
+		return nil, zero, zero, zero, err
+	}
+	collateralValue = thisCollateralValue.Add(otherCollateralValue)
+	borrowedValue = thisBorrowedValue.Add(otherBorrowedValue)
+
+	// Both of this token's values scale linearly with its price p, so collateral covers borrows when
+	//   otherCollateral + thisCollateral * p / price >= otherBorrowed + thisBorrowed * p / price
+	// which rearranges to net * p / price >= deficit.
+	net := thisCollateralValue.Sub(thisBorrowedValue)
+	deficit := otherBorrowedValue.Sub(otherCollateralValue)
+	switch {
+	case net.IsPositive():
+		// solvent at and above the price where net value covers the deficit
+		f := sdk.MaxDec(zero, price.Mul(deficit).Quo(net))
+		floor = &f
+	case !deficit.IsPositive():
+		// lower prices never reduce solvency, so the system is solvent down to zero
+		floor = &zero
+	}
+	return floor, price, collateralValue, borrowedValue, nil
+}
+
 // checkCollateralLiquidity returns the appropriate error if a token denom's
 // collateral liquidity is below its MinCollateralLiquidity
 func (k Keeper) checkCollateralLiquidity(ctx sdk.Context, denom string) error {
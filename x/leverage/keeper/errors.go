@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/umee-network/umee/v5/util/decmath"
 	leveragetypes "github.com/umee-network/umee/v5/x/leverage/types"
@@ -31,3 +32,46 @@ func nonOracleError(err error) bool {
 	}
 	return true
 }
+
+// visiblePriceError filters an error encountered while pricing a single asset in a function which
+// skips assets missing prices (Visible*). Oracle errors are dropped, so the asset is valued at zero,
+// unless ZeroPricePolicy is ERROR. Non-oracle errors are always returned.
+func visiblePriceError(err error, policy leveragetypes.ZeroPricePolicy) error {
+	if nonOracleError(err) || (err != nil && policy == leveragetypes.ZeroPricePolicy_ZERO_PRICE_POLICY_ERROR) {
+		return err
+	}
+	return nil
+}
+
+// collateralPriceError filters an error encountered while pricing a single collateral asset in a
+// function which requires all prices (Calculate*). Oracle errors are returned unless ZeroPricePolicy
+// is TREAT_AS_UNBORROWABLE, in which case the asset counts as zero collateral.
+// Non-oracle errors are always returned.
+func collateralPriceError(err error, policy leveragetypes.ZeroPricePolicy) error {
+	if nonOracleError(err) || policy != leveragetypes.ZeroPricePolicy_ZERO_PRICE_POLICY_TREAT_AS_UNBORROWABLE {
+		return err
+	}
+	return nil
+}
+
+// withdrawReason identifies the constraint responsible for a failed withdrawal, for the CanWithdraw query.
+func withdrawReason(err error) string {
+	switch {
+	case errors.IsOf(err, leveragetypes.ErrInsufficientBalance, sdkerrors.ErrInsufficientFunds):
+		return leveragetypes.WithdrawReasonInsufficientBalance
+	case errors.IsOf(err, leveragetypes.ErrBondedCollateral):
+		return leveragetypes.WithdrawReasonBondedCollateral
+	case errors.IsOf(err, leveragetypes.ErrLendingPoolInsufficient):
+		return leveragetypes.WithdrawReasonInsufficientLiquidity
+	case errors.IsOf(err, leveragetypes.ErrMaxWithdrawRate):
+		return leveragetypes.WithdrawReasonMaxWithdrawRate
+	case errors.IsOf(err, leveragetypes.ErrUndercollaterized):
+		return leveragetypes.WithdrawReasonOverBorrowLimit
+	case errors.IsOf(err, leveragetypes.ErrMinCollateralLiquidity):
+		return leveragetypes.WithdrawReasonMinCollateralLiquidity
+	case errors.IsOf(err, leveragetypes.ErrAccountFrozen):
+		return leveragetypes.WithdrawReasonAccountFrozen
+	default:
+		return leveragetypes.WithdrawReasonOther
+	}
+}
@@ -62,7 +62,30 @@ func (k Keeper) ExchangeUTokens(ctx sdk.Context, uTokens sdk.Coins) (sdk.Coins,
 }
 
 // DeriveExchangeRate calculated the token:uToken exchange rate of a base token denom.
+// It uses the rate cached at the beginning of the block if the rate has not changed since.
 func (k Keeper) DeriveExchangeRate(ctx sdk.Context, denom string) sdk.Dec {
+	if rate, ok := k.getCachedExchangeRate(ctx, denom); ok {
+		return rate
+	}
+	return k.computeExchangeRate(ctx, denom)
+}
+
+// CacheExchangeRates computes and caches the exchange rates of all registered tokens for the current
+// block. The cache is kept in the transient store, so it is never committed and is empty again at the
+// start of the next block. Cached rates are cleared whenever a token's reserves, total borrowed, interest
+// scalar or uToken supply is set. Every operation which moves base tokens in or out of the module account
+// also sets one of those, so cached rates never differ from freshly computed ones. Called by BeginBlock.
+func (k Keeper) CacheExchangeRates(ctx sdk.Context) error {
+	for _, token := range k.GetAllRegisteredTokens(ctx) {
+		if err := k.setCachedExchangeRate(ctx, token.BaseDenom, k.computeExchangeRate(ctx, token.BaseDenom)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// computeExchangeRate calculates the token:uToken exchange rate of a base token denom without using the cache.
+func (k Keeper) computeExchangeRate(ctx sdk.Context, denom string) sdk.Dec {
 	// uToken exchange rate is equal to the token supply (including borrowed
 	// tokens yet to be repaid and excluding tokens reserved) divided by total
 	// uTokens in circulation.
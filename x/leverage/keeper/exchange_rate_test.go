@@ -5,6 +5,7 @@ import (
 
 	appparams "github.com/umee-network/umee/v5/app/params"
 	"github.com/umee-network/umee/v5/util/coin"
+	"github.com/umee-network/umee/v5/x/leverage/types"
 )
 
 func (s *IntegrationTestSuite) TestDeriveExchangeRate() {
@@ -31,3 +32,55 @@ func (s *IntegrationTestSuite) TestDeriveExchangeRate() {
 	rate := app.LeverageKeeper.DeriveExchangeRate(ctx, appparams.BondDenom)
 	require.Equal(sdk.MustNewDecFromStr("2.7"), rate)
 }
+
+func (s *IntegrationTestSuite) TestCacheExchangeRates() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+
+	// creates account which has supplied and collateralized 1000 uumee
+	addr := s.newAccount(coin.New(umeeDenom, 1000))
+	s.supply(addr, coin.New(umeeDenom, 1000))
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 1000))
+
+	// artificially increase total borrows and set reserves, for an exchange rate of 2.7
+	require.NoError(s.tk.SetBorrow(ctx, addr, coin.New(umeeDenom, 2000)))
+	s.setReserves(coin.New(umeeDenom, 300))
+
+	// cached and freshly computed rates of every registered token match
+	requireMatch := func(msg string) {
+		for _, token := range app.LeverageKeeper.GetAllRegisteredTokens(ctx) {
+			require.Equal(
+				s.tk.ComputeExchangeRate(ctx, token.BaseDenom),
+				app.LeverageKeeper.DeriveExchangeRate(ctx, token.BaseDenom),
+				"%s: %s", msg, token.BaseDenom,
+			)
+		}
+	}
+
+	require.NoError(app.LeverageKeeper.CacheExchangeRates(ctx))
+	require.Equal(sdk.MustNewDecFromStr("2.7"), app.LeverageKeeper.DeriveExchangeRate(ctx, umeeDenom))
+	requireMatch("after caching")
+
+	// operations within the block which change exchange rates keep the cache correct
+	supplier := s.newAccount(coin.New(umeeDenom, 1000))
+	s.supply(supplier, coin.New(umeeDenom, 1000))
+	requireMatch("after supply")
+	require.NoError(app.LeverageKeeper.CacheExchangeRates(ctx))
+	s.withdraw(supplier, coin.New("u/"+umeeDenom, 100))
+	requireMatch("after withdraw")
+	require.NoError(app.LeverageKeeper.CacheExchangeRates(ctx))
+	s.setReserves(coin.New(umeeDenom, 400))
+	requireMatch("after setting reserves")
+	require.NoError(app.LeverageKeeper.CacheExchangeRates(ctx))
+	require.NoError(s.tk.SetBorrow(ctx, addr, coin.New(umeeDenom, 3000)))
+	requireMatch("after borrow")
+	require.NoError(app.LeverageKeeper.CacheExchangeRates(ctx))
+	require.NoError(s.tk.SetInterestScalar(ctx, umeeDenom, sdk.MustNewDecFromStr("1.1")))
+	requireMatch("after interest")
+
+	// cached rates are kept in the transient store rather than the module store
+	require.NoError(app.LeverageKeeper.CacheExchangeRates(ctx))
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(app.GetKey(types.StoreKey)), types.KeyPrefixExchangeRateCache)
+	defer iter.Close()
+	require.False(iter.Valid())
+	require.True(ctx.TransientStore(app.GetTKey(types.TStoreKey)).Has(types.KeyExchangeRateCache(umeeDenom)))
+}
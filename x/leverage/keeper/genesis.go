@@ -43,6 +43,22 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	for _, rate := range genState.InterestScalars {
 		util.Panic(k.setInterestScalar(ctx, rate.Denom, rate.Scalar))
 	}
+
+	for _, frozen := range genState.FrozenAccounts {
+		addr, err := sdk.AccAddressFromBech32(frozen)
+		util.Panic(err)
+		util.Panic(k.setAccountFrozen(ctx, addr, true))
+	}
+
+	for _, stopLoss := range genState.StopLosses {
+		util.Panic(k.setStopLoss(ctx, stopLoss))
+	}
+
+	for _, borrowCap := range genState.BorrowCaps {
+		addr, err := sdk.AccAddressFromBech32(borrowCap.Address)
+		util.Panic(err)
+		util.Panic(k.setAccountBorrowCap(ctx, addr, borrowCap.BorrowCap))
+	}
 }
 
 // ExportGenesis returns the x/leverage module's exported genesis state.
@@ -57,6 +73,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		k.getAllBadDebts(ctx),
 		k.getAllInterestScalars(ctx),
 		k.GetAllUTokenSupply(ctx),
+		k.getAllFrozenAccounts(ctx),
+		k.getAllStopLosses(ctx),
+		k.getAllAccountBorrowCaps(ctx),
 	)
 }
 
@@ -133,3 +152,63 @@ func (k Keeper) getAllInterestScalars(ctx sdk.Context) []types.InterestScalar {
 
 	return interestScalars
 }
+
+// getAllFrozenAccounts returns the addresses of all accounts frozen by governance.
+func (k Keeper) getAllFrozenAccounts(ctx sdk.Context) []string {
+	prefix := types.KeyPrefixFrozenAccount
+	frozen := []string{}
+
+	iterator := func(key, _ []byte) error {
+		frozen = append(frozen, types.AddressFromKey(key, prefix).String())
+		return nil
+	}
+
+	util.Panic(k.iterate(ctx, prefix, iterator))
+
+	return frozen
+}
+
+// getAllStopLosses returns the stop-losses recorded by all accounts.
+func (k Keeper) getAllStopLosses(ctx sdk.Context) []types.StopLoss {
+	prefix := types.KeyPrefixStopLoss
+	stopLosses := []types.StopLoss{}
+
+	iterator := func(_, val []byte) error {
+		var stopLoss types.StopLoss
+		if err := k.cdc.Unmarshal(val, &stopLoss); err != nil {
+			// improperly marshaled stop-loss should never happen
+			return err
+		}
+
+		stopLosses = append(stopLosses, stopLoss)
+		return nil
+	}
+
+	util.Panic(k.iterate(ctx, prefix, iterator))
+
+	return stopLosses
+}
+
+// getAllAccountBorrowCaps returns the borrow caps set by governance for all accounts.
+func (k Keeper) getAllAccountBorrowCaps(ctx sdk.Context) []types.AccountBorrowCap {
+	prefix := types.KeyPrefixAccountBorrowCap
+	borrowCaps := []types.AccountBorrowCap{}
+
+	iterator := func(key, val []byte) error {
+		borrowCap := sdk.ZeroDec()
+		if err := borrowCap.Unmarshal(val); err != nil {
+			// improperly marshaled borrow cap should never happen
+			return err
+		}
+
+		borrowCaps = append(borrowCaps, types.AccountBorrowCap{
+			Address:   types.AddressFromKey(key, prefix).String(),
+			BorrowCap: borrowCap,
+		})
+		return nil
+	}
+
+	util.Panic(k.iterate(ctx, prefix, iterator))
+
+	return borrowCaps
+}
@@ -138,6 +138,17 @@ func (s *IntegrationTestSuite) TestKeeper_InitGenesis() {
 			true,
 			"invalid denom: ",
 		},
+		{
+			"invalid stop-loss",
+			types.GenesisState{
+				Params: types.DefaultParams(),
+				StopLosses: []types.StopLoss{
+					types.NewStopLoss(testAddr, testAddr, sdk.MustNewDecFromStr("1.5")),
+				},
+			},
+			true,
+			"stop-loss executor cannot be the borrower: " + testAddr,
+		},
 		{
 			"valid",
 			types.GenesisState{
@@ -189,12 +200,25 @@ func (s *IntegrationTestSuite) TestKeeper_ExportGenesis() {
 			Scalar: sdk.NewDec(10),
 		},
 	}
+	frozenAccounts := []string{testAddr}
+	stopLosses := []types.StopLoss{
+		types.NewStopLoss(testAddr, sdk.AccAddress([]byte("executor____________")).String(), sdk.MustNewDecFromStr("1.5")),
+	}
+	borrowCaps := []types.AccountBorrowCap{
+		{
+			Address:   testAddr,
+			BorrowCap: sdk.NewDec(1000),
+		},
+	}
 	genesis := types.DefaultGenesis()
 	genesis.AdjustedBorrows = borrows
 	genesis.Collateral = collateral
 	genesis.Reserves = reserves
 	genesis.BadDebts = badDebts
 	genesis.InterestScalars = interestScalars
+	genesis.FrozenAccounts = frozenAccounts
+	genesis.StopLosses = stopLosses
+	genesis.BorrowCaps = borrowCaps
 	s.app.LeverageKeeper.InitGenesis(s.ctx, *genesis)
 
 	export := s.app.LeverageKeeper.ExportGenesis(s.ctx)
@@ -203,4 +227,7 @@ func (s *IntegrationTestSuite) TestKeeper_ExportGenesis() {
 	assert.DeepEqual(s.T(), reserves, export.Reserves)
 	assert.DeepEqual(s.T(), badDebts, export.BadDebts)
 	assert.DeepEqual(s.T(), interestScalars, export.InterestScalars)
+	assert.DeepEqual(s.T(), frozenAccounts, export.FrozenAccounts)
+	assert.DeepEqual(s.T(), stopLosses, export.StopLosses)
+	assert.DeepEqual(s.T(), borrowCaps, export.BorrowCaps)
 }
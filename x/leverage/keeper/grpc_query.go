@@ -2,8 +2,13 @@ package keeper
 
 import (
 	"context"
+	"encoding/hex"
+	"strings"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -42,6 +47,16 @@ func (q Querier) RegisteredTokens(
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
+	for _, w := range []*sdk.Dec{req.MinCollateralWeight, req.MaxCollateralWeight} {
+		if w != nil && (w.IsNegative() || w.GT(sdk.OneDec())) {
+			return nil, status.Errorf(codes.InvalidArgument, "collateral weight must be between 0 and 1: %s", w)
+		}
+	}
+	if req.MinCollateralWeight != nil && req.MaxCollateralWeight != nil &&
+		req.MinCollateralWeight.GT(*req.MaxCollateralWeight) {
+		return nil, status.Errorf(codes.InvalidArgument, "min collateral weight %s exceeds max collateral weight %s",
+			req.MinCollateralWeight, req.MaxCollateralWeight)
+	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -56,8 +71,15 @@ func (q Querier) RegisteredTokens(
 		tokens = q.Keeper.GetAllRegisteredTokens(ctx)
 	}
 
+	filtered := []types.Token{}
+	for _, t := range tokens {
+		if req.Matches(t) {
+			filtered = append(filtered, t)
+		}
+	}
+
 	return &types.QueryRegisteredTokensResponse{
-		Registry: tokens,
+		Registry: filtered,
 	}, nil
 }
 
@@ -74,24 +96,31 @@ func (q Querier) MarketSummary(
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	token, err := q.Keeper.GetTokenSettings(ctx, req.Denom)
+	token, err := q.Keeper.marketToken(ctx, req.Denom)
 	if err != nil {
 		return nil, err
 	}
 
-	rate := q.Keeper.DeriveExchangeRate(ctx, req.Denom)
-	supplyAPY := q.Keeper.DeriveSupplyAPY(ctx, req.Denom)
-	borrowAPY := q.Keeper.DeriveBorrowAPY(ctx, req.Denom)
+	resp := q.Keeper.marketSummary(ctx, token)
+	return &resp, nil
+}
+
+// marketSummary computes a registered token's current borrowing and supplying conditions.
+// Oracle prices in the response are nil if unavailable, with the reason appended to Errors.
+func (k Keeper) marketSummary(ctx sdk.Context, token types.Token) types.QueryMarketSummaryResponse {
+	rate := k.DeriveExchangeRate(ctx, token.BaseDenom)
+	supplyAPY := k.DeriveSupplyAPY(ctx, token.BaseDenom)
+	borrowAPY := k.DeriveBorrowAPY(ctx, token.BaseDenom)
 
-	supplied, _ := q.Keeper.GetTotalSupply(ctx, req.Denom)
-	balance := q.Keeper.ModuleBalance(ctx, req.Denom).Amount
-	reserved := q.Keeper.GetReserves(ctx, req.Denom).Amount
-	borrowed := q.Keeper.GetTotalBorrowed(ctx, req.Denom)
-	liquidity := q.Keeper.AvailableLiquidity(ctx, req.Denom)
+	supplied, _ := k.GetTotalSupply(ctx, token.BaseDenom)
+	balance := k.ModuleBalance(ctx, token.BaseDenom).Amount
+	reserved := k.GetReserves(ctx, token.BaseDenom).Amount
+	borrowed := k.GetTotalBorrowed(ctx, token.BaseDenom)
+	liquidity := k.AvailableLiquidity(ctx, token.BaseDenom)
 
-	uDenom := types.ToUTokenDenom(req.Denom)
-	uSupply := q.Keeper.GetUTokenSupply(ctx, uDenom)
-	uCollateral := q.Keeper.GetTotalCollateral(ctx, uDenom)
+	uDenom := types.ToUTokenDenom(token.BaseDenom)
+	uSupply := k.GetUTokenSupply(ctx, uDenom)
+	uCollateral := k.GetTotalCollateral(ctx, uDenom)
 
 	// maxBorrow is based on MaxSupplyUtilization
 	maxBorrow := token.MaxSupplyUtilization.MulInt(supplied.Amount).TruncateInt()
@@ -111,7 +140,7 @@ func (q Querier) MarketSummary(
 	availableWithdraw = sdk.MaxInt(availableWithdraw, sdk.ZeroInt())
 
 	// availableCollateralize respects both MaxCollateralShare and MinCollateralLiquidity
-	maxCollateral, _ := q.Keeper.maxCollateralFromShare(ctx, uDenom)
+	maxCollateral, _ := k.maxCollateralFromShare(ctx, uDenom)
 	if token.MinCollateralLiquidity.IsPositive() {
 		maxCollateralFromLiquidity := toDec(liquidity).Quo(token.MinCollateralLiquidity).TruncateInt()
 		maxCollateral = sdk.MinInt(maxCollateral, maxCollateralFromLiquidity)
@@ -120,40 +149,41 @@ func (q Querier) MarketSummary(
 	availableCollateralize = sdk.MaxInt(availableCollateralize, sdk.ZeroInt())
 
 	resp := types.QueryMarketSummaryResponse{
-		SymbolDenom:            token.SymbolDenom,
-		Exponent:               token.Exponent,
-		UTokenExchangeRate:     rate,
-		Supply_APY:             supplyAPY,
-		Borrow_APY:             borrowAPY,
-		Supplied:               supplied.Amount,
-		Reserved:               reserved,
-		Collateral:             uCollateral.Amount,
-		Borrowed:               borrowed.Amount,
-		Liquidity:              balance.Sub(reserved),
-		MaximumBorrow:          maxBorrow,
-		MaximumCollateral:      maxCollateral,
-		MinimumLiquidity:       minLiquidity,
-		UTokenSupply:           uSupply.Amount,
-		AvailableBorrow:        availableBorrow,
-		AvailableWithdraw:      availableWithdraw,
-		AvailableCollateralize: availableCollateralize,
+		SymbolDenom:                token.SymbolDenom,
+		Exponent:                   token.Exponent,
+		UTokenExchangeRate:         rate,
+		Supply_APY:                 supplyAPY,
+		Borrow_APY:                 borrowAPY,
+		Supplied:                   supplied.Amount,
+		Reserved:                   reserved,
+		Collateral:                 uCollateral.Amount,
+		Borrowed:                   borrowed.Amount,
+		Liquidity:                  balance.Sub(reserved),
+		MaximumBorrow:              maxBorrow,
+		MaximumCollateral:          maxCollateral,
+		MinimumLiquidity:           minLiquidity,
+		UTokenSupply:               uSupply.Amount,
+		AvailableBorrow:            availableBorrow,
+		AvailableWithdraw:          availableWithdraw,
+		AvailableCollateralize:     availableCollateralize,
+		CircuitBreakerExpiryHeight: k.getCircuitBreakerExpiry(ctx, token.BaseDenom),
 	}
 
 	// Oracle prices in response will be nil if it is unavailable
-	oraclePrice, _, oracleErr := q.Keeper.TokenPrice(ctx, req.Denom, types.PriceModeSpot)
+	oraclePrice, _, oracleErr := k.TokenPrice(ctx, token.BaseDenom, types.PriceModeSpot)
 	if oracleErr == nil {
 		resp.OraclePrice = &oraclePrice
 	} else {
 		resp.Errors += oracleErr.Error()
 	}
-	historicPrice, _, historicErr := q.Keeper.TokenPrice(ctx, req.Denom, types.PriceModeHistoric)
+	historicPrice, _, historicErr := k.TokenPrice(ctx, token.BaseDenom, types.PriceModeHistoric)
 	if historicErr == nil {
 		resp.OracleHistoricPrice = &historicPrice
 	} else {
 		resp.Errors += historicErr.Error()
 	}
 
-	return &resp, nil
+	return resp
 }
 
 func (q Querier) AccountBalances(
@@ -181,11 +211,28 @@ func (q Querier) AccountBalances(
 	collateral := q.Keeper.GetBorrowerCollateral(ctx, addr)
 	borrowed := q.Keeper.GetBorrowerBorrows(ctx, addr)
 
-	return &types.QueryAccountBalancesResponse{
-		Supplied:   supplied,
-		Collateral: collateral,
-		Borrowed:   borrowed,
-	}, nil
+	resp := &types.QueryAccountBalancesResponse{
+		Supplied:       supplied,
+		Collateral:     collateral,
+		Borrowed:       borrowed,
+		PrefixSupplied: sdk.ZeroInt(),
+	}
+	if req.DenomPrefix != "" {
+		for _, c := range supplied {
+			if strings.HasPrefix(c.Denom, req.DenomPrefix) {
+				resp.PrefixSupplied = resp.PrefixSupplied.Add(c.Amount)
+			}
+		}
+	}
+	if req.Net {
+		net, err := q.Keeper.netPositions(ctx, supplied, borrowed)
+		if err != nil {
+			return nil, err
+		}
+		resp.Net = net
+	}
+
+	return resp, nil
 }
 
 func (q Querier) AccountSummary(
@@ -206,15 +253,79 @@ func (q Querier) AccountSummary(
 		return nil, err
 	}
 
-	supplied, err := q.Keeper.GetAllSupplied(ctx, addr)
+	return q.Keeper.accountSummary(ctx, addr, req.QuoteDenom, req.ApplyLiquidityHaircut)
+}
+
+func (q Querier) AccountSummaries(
+	goCtx context.Context,
+	req *types.QueryAccountSummaries,
+) (*types.QueryAccountSummariesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Addresses) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty addresses")
+	}
+	if len(req.Addresses) > types.MaxAccountSummaries {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d addresses may be queried at once, got %d",
+			types.MaxAccountSummaries, len(req.Addresses))
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	summaries := make([]types.AccountSummaryEntry, 0, len(req.Addresses))
+	for _, a := range req.Addresses {
+		addr, err := sdk.AccAddressFromBech32(a)
+		if err != nil {
+			return nil, err
+		}
+		summary, err := q.Keeper.accountSummary(ctx, addr, req.QuoteDenom, false)
+		if err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, types.AccountSummaryEntry{Address: a, Summary: *summary})
+	}
+
+	return &types.QueryAccountSummariesResponse{Summaries: summaries}, nil
+}
+
+// accountSummary computes the position values and borrowing limits of an account,
+// as returned by the AccountSummary and AccountSummaries queries. Values are in USD,
+// or in symbol units of the quote denom if one is provided. If haircut is true, supplied
+// and collateral amounts are first reduced by each token's LiquidityHaircut.
+func (k Keeper) accountSummary(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	quoteDenom string,
+	haircut bool,
+) (*types.QueryAccountSummaryResponse, error) {
+	// the quote price must be valid even if the account has no positions
+	quotePrice := sdk.OneDec()
+	if quoteDenom != "" {
+		var err error
+		quotePrice, _, err = k.TokenPrice(ctx, quoteDenom, types.PriceModeSpot)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	supplied, err := k.GetAllSupplied(ctx, addr)
 	if err != nil {
 		return nil, err
 	}
-	collateral := q.Keeper.GetBorrowerCollateral(ctx, addr)
-	borrowed := q.Keeper.GetBorrowerBorrows(ctx, addr)
+	collateral := k.GetBorrowerCollateral(ctx, addr)
+	borrowed := k.GetBorrowerBorrows(ctx, addr)
+	if haircut {
+		if supplied, err = k.applyLiquidityHaircut(ctx, supplied); err != nil {
+			return nil, err
+		}
+		if collateral, err = k.applyLiquidityHaircut(ctx, collateral); err != nil {
+			return nil, err
+		}
+	}
 
 	// supplied value always uses spot prices, and skips supplied assets that are missing prices
-	suppliedValue, err := q.Keeper.VisibleTokenValue(ctx, supplied, types.PriceModeSpot)
+	suppliedValue, err := k.VisibleTokenValue(ctx, supplied, types.PriceModeSpot)
 	if err != nil {
 		return nil, err
 	}
@@ -222,13 +333,13 @@ func (q Querier) AccountSummary(
 	// borrowed value uses spot prices here, but leverage logic instead uses
 	// the higher of spot or historic prices for each borrowed token when comparing it
 	// to borrow limit. This line also skips borrowed assets that are missing prices.
-	borrowedValue, err := q.Keeper.VisibleTokenValue(ctx, borrowed, types.PriceModeSpot)
+	borrowedValue, err := k.VisibleTokenValue(ctx, borrowed, types.PriceModeSpot)
 	if err != nil {
 		return nil, err
 	}
 
 	// collateral value always uses spot prices, and this line skips assets that are missing prices
-	collateralValue, err := q.Keeper.VisibleCollateralValue(ctx, collateral)
+	collateralValue, err := k.VisibleCollateralValue(ctx, collateral)
 	if err != nil {
 		return nil, err
 	}
@@ -236,22 +347,28 @@ func (q Querier) AccountSummary(
 	// borrow limit shown here as it is used in leverage logic:
 	// using the lower of spot or historic prices for each collateral token
 	// skips collateral tokens with missing oracle prices
-	borrowLimit, err := q.Keeper.VisibleBorrowLimit(ctx, collateral)
+	borrowLimit, err := k.VisibleBorrowLimit(ctx, collateral)
 	if err != nil {
 		return nil, err
 	}
 
+	params := k.GetParams(ctx)
 	resp := &types.QueryAccountSummaryResponse{
-		SuppliedValue:   suppliedValue,
-		CollateralValue: collateralValue,
-		BorrowedValue:   borrowedValue,
-		BorrowLimit:     borrowLimit,
+		SuppliedValue:   suppliedValue.Quo(quotePrice),
+		CollateralValue: collateralValue.Quo(quotePrice),
+		BorrowedValue:   borrowedValue.Quo(quotePrice),
+		BorrowLimit:     borrowLimit.Quo(quotePrice),
+		Frozen:          k.IsAccountFrozen(ctx, addr),
+		BorrowPaused:    params.BorrowPaused,
+		SupplyPaused:    params.SupplyPaused,
+		QuoteDenom:      quoteDenom,
 	}
 
 	// liquidation always uses spot prices. This response field will be null
 	// if a price is missing
-	liquidationThreshold, err := q.Keeper.CalculateLiquidationThreshold(ctx, collateral)
+	liquidationThreshold, err := k.CalculateLiquidationThreshold(ctx, collateral)
 	if err == nil {
+		liquidationThreshold = liquidationThreshold.Quo(quotePrice)
 		resp.LiquidationThreshold = &liquidationThreshold
 	}
 
@@ -272,7 +389,7 @@ func (q Querier) LiquidationTargets(
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	targets, err := q.Keeper.GetEligibleLiquidationTargets(ctx)
+	targets, err := q.Keeper.GetEligibleLiquidationTargets(ctx, req.RewardDenom)
 	if err != nil {
 		return nil, err
 	}
@@ -411,3 +528,1629 @@ func (q Querier) MaxBorrow(
 		Tokens: maxTokens,
 	}, nil
 }
+
+func (q Querier) EffectiveReserveFactor(
+	goCtx context.Context,
+	req *types.QueryEffectiveReserveFactor,
+) (*types.QueryEffectiveReserveFactorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	token, err := q.Keeper.marketToken(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// reserve factors are not overridden outside of the token registry,
+	// so the registry value is always the one in effect
+	return &types.QueryEffectiveReserveFactorResponse{
+		ReserveFactor: token.ReserveFactor,
+		Overridden:    false,
+		ExpiryHeight:  0,
+	}, nil
+}
+
+func (q Querier) PendingIncentives(
+	goCtx context.Context,
+	req *types.QueryPendingIncentives,
+) (*types.QueryPendingIncentivesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	incentives := []types.CollateralIncentive{}
+	total := sdk.NewCoins()
+	for _, c := range q.Keeper.GetBorrowerCollateral(ctx, addr) {
+		rewards := q.Keeper.pendingIncentives(ctx, addr, c.Denom)
+		if rewards.IsZero() {
+			continue
+		}
+		incentives = append(incentives, types.CollateralIncentive{
+			CollateralDenom: c.Denom,
+			Rewards:         rewards,
+		})
+		total = total.Add(rewards...)
+	}
+
+	return &types.QueryPendingIncentivesResponse{
+		Incentives: incentives,
+		Total:      total,
+	}, nil
+}
+
+func (q Querier) WithdrawImpact(
+	goCtx context.Context,
+	req *types.QueryWithdrawImpact,
+) (*types.QueryWithdrawImpactResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateUToken(req.Asset); err != nil {
+		return nil, err
+	}
+	uDenom := req.Asset.Denom
+
+	// withdraw uses uTokens in the account's wallet before taking from collateral
+	walletAmount := q.Keeper.bankKeeper.SpendableCoins(ctx, addr).AmountOf(uDenom)
+	fromCollateral := sdk.MaxInt(req.Asset.Amount.Sub(walletAmount), sdk.ZeroInt())
+
+	bonded := q.Keeper.bondedCollateral(ctx, addr, uDenom)
+	unbonded := q.Keeper.unbondedCollateral(ctx, addr, uDenom)
+
+	return &types.QueryWithdrawImpactResponse{
+		FromCollateral:     sdk.NewCoin(uDenom, fromCollateral),
+		Bonded:             bonded,
+		ForfeitsIncentives: bonded.IsPositive() && fromCollateral.GT(unbonded.Amount),
+		PendingRewards:     q.Keeper.pendingIncentives(ctx, addr, uDenom),
+	}, nil
+}
+
+func (q Querier) RepayToFreeCollateral(
+	goCtx context.Context,
+	req *types.QueryRepayToFreeCollateral,
+) (*types.QueryRepayToFreeCollateralResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty address")
+	}
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	uDenom := req.Denom
+	if !types.HasUTokenPrefix(uDenom) {
+		uDenom = types.ToUTokenDenom(uDenom)
+	}
+
+	repayValue, options, err := q.Keeper.userRepayToFreeCollateral(ctx, addr, uDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRepayToFreeCollateralResponse{
+		RepayValue:   repayValue,
+		RepayOptions: options,
+	}, nil
+}
+
+func (q Querier) RegistryHash(
+	goCtx context.Context,
+	req *types.QueryRegistryHash,
+) (*types.QueryRegistryHashResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryRegistryHashResponse{
+		Hash:             hex.EncodeToString(q.Keeper.RegistryHash(ctx)),
+		LastUpdateHeight: q.Keeper.GetRegistryUpdateHeight(ctx),
+	}, nil
+}
+
+func (q Querier) MarketSummaries(
+	goCtx context.Context,
+	req *types.QueryMarketSummaries,
+) (*types.QueryMarketSummariesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	requested := map[string]bool{}
+	for _, denom := range req.Denoms {
+		requested[denom] = true
+	}
+
+	summaries := []types.DenomMarketSummary{}
+	for _, token := range q.Keeper.GetAllRegisteredTokens(ctx) {
+		if len(requested) > 0 && !requested[token.BaseDenom] {
+			continue
+		}
+		delete(requested, token.BaseDenom)
+		summaries = append(summaries, types.DenomMarketSummary{
+			Denom:   token.BaseDenom,
+			Summary: q.Keeper.marketSummary(ctx, token),
+		})
+	}
+	for _, denom := range req.Denoms {
+		if requested[denom] {
+			return nil, types.ErrNotRegisteredToken.Wrap(denom)
+		}
+	}
+
+	return &types.QueryMarketSummariesResponse{Summaries: summaries}, nil
+}
+
+func (q Querier) AccountEquity(
+	goCtx context.Context,
+	req *types.QueryAccountEquity,
+) (*types.QueryAccountEquityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	equity, collateralValue, leverage, err := q.Keeper.AccountEquity(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccountEquityResponse{
+		Equity:          equity,
+		CollateralValue: collateralValue,
+		LeverageRatio:   leverage,
+	}, nil
+}
+
+func (q Querier) AccrualInfo(
+	goCtx context.Context,
+	req *types.QueryAccrualInfo,
+) (*types.QueryAccrualInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	lastHeight := q.Keeper.getLastInterestHeight(ctx)
+	lastTime := q.Keeper.getLastInterestTime(ctx)
+
+	accruals := []types.DenomAccrualInfo{}
+	for _, token := range q.Keeper.GetAllRegisteredTokens(ctx) {
+		info := types.DenomAccrualInfo{
+			Denom:             token.BaseDenom,
+			LastAccrualHeight: lastHeight,
+			LastAccrualTime:   lastTime,
+		}
+		if !token.Blacklist {
+			// interest accrues every EndBlock, so the next accrual is in the upcoming block
+			info.NextAccrualHeight = ctx.BlockHeight() + 1
+		}
+		accruals = append(accruals, info)
+	}
+
+	return &types.QueryAccrualInfoResponse{Accruals: accruals}, nil
+}
+
+func (q Querier) AccountMarkets(
+	goCtx context.Context,
+	req *types.QueryAccountMarkets,
+) (*types.QueryAccountMarketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	markets := q.Keeper.AccountMarkets(ctx, addr)
+	if req.MinValue == nil {
+		return &types.QueryAccountMarketsResponse{Markets: markets}, nil
+	}
+
+	markets, omitted, err := q.Keeper.FilterAccountMarkets(ctx, addr, markets, *req.MinValue)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryAccountMarketsResponse{
+		Markets: markets,
+		Omitted: omitted,
+	}, nil
+}
+
+func (q Querier) DebtByCollateral(
+	goCtx context.Context,
+	req *types.QueryDebtByCollateral,
+) (*types.QueryDebtByCollateralResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !q.Keeper.liquidatorQueryEnabled {
+		return nil, types.ErrNotLiquidatorNode
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	debts, unattributed, err := q.Keeper.GetDebtByCollateral(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDebtByCollateralResponse{
+		Debts:             debts,
+		UnattributedValue: unattributed,
+	}, nil
+}
+
+func (q Querier) ProtocolCollateralComposition(
+	goCtx context.Context,
+	req *types.QueryProtocolCollateralComposition,
+) (*types.QueryProtocolCollateralCompositionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !q.Keeper.liquidatorQueryEnabled {
+		return nil, types.ErrNotLiquidatorNode
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	composition, totalValue, err := q.Keeper.GetCollateralComposition(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryProtocolCollateralCompositionResponse{
+		Composition: composition,
+		TotalValue:  totalValue,
+	}, nil
+}
+
+func (q Querier) BorrowUtilization(
+	goCtx context.Context,
+	req *types.QueryBorrowUtilization,
+) (*types.QueryBorrowUtilizationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	utilization, borrowedValue, borrowLimit, overLimit, err := q.Keeper.BorrowUtilization(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBorrowUtilizationResponse{
+		Utilization:   utilization,
+		BorrowedValue: borrowedValue,
+		BorrowLimit:   borrowLimit,
+		OverLimit:     overLimit,
+	}, nil
+}
+
+func (q Querier) FullLiquidationPlan(
+	goCtx context.Context,
+	req *types.QueryFullLiquidationPlan,
+) (*types.QueryFullLiquidationPlanResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	steps, closeFactor, err := q.Keeper.FullLiquidationPlan(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFullLiquidationPlanResponse{
+		Steps:       steps,
+		CloseFactor: closeFactor,
+	}, nil
+}
+
+func (q Querier) BestLiquidation(
+	goCtx context.Context,
+	req *types.QueryBestLiquidation,
+) (*types.QueryBestLiquidationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	repay, reward, profit, err := q.Keeper.BestLiquidation(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBestLiquidationResponse{
+		Repay:       repay,
+		RewardDenom: reward.Denom,
+		Reward:      reward,
+		Profit:      profit,
+	}, nil
+}
+
+func (q Querier) InterestIndex(
+	goCtx context.Context,
+	req *types.QueryInterestIndex,
+) (*types.QueryInterestIndexResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	token, err := q.Keeper.marketToken(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &types.QueryInterestIndexResponse{
+		InterestScalar: q.Keeper.getInterestScalar(ctx, token.BaseDenom),
+	}
+	if !token.Blacklist {
+		resp.LastUpdateHeight = q.Keeper.getLastInterestHeight(ctx)
+	}
+	return resp, nil
+}
+
+func (q Querier) AverageBorrowAPY(
+	goCtx context.Context,
+	req *types.QueryAverageBorrowAPY,
+) (*types.QueryAverageBorrowAPYResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	token, err := q.Keeper.marketToken(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	apy, seconds, err := q.Keeper.AverageBorrowAPY(ctx, token.BaseDenom, req.Lookback)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAverageBorrowAPYResponse{
+		Borrow_APY:     apy,
+		SampledSeconds: seconds,
+	}, nil
+}
+
+func (q Querier) BorrowLimitBreakdown(
+	goCtx context.Context,
+	req *types.QueryBorrowLimitBreakdown,
+) (*types.QueryBorrowLimitBreakdownResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	contributions, borrowLimit, err := q.Keeper.BorrowLimitBreakdown(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBorrowLimitBreakdownResponse{
+		Contributions: contributions,
+		BorrowLimit:   borrowLimit,
+	}, nil
+}
+
+func (q Querier) LiquidationThresholdBreakdown(
+	goCtx context.Context,
+	req *types.QueryLiquidationThresholdBreakdown,
+) (*types.QueryLiquidationThresholdBreakdownResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	contributions, liquidationThreshold, err := q.Keeper.LiquidationThresholdBreakdown(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryLiquidationThresholdBreakdownResponse{
+		Contributions:        contributions,
+		LiquidationThreshold: liquidationThreshold,
+	}, nil
+}
+
+func (q Querier) CanWithdraw(
+	goCtx context.Context,
+	req *types.QueryCanWithdraw,
+) (*types.QueryCanWithdrawResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty address")
+	}
+	if err := validateUToken(req.Asset); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := q.Keeper.GetTokenSettings(ctx, types.ToTokenDenom(req.Asset.Denom)); err != nil {
+		return nil, err
+	}
+
+	if err := q.Keeper.CanWithdraw(ctx, addr, req.Asset); err != nil {
+		return &types.QueryCanWithdrawResponse{
+			Allowed: false,
+			Reason:  withdrawReason(err),
+			Details: err.Error(),
+		}, nil
+	}
+	return &types.QueryCanWithdrawResponse{Allowed: true}, nil
+}
+
+func (q Querier) CanFullExit(
+	goCtx context.Context,
+	req *types.QueryCanFullExit,
+) (*types.QueryCanFullExitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	shortfalls, err := q.Keeper.fullExitShortfalls(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCanFullExitResponse{
+		CanExit:    shortfalls.IsZero(),
+		Shortfalls: shortfalls,
+	}, nil
+}
+
+func (q Querier) ReserveCoverage(
+	goCtx context.Context,
+	req *types.QueryReserveCoverage,
+) (*types.QueryReserveCoverageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	token, err := q.Keeper.marketToken(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	reserves := q.Keeper.GetReserves(ctx, token.BaseDenom)
+	borrowed := q.Keeper.GetTotalBorrowed(ctx, token.BaseDenom)
+	coverage := types.NoBorrowReserveCoverage
+	if borrowed.IsPositive() {
+		coverage = toDec(reserves.Amount).Quo(toDec(borrowed.Amount))
+	}
+
+	return &types.QueryReserveCoverageResponse{
+		Reserves: reserves,
+		Borrowed: borrowed,
+		Coverage: coverage,
+	}, nil
+}
+
+func (q Querier) UTokenSupply(
+	goCtx context.Context,
+	req *types.QueryUTokenSupply,
+) (*types.QueryUTokenSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if !types.HasUTokenPrefix(req.Denom) {
+		return nil, types.ErrNotUToken.Wrap(req.Denom)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := q.Keeper.GetTokenSettings(ctx, types.ToTokenDenom(req.Denom)); err != nil {
+		return nil, err
+	}
+
+	return &types.QueryUTokenSupplyResponse{
+		Supply: q.Keeper.GetUTokenSupply(ctx, req.Denom),
+	}, nil
+}
+
+func (q Querier) AccountNetAPY(
+	goCtx context.Context,
+	req *types.QueryAccountNetAPY,
+) (*types.QueryAccountNetAPYResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	netAPY, equity, supplyIncome, incentiveIncome, borrowCost, err := q.Keeper.AccountNetAPY(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccountNetAPYResponse{
+		Net_APY:         netAPY,
+		Equity:          equity,
+		SupplyIncome:    supplyIncome,
+		IncentiveIncome: incentiveIncome,
+		BorrowCost:      borrowCost,
+	}, nil
+}
+
+func (q Querier) FreeCollateral(
+	goCtx context.Context,
+	req *types.QueryFreeCollateral,
+) (*types.QueryFreeCollateralResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	free, err := q.Keeper.FreeCollateral(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFreeCollateralResponse{
+		FreeCollateral: free,
+	}, nil
+}
+
+func (q Querier) IncentiveEligibility(
+	goCtx context.Context,
+	req *types.QueryIncentiveEligibility,
+) (*types.QueryIncentiveEligibilityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryIncentiveEligibilityResponse{
+		Programs: q.Keeper.incentiveEligibility(ctx, addr),
+	}, nil
+}
+
+func (q Querier) TopBorrowers(
+	goCtx context.Context,
+	req *types.QueryTopBorrowers,
+) (*types.QueryTopBorrowersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Limit == 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit must be positive")
+	}
+
+	if !q.Keeper.liquidatorQueryEnabled {
+		return nil, types.ErrNotLiquidatorNode
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	limit := int(req.Limit)
+	if limit > types.MaxTopBorrowers {
+		limit = types.MaxTopBorrowers
+	}
+	borrowers, err := q.Keeper.GetTopBorrowers(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTopBorrowersResponse{
+		Borrowers: borrowers,
+	}, nil
+}
+
+func (q Querier) TopSuppliers(
+	goCtx context.Context,
+	req *types.QueryTopSuppliers,
+) (*types.QueryTopSuppliersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Limit == 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit must be positive")
+	}
+
+	if !q.Keeper.liquidatorQueryEnabled {
+		return nil, types.ErrNotLiquidatorNode
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.Denom != "" {
+		if _, err := q.Keeper.GetTokenSettings(ctx, req.Denom); err != nil {
+			return nil, err
+		}
+	}
+
+	limit := int(req.Limit)
+	if limit > types.MaxTopSuppliers {
+		limit = types.MaxTopSuppliers
+	}
+	suppliers, err := q.Keeper.GetTopSuppliers(ctx, req.Denom, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTopSuppliersResponse{
+		Suppliers: suppliers,
+	}, nil
+}
+
+func (q Querier) LiquidationBuffer(
+	goCtx context.Context,
+	req *types.QueryLiquidationBuffer,
+) (*types.QueryLiquidationBufferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var tokens []types.Token
+	if req.Denom != "" {
+		token, err := q.Keeper.GetTokenSettings(ctx, req.Denom)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, token)
+	} else {
+		tokens = q.Keeper.GetAllRegisteredTokens(ctx)
+	}
+
+	buffers := make([]types.LiquidationBuffer, len(tokens))
+	for i, t := range tokens {
+		buffers[i] = types.LiquidationBuffer{
+			Denom:  t.BaseDenom,
+			Buffer: t.LiquidationThreshold.Sub(t.CollateralWeight),
+		}
+	}
+
+	return &types.QueryLiquidationBufferResponse{
+		Buffers: buffers,
+	}, nil
+}
+
+func (q Querier) CollateralPriceFloors(
+	goCtx context.Context,
+	req *types.QueryCollateralPriceFloors,
+) (*types.QueryCollateralPriceFloorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	floors, err := q.Keeper.CollateralPriceFloors(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCollateralPriceFloorsResponse{
+		Floors: floors,
+	}, nil
+}
+
+func (q Querier) BorrowAPY(
+	goCtx context.Context,
+	req *types.QueryBorrowAPY,
+) (*types.QueryBorrowAPYResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+	if req.Utilization != nil && (req.Utilization.IsNegative() || req.Utilization.GT(sdk.OneDec())) {
+		return nil, status.Errorf(codes.InvalidArgument, "utilization must be between 0 and 1: %s", req.Utilization)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	token, err := q.Keeper.marketToken(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	utilization := q.Keeper.SupplyUtilization(ctx, token.BaseDenom)
+	if req.Utilization != nil {
+		utilization = *req.Utilization
+	}
+	apy, err := q.Keeper.BorrowAPYAtUtilization(ctx, token.BaseDenom, utilization)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBorrowAPYResponse{
+		Borrow_APY:  apy,
+		Utilization: utilization,
+	}, nil
+}
+
+func (q Querier) ModuleConfig(
+	goCtx context.Context,
+	req *types.QueryModuleConfig,
+) (*types.QueryModuleConfigResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	features := q.Keeper.GetParams(ctx).FeatureFlags()
+	// liquidator queries are a node setting rather than a param, so they can differ between nodes
+	features = append(features, types.FeatureFlag{Name: "liquidator_queries", Enabled: q.Keeper.liquidatorQueryEnabled})
+
+	return &types.QueryModuleConfigResponse{
+		ConsensusVersion: types.ConsensusVersion,
+		Features:         features,
+	}, nil
+}
+
+func (q Querier) RequiredCollateral(
+	goCtx context.Context,
+	req *types.QueryRequiredCollateral,
+) (*types.QueryRequiredCollateralResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.CollateralDenom == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty collateral denom")
+	}
+	margin := sdk.ZeroDec()
+	if req.SafetyMargin != nil {
+		margin = *req.SafetyMargin
+	}
+	if margin.IsNegative() || margin.GTE(sdk.OneDec()) {
+		return nil, status.Errorf(codes.InvalidArgument, "safety margin must be at least 0 and less than 1: %s", margin)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var addr sdk.AccAddress
+	if req.Address != "" {
+		var err error
+		addr, err = sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	required, err := q.Keeper.RequiredCollateralForBorrow(ctx, addr, req.Borrow, req.CollateralDenom, margin)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRequiredCollateralResponse{
+		Required: required,
+	}, nil
+}
+
+// HealthDistribution implements the Query/HealthDistribution gRPC method, which counts borrowers
+// in buckets of health factor. It is only enabled on liquidator nodes.
+func (q Querier) HealthDistribution(
+	goCtx context.Context,
+	req *types.QueryHealthDistribution,
+) (*types.QueryHealthDistributionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	bounds := req.Bounds
+	if len(bounds) == 0 {
+		bounds = types.DefaultHealthBounds()
+	}
+	if len(bounds) > types.MaxHealthBounds {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d bounds may be given", types.MaxHealthBounds)
+	}
+	for i, b := range bounds {
+		if !b.IsPositive() {
+			return nil, status.Error(codes.InvalidArgument, "bounds must be positive")
+		}
+		if i > 0 && !b.GT(bounds[i-1]) {
+			return nil, status.Error(codes.InvalidArgument, "bounds must be strictly increasing")
+		}
+	}
+
+	if !q.Keeper.liquidatorQueryEnabled {
+		return nil, types.ErrNotLiquidatorNode
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	buckets, unknownBorrowers, unknownValue, err := q.Keeper.GetHealthDistribution(ctx, bounds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryHealthDistributionResponse{
+		Buckets:              buckets,
+		UnknownBorrowers:     unknownBorrowers,
+		UnknownBorrowedValue: unknownValue,
+	}, nil
+}
+
+func (q Querier) InterestParams(
+	goCtx context.Context,
+	req *types.QueryInterestParams,
+) (*types.QueryInterestParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	token, err := q.Keeper.marketToken(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+	lowSlope, highSlope := interestSlopes(token)
+
+	return &types.QueryInterestParamsResponse{
+		BaseBorrowRate:  token.BaseBorrowRate,
+		KinkBorrowRate:  token.KinkBorrowRate,
+		MaxBorrowRate:   token.MaxBorrowRate,
+		KinkUtilization: token.KinkUtilization,
+		LowSlope:        lowSlope,
+		HighSlope:       highSlope,
+		ReserveFactor:   token.ReserveFactor,
+		Blacklist:       token.Blacklist,
+	}, nil
+}
+
+func (q Querier) GlobalStats(
+	goCtx context.Context,
+	req *types.QueryGlobalStats,
+) (*types.QueryGlobalStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	markets := uint64(0)
+	supplied, borrowed := sdk.NewCoins(), sdk.NewCoins()
+	for _, token := range q.Keeper.GetAllRegisteredTokens(ctx) {
+		if token.Blacklist {
+			continue
+		}
+		markets++
+		supply, err := q.Keeper.GetTotalSupply(ctx, token.BaseDenom)
+		if err != nil {
+			return nil, err
+		}
+		supplied = supplied.Add(supply)
+		borrowed = borrowed.Add(q.Keeper.GetTotalBorrowed(ctx, token.BaseDenom))
+	}
+
+	// skips denoms without prices
+	suppliedValue, err := q.Keeper.VisibleTokenValue(ctx, supplied, types.PriceModeSpot)
+	if err != nil {
+		return nil, err
+	}
+	borrowedValue, err := q.Keeper.VisibleTokenValue(ctx, borrowed, types.PriceModeSpot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGlobalStatsResponse{
+		Borrowers:          q.Keeper.getAccountCount(ctx, types.KeyBorrowerCount),
+		Suppliers:          q.Keeper.getAccountCount(ctx, types.KeyCollateralAccountCount),
+		Markets:            markets,
+		TotalSuppliedValue: suppliedValue,
+		TotalBorrowedValue: borrowedValue,
+	}, nil
+}
+
+func (q Querier) CollateralizePreview(
+	goCtx context.Context,
+	req *types.QueryCollateralizePreview,
+) (*types.QueryCollateralizePreviewResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.Keeper.validateCollateralize(ctx, req.Asset); err != nil {
+		return nil, err
+	}
+
+	current, preview, err := q.Keeper.previewCollateralize(ctx, addr, req.Asset)
+	if err != nil {
+		return nil, err
+	}
+
+	// collateralize only uses uTokens in the account's wallet
+	walletAmount := q.Keeper.bankKeeper.SpendableCoins(ctx, addr).AmountOf(req.Asset.Denom)
+
+	return &types.QueryCollateralizePreviewResponse{
+		BorrowLimit:         preview,
+		BorrowLimitIncrease: preview.Sub(current),
+		WhatIf:              walletAmount.LT(req.Asset.Amount),
+	}, nil
+}
+
+func (q Querier) AccountLiquidationView(
+	goCtx context.Context,
+	req *types.QueryAccountLiquidationView,
+) (*types.QueryAccountLiquidationViewResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	// borrower health is computed as in getLiquidationAmounts, using spot prices only
+	borrowedValue, err := q.Keeper.VisibleTokenValue(ctx, q.Keeper.GetBorrowerBorrows(ctx, addr), types.PriceModeSpot)
+	if err != nil {
+		return nil, err
+	}
+	liquidationThreshold, err := q.Keeper.CalculateLiquidationThreshold(ctx, q.Keeper.GetBorrowerCollateral(ctx, addr))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccountLiquidationViewResponse{
+		LiquidationThreshold: liquidationThreshold,
+		BorrowedValue:        borrowedValue,
+		Shortfall:            sdk.MaxDec(borrowedValue.Sub(liquidationThreshold), sdk.ZeroDec()),
+		Liquidatable:         borrowedValue.IsPositive() && borrowedValue.GTE(liquidationThreshold),
+	}, nil
+}
+
+func (q Querier) LifetimeReserves(
+	goCtx context.Context,
+	req *types.QueryLifetimeReserves,
+) (*types.QueryLifetimeReservesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	token, err := q.Keeper.marketToken(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryLifetimeReservesResponse{
+		LifetimeReserves: q.Keeper.GetLifetimeReserves(ctx, token.BaseDenom),
+		SinceHeight:      q.Keeper.GetLifetimeReservesHeight(ctx),
+	}, nil
+}
+
+func (q Querier) APYSeries(
+	goCtx context.Context,
+	req *types.QueryAPYSeries,
+) (*types.QueryAPYSeriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	token, err := q.Keeper.marketToken(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	points, err := q.Keeper.APYSeries(ctx, token.BaseDenom, req.Since, req.Interval)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAPYSeriesResponse{Points: points}, nil
+}
+
+func (q Querier) LiquidationRewardsPaid(
+	goCtx context.Context,
+	req *types.QueryLiquidationRewardsPaid,
+) (*types.QueryLiquidationRewardsPaidResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	token, err := q.Keeper.marketToken(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryLiquidationRewardsPaidResponse{
+		RewardsPaid: q.Keeper.GetLiquidationRewardsPaid(ctx, token.BaseDenom),
+		SinceHeight: q.Keeper.GetLiquidationRewardsHeight(ctx),
+	}, nil
+}
+
+func (q Querier) SolvencyPriceFloor(
+	goCtx context.Context,
+	req *types.QuerySolvencyPriceFloor,
+) (*types.QuerySolvencyPriceFloorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	token, err := q.Keeper.marketToken(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	floor, price, collateralValue, borrowedValue, err := q.Keeper.SolvencyPriceFloor(ctx, token.BaseDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySolvencyPriceFloorResponse{
+		PriceFloor:      floor,
+		Price:           price,
+		CollateralValue: collateralValue,
+		BorrowedValue:   borrowedValue,
+	}, nil
+}
+
+func (q Querier) StopLoss(
+	goCtx context.Context,
+	req *types.QueryStopLoss,
+) (*types.QueryStopLossResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &types.QueryStopLossResponse{}
+	if stopLoss, ok := q.Keeper.GetStopLoss(ctx, addr); ok {
+		resp.StopLoss = &stopLoss
+	}
+
+	// health factor will be null if the account has no borrows or a price is missing
+	healthFactor, hasBorrows, err := q.Keeper.healthFactor(ctx, addr)
+	if nonOracleError(err) {
+		return nil, err
+	}
+	if err == nil && hasBorrows {
+		resp.HealthFactor = &healthFactor
+		resp.Triggered = resp.StopLoss != nil && healthFactor.LTE(resp.StopLoss.TargetHealthFactor)
+	}
+
+	return resp, nil
+}
+
+func (q Querier) AccountEffectiveBorrowRate(
+	goCtx context.Context,
+	req *types.QueryAccountEffectiveBorrowRate,
+) (*types.QueryAccountEffectiveBorrowRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	rate, borrowedValue, borrowCost, components, err := q.Keeper.AccountEffectiveBorrowRate(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccountEffectiveBorrowRateResponse{
+		EffectiveRate: rate,
+		BorrowedValue: borrowedValue,
+		BorrowCost:    borrowCost,
+		Components:    components,
+	}, nil
+}
+
+func (q Querier) ReserveState(
+	goCtx context.Context,
+	req *types.QueryReserveState,
+) (*types.QueryReserveStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryReserveStateResponse{States: q.Keeper.GetReserveState(ctx)}, nil
+}
+
+func (q Querier) BorrowableMarkets(
+	goCtx context.Context,
+	req *types.QueryBorrowableMarkets,
+) (*types.QueryBorrowableMarketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	markets, err := q.Keeper.BorrowableMarkets(ctx, addr, req.IncludeZero)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBorrowableMarketsResponse{Markets: markets}, nil
+}
+
+func (q Querier) ExchangeRateTrend(
+	goCtx context.Context,
+	req *types.QueryExchangeRateTrend,
+) (*types.QueryExchangeRateTrendResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	token, err := q.Keeper.marketToken(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	current, past, pastTime, growth, err := q.Keeper.ExchangeRateTrend(ctx, token.BaseDenom, req.Lookback)
+	if err != nil {
+		return nil, err
+	}
+
+	trend := types.ExchangeRateTrend_EXCHANGE_RATE_TREND_FLAT
+	if current.GT(past) {
+		trend = types.ExchangeRateTrend_EXCHANGE_RATE_TREND_RISING
+	} else if current.LT(past) {
+		trend = types.ExchangeRateTrend_EXCHANGE_RATE_TREND_FALLING
+	}
+
+	return &types.QueryExchangeRateTrendResponse{
+		CurrentRate:      current,
+		PastRate:         past,
+		PastTime:         pastTime,
+		AnnualizedGrowth: growth,
+		Trend:            trend,
+	}, nil
+}
+
+func (q Querier) DebtReserveRatioSeries(
+	goCtx context.Context,
+	req *types.QueryDebtReserveRatioSeries,
+) (*types.QueryDebtReserveRatioSeriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	token, err := q.Keeper.marketToken(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	points, err := q.Keeper.DebtReserveRatioSeries(ctx, token.BaseDenom, req.Since, req.Interval)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDebtReserveRatioSeriesResponse{Points: points}, nil
+}
+
+func (q Querier) AggregateBorrowUtilization(
+	goCtx context.Context,
+	req *types.QueryAggregateBorrowUtilization,
+) (*types.QueryAggregateBorrowUtilizationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !q.Keeper.liquidatorQueryEnabled {
+		return nil, types.ErrNotLiquidatorNode
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	utilization, borrowedValue, borrowLimit, accounts, err := q.Keeper.GetAggregateBorrowUtilization(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAggregateBorrowUtilizationResponse{
+		Utilization:   utilization,
+		BorrowedValue: borrowedValue,
+		BorrowLimit:   borrowLimit,
+		Accounts:      accounts,
+	}, nil
+}
+
+func (q Querier) SupplyAPY(
+	goCtx context.Context,
+	req *types.QuerySupplyAPY,
+) (*types.QuerySupplyAPYResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+	if req.HoldingPeriod < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "holding period must not be negative: %s", req.HoldingPeriod)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	token, err := q.Keeper.marketToken(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	apy, err := q.Keeper.RealizedSupplyAPY(ctx, token.BaseDenom, req.HoldingPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySupplyAPYResponse{
+		Supply_APY:      apy,
+		GrossSupply_APY: q.Keeper.DeriveSupplyAPY(ctx, token.BaseDenom),
+		WithdrawFee:     token.WithdrawFee,
+	}, nil
+}
+
+func (q Querier) RemainingCapacity(
+	goCtx context.Context,
+	req *types.QueryRemainingCapacity,
+) (*types.QueryRemainingCapacityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining, borrowedValue, borrowLimit, markets, err := q.Keeper.RemainingCapacity(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRemainingCapacityResponse{
+		RemainingValue: remaining,
+		BorrowedValue:  borrowedValue,
+		BorrowLimit:    borrowLimit,
+		Markets:        markets,
+	}, nil
+}
+
+func (q Querier) AccrualStaleness(
+	goCtx context.Context,
+	req *types.QueryAccrualStaleness,
+) (*types.QueryAccrualStalenessResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := q.Keeper.marketToken(ctx, req.Denom); err != nil {
+		return nil, err
+	}
+
+	// interest is accrued on all tokens at once, so the last accrual is shared by every market
+	lastHeight := q.Keeper.getLastInterestHeight(ctx)
+	lastTime := q.Keeper.getLastInterestTime(ctx)
+
+	return &types.QueryAccrualStalenessResponse{
+		LastAccrualHeight: lastHeight,
+		LastAccrualTime:   lastTime,
+		BlocksElapsed:     ctx.BlockHeight() - lastHeight,
+		TimeElapsed:       time.Duration(ctx.BlockTime().Unix()-lastTime) * time.Second,
+	}, nil
+}
+
+func (q Querier) BadDebtHistory(
+	goCtx context.Context,
+	req *types.QueryBadDebtHistory,
+) (*types.QueryBadDebtHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	historyStore := prefix.NewStore(ctx.KVStore(q.Keeper.storeKey), types.KeyPrefixBadDebtHistory)
+	records := []types.BadDebtRecord{}
+	pageRes, err := query.Paginate(historyStore, req.Pagination, func(_, value []byte) error {
+		var record types.BadDebtRecord
+		if err := q.Keeper.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryBadDebtHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
+
+func (q Querier) AccountBorrowCap(
+	goCtx context.Context,
+	req *types.QueryAccountBorrowCap,
+) (*types.QueryAccountBorrowCapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccountBorrowCapResponse{BorrowCap: q.Keeper.GetAccountBorrowCap(ctx, addr)}, nil
+}
+
+func (q Querier) AccountCollateralQuality(
+	goCtx context.Context,
+	req *types.QueryAccountCollateralQuality,
+) (*types.QueryAccountCollateralQualityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	collateralWeight, liquidationThreshold, err := q.Keeper.AccountCollateralQuality(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccountCollateralQualityResponse{
+		CollateralWeight:     collateralWeight,
+		LiquidationThreshold: liquidationThreshold,
+	}, nil
+}
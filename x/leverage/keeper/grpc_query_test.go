@@ -1,14 +1,19 @@
 package keeper_test
 
 import (
+	"bytes"
 	"context"
+	"sort"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"gotest.tools/v3/assert"
 
 	appparams "github.com/umee-network/umee/v5/app/params"
 	"github.com/umee-network/umee/v5/util/coin"
 	"github.com/umee-network/umee/v5/x/leverage/fixtures"
+	"github.com/umee-network/umee/v5/x/leverage/keeper"
 	"github.com/umee-network/umee/v5/x/leverage/types"
 )
 
@@ -54,6 +59,107 @@ func (s *IntegrationTestSuite) TestQuerier_RegisteredTokens() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestQuerier_RegisteredTokensFilters() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+
+	// ATOM cannot be borrowed, DAI cannot be collateral, and PUMP cannot be supplied
+	atom, err := app.LeverageKeeper.GetTokenSettings(ctx, atomDenom)
+	require.NoError(err)
+	atom.EnableMsgBorrow = false
+	require.NoError(app.LeverageKeeper.SetTokenSettings(ctx, atom))
+	dai, err := app.LeverageKeeper.GetTokenSettings(ctx, daiDenom)
+	require.NoError(err)
+	dai.CollateralWeight = sdk.ZeroDec()
+	dai.LiquidationThreshold = sdk.ZeroDec()
+	require.NoError(app.LeverageKeeper.SetTokenSettings(ctx, dai))
+	pump, err := app.LeverageKeeper.GetTokenSettings(ctx, pumpDenom)
+	require.NoError(err)
+	pump.EnableMsgSupply = false
+	require.NoError(app.LeverageKeeper.SetTokenSettings(ctx, pump))
+
+	denoms := func(req *types.QueryRegisteredTokens) []string {
+		resp, err := s.queryClient.RegisteredTokens(ctx.Context(), req)
+		require.NoError(err)
+		result := []string{}
+		for _, t := range resp.Registry {
+			result = append(result, t.BaseDenom)
+		}
+		return result
+	}
+
+	all := denoms(&types.QueryRegisteredTokens{})
+	require.Len(all, 5)
+	require.NotContains(denoms(&types.QueryRegisteredTokens{BorrowEnabled: true}), atomDenom)
+	require.Len(denoms(&types.QueryRegisteredTokens{BorrowEnabled: true}), 4)
+	require.NotContains(denoms(&types.QueryRegisteredTokens{CollateralEnabled: true}), daiDenom)
+	require.Len(denoms(&types.QueryRegisteredTokens{CollateralEnabled: true}), 4)
+	require.NotContains(denoms(&types.QueryRegisteredTokens{SupplyEnabled: true}), pumpDenom)
+	require.Len(denoms(&types.QueryRegisteredTokens{SupplyEnabled: true}), 4)
+
+	// filters are combined with AND
+	combined := denoms(&types.QueryRegisteredTokens{BorrowEnabled: true, CollateralEnabled: true, SupplyEnabled: true})
+	require.ElementsMatch([]string{appparams.BondDenom, dumpDenom}, combined)
+
+	// filters also apply to a single token query
+	require.Empty(denoms(&types.QueryRegisteredTokens{BaseDenom: atomDenom, BorrowEnabled: true}))
+	require.Equal([]string{atomDenom}, denoms(&types.QueryRegisteredTokens{BaseDenom: atomDenom, SupplyEnabled: true}))
+}
+
+func (s *IntegrationTestSuite) TestQuerier_RegisteredTokensCollateralWeight() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+
+	// ATOM has a collateral weight of 0.5 and DAI has zero, while other tokens keep 0.25
+	atom, err := app.LeverageKeeper.GetTokenSettings(ctx, atomDenom)
+	require.NoError(err)
+	atom.CollateralWeight = sdk.MustNewDecFromStr("0.5")
+	atom.LiquidationThreshold = sdk.MustNewDecFromStr("0.6")
+	require.NoError(app.LeverageKeeper.SetTokenSettings(ctx, atom))
+	dai, err := app.LeverageKeeper.GetTokenSettings(ctx, daiDenom)
+	require.NoError(err)
+	dai.CollateralWeight = sdk.ZeroDec()
+	dai.LiquidationThreshold = sdk.ZeroDec()
+	require.NoError(app.LeverageKeeper.SetTokenSettings(ctx, dai))
+
+	dec := func(s string) *sdk.Dec {
+		d := sdk.MustNewDecFromStr(s)
+		return &d
+	}
+	denoms := func(req *types.QueryRegisteredTokens) []string {
+		resp, err := s.queryClient.RegisteredTokens(ctx.Context(), req)
+		require.NoError(err)
+		result := []string{}
+		for _, t := range resp.Registry {
+			result = append(result, t.BaseDenom)
+		}
+		return result
+	}
+
+	// bounds are inclusive
+	require.Equal([]string{atomDenom}, denoms(&types.QueryRegisteredTokens{MinCollateralWeight: dec("0.3")}))
+	require.Equal([]string{atomDenom}, denoms(&types.QueryRegisteredTokens{MinCollateralWeight: dec("0.5")}))
+	require.Len(denoms(&types.QueryRegisteredTokens{MinCollateralWeight: dec("0.25")}), 4)
+	require.Equal([]string{daiDenom}, denoms(&types.QueryRegisteredTokens{MaxCollateralWeight: dec("0.1")}))
+	require.Len(denoms(&types.QueryRegisteredTokens{MaxCollateralWeight: dec("0.25")}), 4)
+
+	// a range excludes tokens on either side
+	middle := denoms(&types.QueryRegisteredTokens{MinCollateralWeight: dec("0.1"), MaxCollateralWeight: dec("0.4")})
+	require.ElementsMatch([]string{appparams.BondDenom, dumpDenom, pumpDenom}, middle)
+	require.Empty(denoms(&types.QueryRegisteredTokens{MinCollateralWeight: dec("0.3"), MaxCollateralWeight: dec("0.4")}))
+
+	// weight filters combine with capability filters and single token queries
+	require.Empty(denoms(&types.QueryRegisteredTokens{CollateralEnabled: true, MaxCollateralWeight: dec("0.1")}))
+	require.Empty(denoms(&types.QueryRegisteredTokens{BaseDenom: atomDenom, MaxCollateralWeight: dec("0.4")}))
+
+	// invalid ranges are rejected
+	_, err = s.queryClient.RegisteredTokens(ctx.Context(), &types.QueryRegisteredTokens{MinCollateralWeight: dec("-0.1")})
+	require.ErrorContains(err, "collateral weight must be between 0 and 1")
+	_, err = s.queryClient.RegisteredTokens(ctx.Context(), &types.QueryRegisteredTokens{MaxCollateralWeight: dec("1.1")})
+	require.ErrorContains(err, "collateral weight must be between 0 and 1")
+	_, err = s.queryClient.RegisteredTokens(ctx.Context(),
+		&types.QueryRegisteredTokens{MinCollateralWeight: dec("0.5"), MaxCollateralWeight: dec("0.4")})
+	require.ErrorContains(err, "exceeds max collateral weight")
+}
+
 func (s *IntegrationTestSuite) TestQuerier_Params() {
 	ctx, require := s.ctx, s.Require()
 
@@ -117,7 +223,8 @@ func (s *IntegrationTestSuite) TestQuerier_AccountBalances() {
 		Collateral: sdk.NewCoins(
 			coin.New("u/"+umeeDenom, 1000),
 		),
-		Borrowed: nil,
+		Borrowed:       nil,
+		PrefixSupplied: sdk.ZeroInt(),
 	}
 
 	require.Equal(expected, *resp)
@@ -153,6 +260,116 @@ func (s *IntegrationTestSuite) TestQuerier_AccountSummary() {
 	}
 
 	require.Equal(expected, *resp)
+
+	// values can be expressed in UMEE instead of USD
+	resp, err = s.queryClient.AccountSummary(ctx.Context(), &types.QueryAccountSummary{
+		Address:    addr.String(),
+		QuoteDenom: umeeDenom,
+	})
+	require.NoError(err)
+	lt = sdk.MustNewDecFromStr("260")
+	expected.SuppliedValue = sdk.MustNewDecFromStr("1000")
+	expected.CollateralValue = sdk.MustNewDecFromStr("1000")
+	expected.BorrowLimit = sdk.MustNewDecFromStr("250")
+	expected.LiquidationThreshold = &lt
+	expected.QuoteDenom = umeeDenom
+	require.Equal(expected, *resp)
+
+	// the quote denom must be a registered token with a price
+	_, err = s.queryClient.AccountSummary(ctx.Context(), &types.QueryAccountSummary{
+		Address:    addr.String(),
+		QuoteDenom: "uabcd",
+	})
+	require.ErrorContains(err, types.ErrNotRegisteredToken.Error())
+}
+
+func (s *IntegrationTestSuite) TestQuerier_AccountSummaryLiquidityHaircut() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+
+	// UMEE is given a 20% liquidity haircut
+	umeeToken, err := app.LeverageKeeper.GetTokenSettings(ctx, umeeDenom)
+	require.NoError(err)
+	umeeToken.LiquidityHaircut = sdk.MustNewDecFromStr("0.2")
+	require.NoError(app.LeverageKeeper.SetTokenSettings(ctx, umeeToken))
+
+	// creates account which has supplied and collateralized 1000 UMEE, and borrowed 10 UMEE
+	addr := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(addr, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 1000_000000))
+	s.borrow(addr, coin.New(umeeDenom, 10_000000))
+
+	// without the haircut, values are unchanged
+	resp, err := s.queryClient.AccountSummary(ctx.Context(), &types.QueryAccountSummary{Address: addr.String()})
+	require.NoError(err)
+	require.Equal(sdk.MustNewDecFromStr("4210"), resp.CollateralValue)
+	require.Equal(sdk.MustNewDecFromStr("1052.5"), resp.BorrowLimit)
+
+	resp, err = s.queryClient.AccountSummary(ctx.Context(), &types.QueryAccountSummary{
+		Address:               addr.String(),
+		ApplyLiquidityHaircut: true,
+	})
+	require.NoError(err)
+	// (1000) * 0.8 * 4.21 = 3368
+	require.Equal(sdk.MustNewDecFromStr("3368"), resp.SuppliedValue)
+	require.Equal(sdk.MustNewDecFromStr("3368"), resp.CollateralValue)
+	// borrowed value is not reduced: (10) * 4.21 = 42.1
+	require.Equal(sdk.MustNewDecFromStr("42.1"), resp.BorrowedValue)
+	// (1000) * 0.8 * 4.21 * 0.25 = 842
+	require.Equal(sdk.MustNewDecFromStr("842"), resp.BorrowLimit)
+	// (1000) * 0.8 * 4.21 * 0.26 = 875.68
+	require.Equal(sdk.MustNewDecFromStr("875.68"), *resp.LiquidationThreshold)
+
+	// a full haircut counts no value at all
+	umeeToken.LiquidityHaircut = sdk.OneDec()
+	require.NoError(app.LeverageKeeper.SetTokenSettings(ctx, umeeToken))
+	resp, err = s.queryClient.AccountSummary(ctx.Context(), &types.QueryAccountSummary{
+		Address:               addr.String(),
+		ApplyLiquidityHaircut: true,
+	})
+	require.NoError(err)
+	require.Equal(sdk.ZeroDec(), resp.CollateralValue)
+	require.Equal(sdk.ZeroDec(), resp.BorrowLimit)
+}
+
+func (s *IntegrationTestSuite) TestQuerier_AccountSummaries() {
+	ctx, require := s.ctx, s.Require()
+
+	// one account supplies and collateralizes 1000 UMEE, the other only supplies 100 ATOM
+	addr1 := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(addr1, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(addr1, coin.New("u/"+umeeDenom, 1000_000000))
+	addr2 := s.newAccount(coin.New(atomDenom, 100_000000))
+	s.supply(addr2, coin.New(atomDenom, 100_000000))
+
+	resp, err := s.queryClient.AccountSummaries(ctx.Context(), &types.QueryAccountSummaries{
+		Addresses: []string{addr2.String(), addr1.String()},
+	})
+	require.NoError(err)
+	require.Len(resp.Summaries, 2)
+
+	// each entry matches the single-account summary, in request order
+	for i, addr := range []sdk.AccAddress{addr2, addr1} {
+		summary, err := s.queryClient.AccountSummary(ctx.Context(), &types.QueryAccountSummary{Address: addr.String()})
+		require.NoError(err)
+		require.Equal(addr.String(), resp.Summaries[i].Address)
+		require.Equal(*summary, resp.Summaries[i].Summary)
+	}
+	require.Equal(sdk.MustNewDecFromStr("4210"), resp.Summaries[1].Summary.CollateralValue)
+	require.Equal(sdk.ZeroDec(), resp.Summaries[0].Summary.CollateralValue)
+
+	// empty, invalid and oversized address lists are rejected
+	_, err = s.queryClient.AccountSummaries(ctx.Context(), &types.QueryAccountSummaries{})
+	require.ErrorContains(err, "empty addresses")
+	_, err = s.queryClient.AccountSummaries(ctx.Context(), &types.QueryAccountSummaries{
+		Addresses: []string{addr1.String(), "invalid"},
+	})
+	require.Error(err)
+	tooMany := make([]string, types.MaxAccountSummaries+1)
+	for i := range tooMany {
+		tooMany[i] = addr1.String()
+	}
+	_, err = s.queryClient.AccountSummaries(ctx.Context(), &types.QueryAccountSummaries{Addresses: tooMany})
+	require.ErrorContains(err, "at most 100 addresses")
 }
 
 func (s *IntegrationTestSuite) TestQuerier_LiquidationTargets() {
@@ -181,6 +398,138 @@ func (s *IntegrationTestSuite) TestQuerier_BadDebts() {
 	require.Equal(expected, *resp)
 }
 
+func (s *IntegrationTestSuite) TestQuerier_ReserveState() {
+	ctx, require := s.ctx, s.Require()
+
+	// no reserves or bad debt
+	resp, err := s.queryClient.ReserveState(ctx.Context(), &types.QueryReserveState{})
+	require.NoError(err)
+	require.Empty(resp.States)
+
+	// 100 UMEE and 5 ATOM are reserved, and a borrower has 8 ATOM of bad debt
+	require.NoError(s.tk.SetReserveAmount(ctx, coin.New(umeeDenom, 100_000000)))
+	require.NoError(s.tk.SetReserveAmount(ctx, coin.New(atomDenom, 5_000000)))
+	addr := s.newAccount()
+	require.NoError(s.tk.SetBorrow(ctx, addr, coin.New(atomDenom, 8_000000)))
+	require.NoError(s.tk.SetBadDebtAddress(ctx, addr, atomDenom, true))
+
+	resp, err = s.queryClient.ReserveState(ctx.Context(), &types.QueryReserveState{})
+	require.NoError(err)
+	require.Equal([]types.ReserveState{
+		{
+			Denom:       atomDenom,
+			Reserves:    coin.New(atomDenom, 5_000000),
+			BadDebt:     coin.New(atomDenom, 8_000000),
+			NetCoverage: sdk.NewDec(-3_000000),
+		},
+		{
+			Denom:       umeeDenom,
+			Reserves:    coin.New(umeeDenom, 100_000000),
+			BadDebt:     coin.Zero(umeeDenom),
+			NetCoverage: sdk.NewDec(100_000000),
+		},
+	}, resp.States)
+}
+
+func (s *IntegrationTestSuite) TestQuerier_BorrowableMarkets() {
+	ctx, require := s.ctx, s.Require()
+
+	query := func(addr sdk.AccAddress, includeZero bool) []types.BorrowableMarket {
+		resp, err := s.queryClient.BorrowableMarkets(ctx.Context(), &types.QueryBorrowableMarkets{
+			Address:     addr.String(),
+			IncludeZero: includeZero,
+		})
+		require.NoError(err)
+		return resp.Markets
+	}
+
+	// a supplier provides 10 ATOM of liquidity
+	supplier := s.newAccount(coin.New(atomDenom, 10_000000))
+	s.supply(supplier, coin.New(atomDenom, 10_000000))
+
+	// creates account which has supplied and collateralized 1000 UMEE
+	addr := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(addr, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 1000_000000))
+
+	// ATOM is limited to 90% of its liquidity by MaxSupplyUtilization, and UMEE by the borrow limit
+	// of 1000 * 4.21 * 0.25 = 1052.5, which is 250 UMEE
+	require.Equal([]types.BorrowableMarket{
+		{Denom: atomDenom, MaxBorrow: coin.New(atomDenom, 9_000000)},
+		{Denom: umeeDenom, MaxBorrow: coin.New(umeeDenom, 250_000000)},
+	}, query(addr, false))
+
+	// markets without liquidity are included on request
+	require.Equal([]types.BorrowableMarket{
+		{Denom: atomDenom, MaxBorrow: coin.New(atomDenom, 9_000000)},
+		{Denom: daiDenom, MaxBorrow: coin.Zero(daiDenom)},
+		{Denom: dumpDenom, MaxBorrow: coin.Zero(dumpDenom)},
+		{Denom: pumpDenom, MaxBorrow: coin.Zero(pumpDenom)},
+		{Denom: umeeDenom, MaxBorrow: coin.New(umeeDenom, 250_000000)},
+	}, query(addr, true))
+
+	// UMEE cannot be borrowed against its own collateral once NoSelfBorrow is set
+	umeeToken := newToken(umeeDenom, "UMEE", 6)
+	umeeToken.NoSelfBorrow = true
+	require.NoError(s.app.LeverageKeeper.SetTokenSettings(ctx, umeeToken))
+	require.Equal([]types.BorrowableMarket{
+		{Denom: atomDenom, MaxBorrow: coin.New(atomDenom, 9_000000)},
+	}, query(addr, false))
+}
+
+func (s *IntegrationTestSuite) TestQuerier_RemainingCapacity() {
+	ctx, require := s.ctx, s.Require()
+
+	query := func(addr sdk.AccAddress) *types.QueryRemainingCapacityResponse {
+		resp, err := s.queryClient.RemainingCapacity(ctx.Context(), &types.QueryRemainingCapacity{
+			Address: addr.String(),
+		})
+		require.NoError(err)
+		return resp
+	}
+
+	// a supplier provides 10 ATOM of liquidity
+	supplier := s.newAccount(coin.New(atomDenom, 10_000000))
+	s.supply(supplier, coin.New(atomDenom, 10_000000))
+
+	// creates account which has supplied and collateralized 1000 UMEE, then borrows 50 UMEE
+	addr := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(addr, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 1000_000000))
+	s.borrow(addr, coin.New(umeeDenom, 50_000000))
+
+	// the borrow limit of 1000 * 4.21 * 0.25 = 1052.5 minus 50 * 4.21 = 210.5 borrowed leaves 842,
+	// which is 200 UMEE, while ATOM is limited to 90% of its liquidity by MaxSupplyUtilization
+	resp := query(addr)
+	require.Equal(sdk.MustNewDecFromStr("842"), resp.RemainingValue)
+	require.Equal(sdk.MustNewDecFromStr("210.5"), resp.BorrowedValue)
+	require.Equal(sdk.MustNewDecFromStr("1052.5"), resp.BorrowLimit)
+	require.Equal([]types.BorrowableMarket{
+		{Denom: atomDenom, MaxBorrow: coin.New(atomDenom, 9_000000)},
+		{Denom: daiDenom, MaxBorrow: coin.Zero(daiDenom)},
+		{Denom: dumpDenom, MaxBorrow: coin.Zero(dumpDenom)},
+		{Denom: pumpDenom, MaxBorrow: coin.Zero(pumpDenom)},
+		{Denom: umeeDenom, MaxBorrow: coin.New(umeeDenom, 200_000000)},
+	}, resp.Markets)
+
+	// an account over its borrow limit has no remaining capacity
+	s.forceBorrow(addr, coin.New(umeeDenom, 250_000000))
+	resp = query(addr)
+	require.Equal(sdk.ZeroDec(), resp.RemainingValue)
+	require.Equal(sdk.MustNewDecFromStr("1263"), resp.BorrowedValue)
+	for _, m := range resp.Markets {
+		require.True(m.MaxBorrow.IsZero(), m.Denom)
+	}
+
+	// an account without positions has no capacity in any market
+	resp = query(s.newAccount())
+	require.Equal(sdk.ZeroDec(), resp.RemainingValue)
+	require.Len(resp.Markets, 5)
+
+	_, err := s.queryClient.RemainingCapacity(ctx.Context(), &types.QueryRemainingCapacity{})
+	require.ErrorContains(err, "empty address")
+}
+
 func (s *IntegrationTestSuite) TestQuerier_MaxWithdraw() {
 	ctx, require := s.ctx, s.Require()
 
@@ -296,3 +645,2020 @@ func (s *IntegrationTestSuite) TestQuerier_MaxBorrow() {
 	}
 	require.Equal(expected, *resp)
 }
+
+func (s *IntegrationTestSuite) TestQuerier_EffectiveReserveFactor() {
+	require := s.Require()
+
+	_, err := s.queryClient.EffectiveReserveFactor(context.Background(), &types.QueryEffectiveReserveFactor{})
+	require.ErrorContains(err, "empty denom")
+
+	_, err = s.queryClient.EffectiveReserveFactor(context.Background(),
+		&types.QueryEffectiveReserveFactor{Denom: "not_reg_token"})
+	require.ErrorContains(err, "not a registered Token")
+
+	resp, err := s.queryClient.EffectiveReserveFactor(context.Background(),
+		&types.QueryEffectiveReserveFactor{Denom: umeeDenom})
+	require.NoError(err)
+	require.Equal(types.QueryEffectiveReserveFactorResponse{
+		ReserveFactor: sdk.MustNewDecFromStr("0.2"),
+		Overridden:    false,
+		ExpiryHeight:  0,
+	}, *resp)
+}
+
+func (s *IntegrationTestSuite) TestQuerier_PendingIncentives() {
+	ctx, require := s.ctx, s.Require()
+
+	_, err := s.queryClient.PendingIncentives(ctx.Context(), &types.QueryPendingIncentives{})
+	require.ErrorContains(err, "empty address")
+
+	addr := s.newAccount(coin.New(umeeDenom, 1000))
+	s.supply(addr, coin.New(umeeDenom, 1000))
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 1000))
+
+	// no incentive module is wired to the test keeper
+	resp, err := s.queryClient.PendingIncentives(ctx.Context(), &types.QueryPendingIncentives{Address: addr.String()})
+	require.NoError(err)
+	require.Empty(resp.Incentives)
+	require.True(resp.Total.IsZero())
+}
+
+func (s *IntegrationTestSuite) TestQuerier_WithdrawImpact() {
+	ctx, require := s.ctx, s.Require()
+
+	addr := s.newAccount(coin.New(umeeDenom, 1000))
+	s.supply(addr, coin.New(umeeDenom, 1000))
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 400))
+
+	_, err := s.queryClient.WithdrawImpact(ctx.Context(), &types.QueryWithdrawImpact{
+		Address: addr.String(),
+		Asset:   coin.New(umeeDenom, 100),
+	})
+	require.ErrorContains(err, "denom should be a uToken")
+
+	// 600 u/uumee come from the wallet and 100 from collateral
+	resp, err := s.queryClient.WithdrawImpact(ctx.Context(), &types.QueryWithdrawImpact{
+		Address: addr.String(),
+		Asset:   coin.New("u/"+umeeDenom, 700),
+	})
+	require.NoError(err)
+	require.Equal(coin.New("u/"+umeeDenom, 100), resp.FromCollateral)
+	require.Equal(coin.Zero("u/"+umeeDenom), resp.Bonded)
+	require.False(resp.ForfeitsIncentives)
+	require.True(resp.PendingRewards.IsZero())
+}
+
+func (s *IntegrationTestSuite) TestQuerier_RepayToFreeCollateral() {
+	ctx, require := s.ctx, s.Require()
+
+	// creates account which has supplied and collateralized 1000 UMEE
+	addr := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(addr, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 1000_000000))
+
+	resp, err := s.queryClient.RepayToFreeCollateral(ctx.Context(), &types.QueryRepayToFreeCollateral{
+		Address: addr.String(),
+		Denom:   umeeDenom,
+	})
+	require.NoError(err)
+	require.Equal(sdk.ZeroDec(), resp.RepayValue)
+	require.True(resp.RepayOptions.IsZero())
+
+	// borrow 100 UMEE, which must be fully repaid to free the only collateral
+	s.borrow(addr, coin.New(umeeDenom, 100_000000))
+
+	resp, err = s.queryClient.RepayToFreeCollateral(ctx.Context(), &types.QueryRepayToFreeCollateral{
+		Address: addr.String(),
+		Denom:   "u/" + umeeDenom,
+	})
+	require.NoError(err)
+	require.Equal(sdk.MustNewDecFromStr("421"), resp.RepayValue)
+	require.Equal(sdk.NewCoins(coin.New(umeeDenom, 100_000000)), resp.RepayOptions)
+}
+
+func (s *IntegrationTestSuite) TestQuerier_ListOrdering() {
+	ctx, require := s.ctx, s.Require()
+
+	// registered tokens are sorted by base denom, and stable across calls
+	tokens, err := s.queryClient.RegisteredTokens(ctx.Context(), &types.QueryRegisteredTokens{})
+	require.NoError(err)
+	require.True(sort.SliceIsSorted(tokens.Registry, func(i, j int) bool {
+		return tokens.Registry[i].BaseDenom < tokens.Registry[j].BaseDenom
+	}))
+	tokens2, err := s.queryClient.RegisteredTokens(ctx.Context(), &types.QueryRegisteredTokens{})
+	require.NoError(err)
+	require.Equal(tokens, tokens2)
+
+	// bad debts are ordered by address bytes, then denom, regardless of insertion order
+	addr1 := s.newAccount()
+	addr2 := s.newAccount()
+	require.NoError(s.tk.SetBadDebtAddress(s.ctx, addr2, atomDenom, true))
+	require.NoError(s.tk.SetBadDebtAddress(s.ctx, addr2, umeeDenom, true))
+	require.NoError(s.tk.SetBadDebtAddress(s.ctx, addr1, umeeDenom, true))
+
+	first, second := addr1, addr2
+	if bytes.Compare(addr2, addr1) < 0 {
+		first, second = addr2, addr1
+	}
+	expected := map[string][]types.BadDebt{
+		addr1.String(): {types.NewBadDebt(addr1.String(), umeeDenom)},
+		addr2.String(): {
+			types.NewBadDebt(addr2.String(), atomDenom),
+			types.NewBadDebt(addr2.String(), umeeDenom),
+		},
+	}
+	badDebts, err := s.queryClient.BadDebts(ctx.Context(), &types.QueryBadDebts{})
+	require.NoError(err)
+	require.Equal(append(expected[first.String()], expected[second.String()]...), badDebts.Targets)
+
+	badDebts2, err := s.queryClient.BadDebts(ctx.Context(), &types.QueryBadDebts{})
+	require.NoError(err)
+	require.Equal(badDebts, badDebts2)
+}
+
+func (s *IntegrationTestSuite) TestQuerier_RegistryHash() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+
+	resp, err := s.queryClient.RegistryHash(ctx.Context(), &types.QueryRegistryHash{})
+	require.NoError(err)
+	require.Len(resp.Hash, 64)
+
+	// unchanged registry returns the same hash
+	resp2, err := s.queryClient.RegistryHash(ctx.Context(), &types.QueryRegistryHash{})
+	require.NoError(err)
+	require.Equal(resp, resp2)
+
+	// modifying a token changes the hash and records the update height
+	umeeToken, err := app.LeverageKeeper.GetTokenSettings(ctx, umeeDenom)
+	require.NoError(err)
+	umeeToken.ReserveFactor = sdk.MustNewDecFromStr("0.3")
+	updateCtx := ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	require.NoError(app.LeverageKeeper.SetTokenSettings(updateCtx, umeeToken))
+
+	resp3, err := s.queryClient.RegistryHash(ctx.Context(), &types.QueryRegistryHash{})
+	require.NoError(err)
+	require.NotEqual(resp.Hash, resp3.Hash)
+	require.Equal(updateCtx.BlockHeight(), resp3.LastUpdateHeight)
+}
+
+func (s *IntegrationTestSuite) TestQuerier_MarketSummaries() {
+	require := s.Require()
+
+	resp, err := s.queryClient.MarketSummaries(context.Background(), &types.QueryMarketSummaries{})
+	require.NoError(err)
+	require.Len(resp.Summaries, 5)
+
+	resp, err = s.queryClient.MarketSummaries(context.Background(), &types.QueryMarketSummaries{
+		Denoms: []string{umeeDenom, atomDenom},
+	})
+	require.NoError(err)
+	require.Len(resp.Summaries, 2)
+	require.Equal(atomDenom, resp.Summaries[0].Denom)
+	require.Equal(umeeDenom, resp.Summaries[1].Denom)
+
+	single, err := s.queryClient.MarketSummary(context.Background(), &types.QueryMarketSummary{Denom: umeeDenom})
+	require.NoError(err)
+	require.Equal(*single, resp.Summaries[1].Summary)
+
+	_, err = s.queryClient.MarketSummaries(context.Background(), &types.QueryMarketSummaries{
+		Denoms: []string{umeeDenom, "not_reg_token"},
+	})
+	require.ErrorContains(err, "not a registered Token")
+}
+
+func (s *IntegrationTestSuite) TestQuerier_AccountEquity() {
+	ctx, require := s.ctx, s.Require()
+
+	// account with no positions has zero equity
+	empty := s.newAccount()
+	resp, err := s.queryClient.AccountEquity(ctx.Context(), &types.QueryAccountEquity{Address: empty.String()})
+	require.NoError(err)
+	require.Equal(sdk.ZeroDec(), resp.Equity)
+	require.Equal(types.InfiniteLeverageRatio, resp.LeverageRatio)
+
+	// creates account which has supplied and collateralized 1000 UMEE, and borrowed 100 UMEE
+	addr := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(addr, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 1000_000000))
+	s.borrow(addr, coin.New(umeeDenom, 100_000000))
+
+	resp, err = s.queryClient.AccountEquity(ctx.Context(), &types.QueryAccountEquity{Address: addr.String()})
+	require.NoError(err)
+
+	expected := types.QueryAccountEquityResponse{
+		// (1000 - 100) * 4.21 = 3789
+		Equity:          sdk.MustNewDecFromStr("3789"),
+		CollateralValue: sdk.MustNewDecFromStr("4210"),
+		LeverageRatio:   sdk.MustNewDecFromStr("4210").Quo(sdk.MustNewDecFromStr("3789")),
+	}
+	require.Equal(expected, *resp)
+}
+
+func (s *IntegrationTestSuite) TestQuerier_AccrualInfo() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+
+	// accrue interest at a later height and time
+	accrualCtx := ctx.WithBlockHeight(ctx.BlockHeight() + 10).WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	require.NoError(app.LeverageKeeper.AccrueAllInterest(accrualCtx))
+
+	// blacklist a token, which stops it from accruing interest
+	atomToken, err := app.LeverageKeeper.GetTokenSettings(ctx, atomDenom)
+	require.NoError(err)
+	atomToken.Blacklist = true
+	atomToken.EnableMsgBorrow = false
+	atomToken.EnableMsgSupply = false
+	require.NoError(app.LeverageKeeper.SetTokenSettings(ctx, atomToken))
+
+	resp, err := s.queryClient.AccrualInfo(ctx.Context(), &types.QueryAccrualInfo{})
+	require.NoError(err)
+	require.Len(resp.Accruals, 5)
+
+	for _, info := range resp.Accruals {
+		require.Equal(accrualCtx.BlockHeight(), info.LastAccrualHeight, info.Denom)
+		require.Equal(accrualCtx.BlockTime().Unix(), info.LastAccrualTime, info.Denom)
+		if info.Denom == atomDenom {
+			require.Zero(info.NextAccrualHeight)
+		} else {
+			require.Equal(ctx.BlockHeight()+1, info.NextAccrualHeight, info.Denom)
+		}
+	}
+}
+
+func (s *IntegrationTestSuite) TestQuerier_BadDebtHistory() {
+	app, ctx, srv, require := s.app, s.ctx, s.msgSrvr, s.Require()
+
+	// keep at most two bad debt records
+	params := app.LeverageKeeper.GetParams(ctx)
+	params.BadDebtHistorySize = 2
+	app.LeverageKeeper.SetParams(ctx, params)
+
+	supplier := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(supplier, coin.New(umeeDenom, 1000_000000))
+	liquidator := s.newAccount(coin.New(umeeDenom, 1000_000000))
+
+	// three borrowers collateralize 110 UMEE and borrow 200 UMEE, then are liquidated at successive
+	// heights, each leaving 100 UMEE of bad debt
+	h := ctx.BlockHeight()
+	borrowers := []sdk.AccAddress{}
+	for i := int64(0); i < 3; i++ {
+		borrower := s.newAccount(coin.New(umeeDenom, 110_000000))
+		s.supply(borrower, coin.New(umeeDenom, 110_000000))
+		s.collateralize(borrower, coin.New("u/"+umeeDenom, 110_000000))
+		s.forceBorrow(borrower, coin.New(umeeDenom, 200_000000))
+		_, err := srv.Liquidate(ctx.WithBlockHeight(h+i), &types.MsgLiquidate{
+			Liquidator:  liquidator.String(),
+			Borrower:    borrower.String(),
+			Repayment:   coin.New(umeeDenom, 200_000000),
+			RewardDenom: "u/" + umeeDenom,
+		})
+		require.NoError(err)
+		borrowers = append(borrowers, borrower)
+	}
+
+	record := func(i int) types.BadDebtRecord {
+		return types.BadDebtRecord{
+			Height:  h + int64(i),
+			Address: borrowers[i].String(),
+			Denom:   umeeDenom,
+			Amount:  sdk.NewInt(100_000000),
+		}
+	}
+
+	// the oldest record was evicted
+	resp, err := s.queryClient.BadDebtHistory(ctx.Context(), &types.QueryBadDebtHistory{})
+	require.NoError(err)
+	require.Equal([]types.BadDebtRecord{record(1), record(2)}, resp.Records)
+
+	// records are paginated, oldest first
+	resp, err = s.queryClient.BadDebtHistory(ctx.Context(), &types.QueryBadDebtHistory{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Equal([]types.BadDebtRecord{record(1)}, resp.Records)
+	require.Equal(uint64(2), resp.Pagination.Total)
+	resp, err = s.queryClient.BadDebtHistory(ctx.Context(), &types.QueryBadDebtHistory{
+		Pagination: &query.PageRequest{Key: resp.Pagination.NextKey},
+	})
+	require.NoError(err)
+	require.Equal([]types.BadDebtRecord{record(2)}, resp.Records)
+
+	// a zero history size records nothing, and removes existing records the next time bad debt is marked
+	params.BadDebtHistorySize = 0
+	app.LeverageKeeper.SetParams(ctx, params)
+	borrower := s.newAccount(coin.New(umeeDenom, 110_000000))
+	s.supply(borrower, coin.New(umeeDenom, 110_000000))
+	s.collateralize(borrower, coin.New("u/"+umeeDenom, 110_000000))
+	s.forceBorrow(borrower, coin.New(umeeDenom, 200_000000))
+	_, err = srv.Liquidate(ctx, &types.MsgLiquidate{
+		Liquidator:  liquidator.String(),
+		Borrower:    borrower.String(),
+		Repayment:   coin.New(umeeDenom, 200_000000),
+		RewardDenom: "u/" + umeeDenom,
+	})
+	require.NoError(err)
+	resp, err = s.queryClient.BadDebtHistory(ctx.Context(), &types.QueryBadDebtHistory{})
+	require.NoError(err)
+	require.Empty(resp.Records)
+}
+
+func (s *IntegrationTestSuite) TestQuerier_AccrualStaleness() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+
+	// accrue interest, then query 7 blocks and 42 seconds later
+	require.NoError(app.LeverageKeeper.AccrueAllInterest(ctx))
+	queryCtx := ctx.WithBlockHeight(ctx.BlockHeight() + 7).WithBlockTime(ctx.BlockTime().Add(42 * time.Second))
+
+	// the query client is bound to the suite context, so the later height is queried directly
+	resp, err := keeper.NewQuerier(app.LeverageKeeper).AccrualStaleness(
+		sdk.WrapSDKContext(queryCtx), &types.QueryAccrualStaleness{Denom: atomDenom})
+	require.NoError(err)
+	require.Equal(types.QueryAccrualStalenessResponse{
+		LastAccrualHeight: ctx.BlockHeight(),
+		LastAccrualTime:   ctx.BlockTime().Unix(),
+		BlocksElapsed:     7,
+		TimeElapsed:       42 * time.Second,
+	}, *resp)
+
+	// immediately after accrual, nothing is stale
+	resp, err = s.queryClient.AccrualStaleness(ctx.Context(), &types.QueryAccrualStaleness{Denom: umeeDenom})
+	require.NoError(err)
+	require.Zero(resp.BlocksElapsed)
+	require.Zero(resp.TimeElapsed)
+
+	_, err = s.queryClient.AccrualStaleness(ctx.Context(), &types.QueryAccrualStaleness{Denom: "abcd"})
+	require.ErrorIs(err, types.ErrNotRegisteredToken)
+	_, err = s.queryClient.AccrualStaleness(ctx.Context(), &types.QueryAccrualStaleness{})
+	require.Error(err)
+}
+
+func (s *IntegrationTestSuite) TestQuerier_AccountMarkets() {
+	require := s.Require()
+
+	// create an account which supplies UMEE, collateralizes ATOM, and borrows UMEE
+	addr := s.newAccount(coin.New(umeeDenom, 100_000000), coin.New(atomDenom, 100_000000))
+	s.supply(addr, coin.New(umeeDenom, 100_000000), coin.New(atomDenom, 100_000000))
+	s.collateralize(addr, coin.New("u/"+atomDenom, 100_000000))
+	s.borrow(addr, coin.New(umeeDenom, 1_000000))
+
+	resp, err := s.queryClient.AccountMarkets(context.Background(), &types.QueryAccountMarkets{Address: addr.String()})
+	require.NoError(err)
+	require.Equal([]types.AccountMarket{
+		{Denom: atomDenom, Supplied: true, Collateral: true},
+		{Denom: umeeDenom, Supplied: true, Borrowed: true},
+	}, resp.Markets)
+
+	// the UMEE market ($421 supplied + $4.21 borrowed) is omitted below a $500 minimum value,
+	// while the ATOM market ($3938 supplied) is kept
+	minValue := sdk.MustNewDecFromStr("500")
+	req := &types.QueryAccountMarkets{Address: addr.String(), MinValue: &minValue}
+	resp, err = s.queryClient.AccountMarkets(context.Background(), req)
+	require.NoError(err)
+	require.Equal([]types.AccountMarket{{Denom: atomDenom, Supplied: true, Collateral: true}}, resp.Markets)
+	require.Equal(uint64(1), resp.Omitted)
+
+	// markets missing prices are never omitted
+	s.mockOracle.Clear("UMEE")
+	resp, err = s.queryClient.AccountMarkets(context.Background(), req)
+	require.NoError(err)
+	require.Len(resp.Markets, 2)
+	require.Equal(uint64(0), resp.Omitted)
+	s.mockOracle.Reset()
+
+	// an account with no positions has no markets
+	empty := s.newAccount()
+	resp, err = s.queryClient.AccountMarkets(context.Background(), &types.QueryAccountMarkets{Address: empty.String()})
+	require.NoError(err)
+	require.Empty(resp.Markets)
+
+	_, err = s.queryClient.AccountMarkets(context.Background(), &types.QueryAccountMarkets{})
+	require.ErrorContains(err, "empty address")
+}
+
+func (s *IntegrationTestSuite) TestQuerier_DebtByCollateral() {
+	require := s.Require()
+
+	// create a supplier to provide liquidity
+	supplier := s.newAccount(coin.New(umeeDenom, 1000_000000), coin.New(atomDenom, 1000_000000))
+	s.supply(supplier, coin.New(umeeDenom, 1000_000000), coin.New(atomDenom, 1000_000000))
+
+	// borrower with UMEE collateral borrows 1 ATOM ($39.38)
+	umeeBorrower := s.newAccount(coin.New(umeeDenom, 100_000000))
+	s.supply(umeeBorrower, coin.New(umeeDenom, 100_000000))
+	s.collateralize(umeeBorrower, coin.New("u/"+umeeDenom, 100_000000))
+	s.forceBorrow(umeeBorrower, coin.New(atomDenom, 1_000000))
+
+	// borrower with mostly ATOM collateral borrows 10 UMEE ($42.10) and 1 ATOM ($39.38)
+	atomBorrower := s.newAccount(coin.New(umeeDenom, 10_000000), coin.New(atomDenom, 10_000000))
+	s.supply(atomBorrower, coin.New(umeeDenom, 10_000000), coin.New(atomDenom, 10_000000))
+	s.collateralize(atomBorrower, coin.New("u/"+umeeDenom, 10_000000), coin.New("u/"+atomDenom, 10_000000))
+	s.forceBorrow(atomBorrower, coin.New(umeeDenom, 10_000000), coin.New(atomDenom, 1_000000))
+
+	// borrower without collateral borrows 1 UMEE ($4.21)
+	noCollateralBorrower := s.newAccount()
+	s.forceBorrow(noCollateralBorrower, coin.New(umeeDenom, 1_000000))
+
+	resp, err := s.queryClient.DebtByCollateral(context.Background(), &types.QueryDebtByCollateral{})
+	require.NoError(err)
+	require.Equal([]types.CollateralDebt{
+		{Denom: "u/" + atomDenom, BorrowedValue: sdk.MustNewDecFromStr("81.48"), Borrowers: 1},
+		{Denom: "u/" + umeeDenom, BorrowedValue: sdk.MustNewDecFromStr("39.38"), Borrowers: 1},
+	}, resp.Debts)
+	require.Equal(sdk.MustNewDecFromStr("4.21"), resp.UnattributedValue)
+}
+
+func (s *IntegrationTestSuite) TestQuerier_AggregateBorrowUtilization() {
+	require := s.Require()
+
+	// no accounts have collateral or borrows
+	resp, err := s.queryClient.AggregateBorrowUtilization(context.Background(),
+		&types.QueryAggregateBorrowUtilization{})
+	require.NoError(err)
+	require.Equal(types.QueryAggregateBorrowUtilizationResponse{
+		Utilization:   sdk.ZeroDec(),
+		BorrowedValue: sdk.ZeroDec(),
+		BorrowLimit:   sdk.ZeroDec(),
+	}, *resp)
+
+	// create a supplier to provide liquidity, which has no collateral and is not counted
+	supplier := s.newAccount(coin.New(umeeDenom, 1000_000000), coin.New(atomDenom, 1000_000000))
+	s.supply(supplier, coin.New(umeeDenom, 1000_000000), coin.New(atomDenom, 1000_000000))
+
+	// borrower with 100 UMEE collateral ($105.25 borrow limit) borrows 1 ATOM ($39.38)
+	umeeBorrower := s.newAccount(coin.New(umeeDenom, 100_000000))
+	s.supply(umeeBorrower, coin.New(umeeDenom, 100_000000))
+	s.collateralize(umeeBorrower, coin.New("u/"+umeeDenom, 100_000000))
+	s.borrow(umeeBorrower, coin.New(atomDenom, 1_000000))
+
+	// borrower with 10 UMEE and 10 ATOM collateral ($108.975 borrow limit) borrows 10 UMEE and 1 ATOM ($81.48)
+	atomBorrower := s.newAccount(coin.New(umeeDenom, 10_000000), coin.New(atomDenom, 10_000000))
+	s.supply(atomBorrower, coin.New(umeeDenom, 10_000000), coin.New(atomDenom, 10_000000))
+	s.collateralize(atomBorrower, coin.New("u/"+umeeDenom, 10_000000), coin.New("u/"+atomDenom, 10_000000))
+	s.borrow(atomBorrower, coin.New(umeeDenom, 10_000000), coin.New(atomDenom, 1_000000))
+
+	// borrower without collateral borrows 1 UMEE ($4.21)
+	noCollateralBorrower := s.newAccount()
+	s.forceBorrow(noCollateralBorrower, coin.New(umeeDenom, 1_000000))
+
+	resp, err = s.queryClient.AggregateBorrowUtilization(context.Background(),
+		&types.QueryAggregateBorrowUtilization{})
+	require.NoError(err)
+	borrowed := sdk.MustNewDecFromStr("125.07")
+	limit := sdk.MustNewDecFromStr("214.225")
+	require.Equal(types.QueryAggregateBorrowUtilizationResponse{
+		Utilization:   borrowed.Quo(limit),
+		BorrowedValue: borrowed,
+		BorrowLimit:   limit,
+		Accounts:      3,
+	}, *resp)
+}
+
+func (s *IntegrationTestSuite) TestQuerier_ProtocolCollateralComposition() {
+	require := s.Require()
+
+	// one account collateralizes 100 UMEE ($421), the other 10 UMEE ($42.10) and 10 ATOM ($393.80)
+	addr1 := s.newAccount(coin.New(umeeDenom, 100_000000))
+	s.supply(addr1, coin.New(umeeDenom, 100_000000))
+	s.collateralize(addr1, coin.New("u/"+umeeDenom, 100_000000))
+	addr2 := s.newAccount(coin.New(umeeDenom, 10_000000), coin.New(atomDenom, 10_000000))
+	s.supply(addr2, coin.New(umeeDenom, 10_000000), coin.New(atomDenom, 10_000000))
+	s.collateralize(addr2, coin.New("u/"+umeeDenom, 10_000000), coin.New("u/"+atomDenom, 10_000000))
+
+	resp, err := s.queryClient.ProtocolCollateralComposition(context.Background(),
+		&types.QueryProtocolCollateralComposition{})
+	require.NoError(err)
+
+	total := sdk.MustNewDecFromStr("856.9")
+	require.Equal(total, resp.TotalValue)
+	require.Equal([]types.CollateralShare{
+		{
+			Collateral: coin.New("u/"+atomDenom, 10_000000),
+			Value:      sdk.MustNewDecFromStr("393.8"),
+			Share:      sdk.MustNewDecFromStr("393.8").Quo(total),
+		},
+		{
+			Collateral: coin.New("u/"+umeeDenom, 110_000000),
+			Value:      sdk.MustNewDecFromStr("463.1"),
+			Share:      sdk.MustNewDecFromStr("463.1").Quo(total),
+		},
+	}, resp.Composition)
+}
+
+func (s *IntegrationTestSuite) TestQuerier_BorrowUtilization() {
+	require := s.Require()
+
+	query := func(addr sdk.AccAddress) *types.QueryBorrowUtilizationResponse {
+		resp, err := s.queryClient.BorrowUtilization(context.Background(),
+			&types.QueryBorrowUtilization{Address: addr.String()})
+		require.NoError(err)
+		return resp
+	}
+
+	// account with no collateral has zero utilization
+	empty := s.newAccount()
+	resp := query(empty)
+	require.Equal(sdk.ZeroDec(), resp.Utilization)
+	require.Equal(sdk.ZeroDec(), resp.BorrowLimit)
+	require.False(resp.OverLimit)
+
+	// account which collateralizes 1000 UMEE and borrows 10 UMEE
+	addr := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(addr, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 1000_000000))
+	s.borrow(addr, coin.New(umeeDenom, 10_000000))
+
+	resp = query(addr)
+	require.True(resp.BorrowLimit.IsPositive())
+	require.Equal(resp.BorrowedValue.Quo(resp.BorrowLimit), resp.Utilization)
+	require.True(resp.Utilization.LT(sdk.OneDec()))
+	require.False(resp.OverLimit)
+
+	// artificially borrow far past the borrow limit, which caps utilization
+	s.forceBorrow(addr, coin.New(umeeDenom, 900_000000))
+	resp = query(addr)
+	require.True(resp.BorrowedValue.GT(resp.BorrowLimit))
+	require.Equal(types.MaxBorrowUtilization, resp.Utilization)
+	require.True(resp.OverLimit)
+
+	_, err := s.queryClient.BorrowUtilization(context.Background(), &types.QueryBorrowUtilization{})
+	require.ErrorContains(err, "empty address")
+}
+
+func (s *IntegrationTestSuite) TestQuerier_FullLiquidationPlan() {
+	ctx, srv, require := s.ctx, s.msgSrvr, s.Require()
+
+	// create a supplier to provide liquidity
+	supplier := s.newAccount(coin.New(umeeDenom, 1000_000000), coin.New(atomDenom, 1000_000000))
+	s.supply(supplier, coin.New(umeeDenom, 1000_000000), coin.New(atomDenom, 1000_000000))
+
+	// healthy borrowers are not eligible for liquidation
+	healthy := s.newAccount(coin.New(umeeDenom, 100_000000))
+	s.supply(healthy, coin.New(umeeDenom, 100_000000))
+	s.collateralize(healthy, coin.New("u/"+umeeDenom, 100_000000))
+	_, err := s.queryClient.FullLiquidationPlan(context.Background(),
+		&types.QueryFullLiquidationPlan{Address: healthy.String()})
+	require.ErrorIs(err, types.ErrLiquidationIneligible)
+
+	// create a borrower with multiple collateral and borrowed denoms
+	borrower := s.newAccount(coin.New(umeeDenom, 100_000000), coin.New(atomDenom, 100_000000))
+	s.supply(borrower, coin.New(umeeDenom, 100_000000), coin.New(atomDenom, 100_000000))
+	s.collateralize(borrower, coin.New("u/"+umeeDenom, 100_000000), coin.New("u/"+atomDenom, 100_000000))
+	s.forceBorrow(borrower, coin.New(atomDenom, 30_000000), coin.New(umeeDenom, 30_000000))
+
+	resp, err := s.queryClient.FullLiquidationPlan(context.Background(),
+		&types.QueryFullLiquidationPlan{Address: borrower.String()})
+	require.NoError(err)
+	require.NotEmpty(resp.Steps)
+	require.True(resp.CloseFactor.IsPositive())
+
+	// the first step uses the collateral with the highest liquidation incentive
+	atomToken, err := s.app.LeverageKeeper.GetTokenSettings(ctx, atomDenom)
+	require.NoError(err)
+	umeeToken, err := s.app.LeverageKeeper.GetTokenSettings(ctx, umeeDenom)
+	require.NoError(err)
+	best := "u/" + atomDenom
+	if umeeToken.LiquidationIncentive.GT(atomToken.LiquidationIncentive) {
+		best = "u/" + umeeDenom
+	}
+	require.Equal(best, resp.Steps[0].RewardDenom)
+
+	// the first step matches an actual liquidation
+	first := resp.Steps[0]
+	liquidator := s.newAccount(first.Repay)
+	liqResp, err := srv.Liquidate(ctx, types.NewMsgLiquidate(liquidator, borrower, first.Repay, first.RewardDenom))
+	require.NoError(err)
+	require.Equal(first.Repay, liqResp.Repaid)
+	require.Equal(first.Reward, liqResp.Reward)
+}
+
+func (s *IntegrationTestSuite) TestQuerier_BestLiquidation() {
+	app, ctx, srv, require := s.app, s.ctx, s.msgSrvr, s.Require()
+
+	// create a supplier to provide liquidity
+	supplier := s.newAccount(coin.New(umeeDenom, 1000_000000), coin.New(atomDenom, 1000_000000))
+	s.supply(supplier, coin.New(umeeDenom, 1000_000000), coin.New(atomDenom, 1000_000000))
+
+	// healthy borrowers are not eligible for liquidation
+	healthy := s.newAccount(coin.New(umeeDenom, 100_000000))
+	s.supply(healthy, coin.New(umeeDenom, 100_000000))
+	s.collateralize(healthy, coin.New("u/"+umeeDenom, 100_000000))
+	_, err := s.queryClient.BestLiquidation(context.Background(),
+		&types.QueryBestLiquidation{Address: healthy.String()})
+	require.ErrorIs(err, types.ErrLiquidationIneligible)
+
+	// create a borrower with multiple collateral and borrowed denoms
+	borrower := s.newAccount(coin.New(umeeDenom, 100_000000), coin.New(atomDenom, 100_000000))
+	s.supply(borrower, coin.New(umeeDenom, 100_000000), coin.New(atomDenom, 100_000000))
+	s.collateralize(borrower, coin.New("u/"+umeeDenom, 100_000000), coin.New("u/"+atomDenom, 100_000000))
+	s.forceBorrow(borrower, coin.New(atomDenom, 30_000000), coin.New(umeeDenom, 30_000000))
+
+	resp, err := s.queryClient.BestLiquidation(context.Background(),
+		&types.QueryBestLiquidation{Address: borrower.String()})
+	require.NoError(err)
+	require.Equal(resp.RewardDenom, resp.Reward.Denom)
+	require.True(resp.Profit.IsPositive())
+
+	// no other combination of denoms yields a higher profit
+	for _, repayDenom := range []string{umeeDenom, atomDenom} {
+		for _, rewardDenom := range []string{"u/" + umeeDenom, "u/" + atomDenom} {
+			cacheCtx, _ := ctx.CacheContext()
+			liquidator := s.newAccount(coin.New(repayDenom, 1000_000000))
+			liqResp, err := srv.Liquidate(cacheCtx, types.NewMsgLiquidate(
+				liquidator, borrower, coin.New(repayDenom, 1000_000000), rewardDenom,
+			))
+			require.NoError(err)
+			baseReward, err := app.LeverageKeeper.ExchangeUToken(cacheCtx, liqResp.Reward)
+			require.NoError(err)
+			rewardValue, err := app.LeverageKeeper.TokenValue(cacheCtx, baseReward, types.PriceModeSpot)
+			require.NoError(err)
+			repaidValue, err := app.LeverageKeeper.TokenValue(cacheCtx, liqResp.Repaid, types.PriceModeSpot)
+			require.NoError(err)
+			require.True(rewardValue.Sub(repaidValue).LTE(resp.Profit), repayDenom+" "+rewardDenom)
+		}
+	}
+
+	// the recommendation matches an actual liquidation
+	liquidator := s.newAccount(resp.Repay)
+	liqResp, err := srv.Liquidate(ctx, types.NewMsgLiquidate(liquidator, borrower, resp.Repay, resp.RewardDenom))
+	require.NoError(err)
+	require.Equal(resp.Repay, liqResp.Repaid)
+	require.Equal(resp.Reward, liqResp.Reward)
+
+	_, err = s.queryClient.BestLiquidation(context.Background(), &types.QueryBestLiquidation{})
+	require.ErrorContains(err, "empty address")
+}
+
+func (s *IntegrationTestSuite) TestQuerier_AccountBalances_Net() {
+	require := s.Require()
+
+	// create a supplier to provide ATOM liquidity
+	supplier := s.newAccount(coin.New(atomDenom, 100_000000))
+	s.supply(supplier, coin.New(atomDenom, 100_000000))
+
+	// account supplies 1000 UMEE and 1 ATOM, then borrows 10 UMEE and 2 ATOM
+	addr := s.newAccount(coin.New(umeeDenom, 1000_000000), coin.New(atomDenom, 1_000000))
+	s.supply(addr, coin.New(umeeDenom, 1000_000000), coin.New(atomDenom, 1_000000))
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 1000_000000))
+	s.borrow(addr, coin.New(umeeDenom, 10_000000), coin.New(atomDenom, 2_000000))
+
+	// net positions are omitted unless requested
+	resp, err := s.queryClient.AccountBalances(context.Background(), &types.QueryAccountBalances{
+		Address: addr.String(),
+	})
+	require.NoError(err)
+	require.Nil(resp.Net)
+
+	resp, err = s.queryClient.AccountBalances(context.Background(), &types.QueryAccountBalances{
+		Address: addr.String(),
+		Net:     true,
+	})
+	require.NoError(err)
+	require.Equal([]types.NetPosition{
+		// (1 - 2) * 39.38
+		{Denom: atomDenom, NetValue: sdk.MustNewDecFromStr("-39.38"), NetPositive: false},
+		// (1000 - 10) * 4.21
+		{Denom: umeeDenom, NetValue: sdk.MustNewDecFromStr("4167.9"), NetPositive: true},
+	}, resp.Net)
+}
+
+func (s *IntegrationTestSuite) TestQuerier_AccountBalances_DenomPrefix() {
+	require := s.Require()
+
+	// account supplies 1000 UMEE, and 1 ATOM and 2 DAI which are both IBC tokens
+	addr := s.newAccount(coin.New(umeeDenom, 1000_000000), coin.New(atomDenom, 1_000000), coin.New(daiDenom, 2_000000))
+	s.supply(addr, coin.New(umeeDenom, 1000_000000), coin.New(atomDenom, 1_000000), coin.New(daiDenom, 2_000000))
+
+	// the prefix total is zero unless requested
+	resp, err := s.queryClient.AccountBalances(context.Background(), &types.QueryAccountBalances{
+		Address: addr.String(),
+	})
+	require.NoError(err)
+	require.Equal(sdk.ZeroInt(), resp.PrefixSupplied)
+
+	resp, err = s.queryClient.AccountBalances(context.Background(), &types.QueryAccountBalances{
+		Address:     addr.String(),
+		DenomPrefix: "ibc/",
+	})
+	require.NoError(err)
+	require.Equal(sdk.NewInt(3_000000), resp.PrefixSupplied)
+	// supplied balances are unaffected by the prefix
+	require.Equal(sdk.NewCoins(
+		coin.New(umeeDenom, 1000_000000), coin.New(atomDenom, 1_000000), coin.New(daiDenom, 2_000000),
+	), resp.Supplied)
+
+	// a prefix matching no denoms sums to zero
+	resp, err = s.queryClient.AccountBalances(context.Background(), &types.QueryAccountBalances{
+		Address:     addr.String(),
+		DenomPrefix: "gravity",
+	})
+	require.NoError(err)
+	require.Equal(sdk.ZeroInt(), resp.PrefixSupplied)
+}
+
+func (s *IntegrationTestSuite) TestQuerier_InterestIndex() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+
+	// the interest scalar starts at one
+	resp, err := s.queryClient.InterestIndex(ctx.Context(), &types.QueryInterestIndex{Denom: umeeDenom})
+	require.NoError(err)
+	require.Equal(sdk.OneDec(), resp.InterestScalar)
+
+	// create a borrower, then accrue interest once to set the starting time
+	// and again 30 days later at a later height
+	addr := s.newAccount(coin.New(umeeDenom, 100_000000))
+	s.supply(addr, coin.New(umeeDenom, 100_000000))
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 100_000000))
+	s.borrow(addr, coin.New(umeeDenom, 10_000000))
+	startCtx := ctx.WithBlockTime(time.Unix(1_000_000, 0))
+	require.NoError(app.LeverageKeeper.AccrueAllInterest(startCtx))
+	accrualCtx := startCtx.WithBlockHeight(ctx.BlockHeight() + 10).WithBlockTime(startCtx.BlockTime().Add(30 * 24 * time.Hour))
+	require.NoError(app.LeverageKeeper.AccrueAllInterest(accrualCtx))
+
+	resp, err = s.queryClient.InterestIndex(ctx.Context(), &types.QueryInterestIndex{Denom: umeeDenom})
+	require.NoError(err)
+	require.True(resp.InterestScalar.GT(sdk.OneDec()), resp.InterestScalar)
+	require.Equal(accrualCtx.BlockHeight(), resp.LastUpdateHeight)
+
+	// the interest scalar converts the originally borrowed amount to the current one
+	borrowed := app.LeverageKeeper.GetBorrow(ctx, addr, umeeDenom)
+	require.Equal(resp.InterestScalar.MulInt64(10_000000).Ceil().TruncateInt(), borrowed.Amount)
+
+	// unregistered denoms are rejected
+	_, err = s.queryClient.InterestIndex(ctx.Context(), &types.QueryInterestIndex{Denom: "abcd"})
+	require.ErrorIs(err, types.ErrNotRegisteredToken)
+}
+
+func (s *IntegrationTestSuite) TestQuerier_BorrowLimitBreakdown() {
+	require := s.Require()
+
+	// create an account which supplies and collateralizes 100 UMEE and 10 ATOM
+	addr := s.newAccount(coin.New(umeeDenom, 100_000000), coin.New(atomDenom, 10_000000))
+	s.supply(addr, coin.New(umeeDenom, 100_000000), coin.New(atomDenom, 10_000000))
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 100_000000), coin.New("u/"+atomDenom, 10_000000))
+
+	resp, err := s.queryClient.BorrowLimitBreakdown(context.Background(),
+		&types.QueryBorrowLimitBreakdown{Address: addr.String()})
+	require.NoError(err)
+
+	// collateral weights are always 0.25 in testing
+	require.Equal([]types.BorrowLimitContribution{
+		{
+			Denom:            "u/" + atomDenom,
+			CollateralValue:  sdk.MustNewDecFromStr("393.8"),
+			CollateralWeight: sdk.MustNewDecFromStr("0.25"),
+			BorrowLimit:      sdk.MustNewDecFromStr("98.45"),
+		},
+		{
+			Denom:            "u/" + umeeDenom,
+			CollateralValue:  sdk.MustNewDecFromStr("421"),
+			CollateralWeight: sdk.MustNewDecFromStr("0.25"),
+			BorrowLimit:      sdk.MustNewDecFromStr("105.25"),
+		},
+	}, resp.Contributions)
+	require.Equal(sdk.MustNewDecFromStr("203.7"), resp.BorrowLimit)
+
+	// the total matches the borrow limit in the account summary
+	summary, err := s.queryClient.AccountSummary(context.Background(),
+		&types.QueryAccountSummary{Address: addr.String()})
+	require.NoError(err)
+	require.Equal(summary.BorrowLimit, resp.BorrowLimit)
+
+	_, err = s.queryClient.BorrowLimitBreakdown(context.Background(), &types.QueryBorrowLimitBreakdown{})
+	require.ErrorContains(err, "empty address")
+}
+
+func (s *IntegrationTestSuite) TestQuerier_LiquidationThresholdBreakdown() {
+	require := s.Require()
+
+	// create an account which supplies and collateralizes 100 UMEE and 10 ATOM
+	addr := s.newAccount(coin.New(umeeDenom, 100_000000), coin.New(atomDenom, 10_000000))
+	s.supply(addr, coin.New(umeeDenom, 100_000000), coin.New(atomDenom, 10_000000))
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 100_000000), coin.New("u/"+atomDenom, 10_000000))
+
+	resp, err := s.queryClient.LiquidationThresholdBreakdown(context.Background(),
+		&types.QueryLiquidationThresholdBreakdown{Address: addr.String()})
+	require.NoError(err)
+
+	// liquidation thresholds are always 0.26 in testing
+	require.Equal([]types.LiquidationThresholdContribution{
+		{
+			Denom:                     "u/" + atomDenom,
+			CollateralValue:           sdk.MustNewDecFromStr("393.8"),
+			TokenLiquidationThreshold: sdk.MustNewDecFromStr("0.26"),
+			LiquidationThreshold:      sdk.MustNewDecFromStr("102.388"),
+		},
+		{
+			Denom:                     "u/" + umeeDenom,
+			CollateralValue:           sdk.MustNewDecFromStr("421"),
+			TokenLiquidationThreshold: sdk.MustNewDecFromStr("0.26"),
+			LiquidationThreshold:      sdk.MustNewDecFromStr("109.46"),
+		},
+	}, resp.Contributions)
+	require.Equal(sdk.MustNewDecFromStr("211.848"), resp.LiquidationThreshold)
+
+	// the total matches the liquidation threshold in the account summary
+	summary, err := s.queryClient.AccountSummary(context.Background(),
+		&types.QueryAccountSummary{Address: addr.String()})
+	require.NoError(err)
+	require.Equal(*summary.LiquidationThreshold, resp.LiquidationThreshold)
+
+	_, err = s.queryClient.LiquidationThresholdBreakdown(context.Background(),
+		&types.QueryLiquidationThresholdBreakdown{})
+	require.ErrorContains(err, "empty address")
+}
+
+func (s *IntegrationTestSuite) TestQuerier_CanFullExit() {
+	require := s.Require()
+
+	// create a supplier which supplies and collateralizes 100 UMEE, then borrows 20 UMEE
+	supplier := s.newAccount(coin.New(umeeDenom, 100_000000))
+	s.supply(supplier, coin.New(umeeDenom, 100_000000))
+	s.collateralize(supplier, coin.New("u/"+umeeDenom, 100_000000))
+	s.borrow(supplier, coin.New(umeeDenom, 20_000000))
+
+	// create a borrower which supplies and collateralizes 100 ATOM, then borrows 50 UMEE
+	borrower := s.newAccount(coin.New(atomDenom, 100_000000))
+	s.supply(borrower, coin.New(atomDenom, 100_000000))
+	s.collateralize(borrower, coin.New("u/"+atomDenom, 100_000000))
+	s.borrow(borrower, coin.New(umeeDenom, 50_000000))
+
+	// 30 UMEE is available, plus the 20 UMEE the supplier would repay, out of 100 UMEE it would withdraw
+	resp, err := s.queryClient.CanFullExit(context.Background(), &types.QueryCanFullExit{Address: supplier.String()})
+	require.NoError(err)
+	require.False(resp.CanExit)
+	require.Equal(sdk.NewCoins(coin.New(umeeDenom, 50_000000)), resp.Shortfalls)
+
+	// all supplied ATOM is available, and repaying UMEE does not require liquidity
+	resp, err = s.queryClient.CanFullExit(context.Background(), &types.QueryCanFullExit{Address: borrower.String()})
+	require.NoError(err)
+	require.True(resp.CanExit)
+	require.Empty(resp.Shortfalls)
+
+	_, err = s.queryClient.CanFullExit(context.Background(), &types.QueryCanFullExit{})
+	require.ErrorContains(err, "empty address")
+}
+
+func (s *IntegrationTestSuite) TestQuerier_CanWithdraw() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+
+	// create a supplier which supplies 1000 UMEE
+	supplier := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(supplier, coin.New(umeeDenom, 1000_000000))
+
+	// create a borrower which collateralizes 100 ATOM and borrows 200 UMEE
+	borrower := s.newAccount(coin.New(atomDenom, 100_000000))
+	s.supply(borrower, coin.New(atomDenom, 100_000000))
+	s.collateralize(borrower, coin.New("u/"+atomDenom, 100_000000))
+	s.borrow(borrower, coin.New(umeeDenom, 200_000000))
+
+	query := func(addr sdk.AccAddress, asset sdk.Coin) *types.QueryCanWithdrawResponse {
+		resp, err := s.queryClient.CanWithdraw(context.Background(),
+			&types.QueryCanWithdraw{Address: addr.String(), Asset: asset})
+		require.NoError(err)
+		return resp
+	}
+
+	resp := query(supplier, coin.New("u/"+umeeDenom, 10_000000))
+	require.Equal(&types.QueryCanWithdrawResponse{Allowed: true}, resp)
+
+	// an account with no uTokens
+	resp = query(s.newAccount(), coin.New("u/"+umeeDenom, 10_000000))
+	require.False(resp.Allowed)
+	require.Equal(types.WithdrawReasonInsufficientBalance, resp.Reason)
+
+	// 200 of the 1000 supplied UMEE are borrowed
+	resp = query(supplier, coin.New("u/"+umeeDenom, 1000_000000))
+	require.False(resp.Allowed)
+	require.Equal(types.WithdrawReasonInsufficientLiquidity, resp.Reason)
+
+	// the borrower's $842 debt requires more than 80 ATOM of collateral
+	resp = query(borrower, coin.New("u/"+atomDenom, 20_000000))
+	require.False(resp.Allowed)
+	require.Equal(types.WithdrawReasonOverBorrowLimit, resp.Reason)
+	require.NotEmpty(resp.Details)
+
+	// queries do not change state
+	require.Equal(coin.New("u/"+umeeDenom, 1000_000000),
+		app.BankKeeper.GetBalance(ctx, supplier, "u/"+umeeDenom))
+	require.Equal(sdk.NewCoins(coin.New("u/"+atomDenom, 100_000000)),
+		app.LeverageKeeper.GetBorrowerCollateral(ctx, borrower))
+
+	// invalid and unregistered uTokens are rejected
+	_, err := s.queryClient.CanWithdraw(context.Background(),
+		&types.QueryCanWithdraw{Address: supplier.String(), Asset: coin.New(umeeDenom, 1)})
+	require.ErrorIs(err, types.ErrNotUToken)
+	_, err = s.queryClient.CanWithdraw(context.Background(),
+		&types.QueryCanWithdraw{Address: supplier.String(), Asset: coin.New("u/abcd", 1)})
+	require.ErrorIs(err, types.ErrNotRegisteredToken)
+}
+
+func (s *IntegrationTestSuite) TestQuerier_ReserveCoverage() {
+	ctx, require := s.ctx, s.Require()
+
+	// with nothing borrowed, coverage is the sentinel value
+	s.setReserves(coin.New(umeeDenom, 5_000000))
+	resp, err := s.queryClient.ReserveCoverage(ctx.Context(), &types.QueryReserveCoverage{Denom: umeeDenom})
+	require.NoError(err)
+	require.Equal(types.QueryReserveCoverageResponse{
+		Reserves: coin.New(umeeDenom, 5_000000),
+		Borrowed: coin.Zero(umeeDenom),
+		Coverage: types.NoBorrowReserveCoverage,
+	}, *resp)
+
+	// create a borrower which borrows 20 UMEE
+	addr := s.newAccount(coin.New(umeeDenom, 100_000000))
+	s.supply(addr, coin.New(umeeDenom, 100_000000))
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 100_000000))
+	s.borrow(addr, coin.New(umeeDenom, 20_000000))
+
+	resp, err = s.queryClient.ReserveCoverage(ctx.Context(), &types.QueryReserveCoverage{Denom: umeeDenom})
+	require.NoError(err)
+	require.Equal(types.QueryReserveCoverageResponse{
+		Reserves: coin.New(umeeDenom, 5_000000),
+		Borrowed: coin.New(umeeDenom, 20_000000),
+		Coverage: sdk.MustNewDecFromStr("0.25"),
+	}, *resp)
+
+	// unregistered denoms are rejected
+	_, err = s.queryClient.ReserveCoverage(ctx.Context(), &types.QueryReserveCoverage{Denom: "abcd"})
+	require.ErrorIs(err, types.ErrNotRegisteredToken)
+}
+
+func (s *IntegrationTestSuite) TestQuerier_UTokenSupply() {
+	ctx, require := s.ctx, s.Require()
+
+	// supply 100 UMEE from two accounts
+	s.supply(s.newAccount(coin.New(umeeDenom, 60_000000)), coin.New(umeeDenom, 60_000000))
+	s.supply(s.newAccount(coin.New(umeeDenom, 40_000000)), coin.New(umeeDenom, 40_000000))
+
+	resp, err := s.queryClient.UTokenSupply(ctx.Context(), &types.QueryUTokenSupply{Denom: "u/" + umeeDenom})
+	require.NoError(err)
+	require.Equal(coin.New("u/"+umeeDenom, 100_000000), resp.Supply)
+
+	// nothing has been supplied of another registered token
+	resp, err = s.queryClient.UTokenSupply(ctx.Context(), &types.QueryUTokenSupply{Denom: "u/" + atomDenom})
+	require.NoError(err)
+	require.Equal(coin.Zero("u/"+atomDenom), resp.Supply)
+
+	// base denoms and unregistered uTokens are rejected
+	_, err = s.queryClient.UTokenSupply(ctx.Context(), &types.QueryUTokenSupply{Denom: umeeDenom})
+	require.ErrorIs(err, types.ErrNotUToken)
+	_, err = s.queryClient.UTokenSupply(ctx.Context(), &types.QueryUTokenSupply{Denom: "u/abcd"})
+	require.ErrorIs(err, types.ErrNotRegisteredToken)
+}
+
+func (s *IntegrationTestSuite) TestQuerier_AccountNetAPY() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+
+	// account with no positions has zero net APY
+	empty := s.newAccount()
+	resp, err := s.queryClient.AccountNetAPY(ctx.Context(), &types.QueryAccountNetAPY{Address: empty.String()})
+	require.NoError(err)
+	require.Equal(sdk.ZeroDec(), resp.Net_APY)
+	require.Equal(sdk.ZeroDec(), resp.Equity)
+
+	// creates account which has supplied and collateralized 1000 UMEE, and borrowed 100 UMEE
+	addr := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(addr, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 1000_000000))
+	s.borrow(addr, coin.New(umeeDenom, 100_000000))
+
+	resp, err = s.queryClient.AccountNetAPY(ctx.Context(), &types.QueryAccountNetAPY{Address: addr.String()})
+	require.NoError(err)
+
+	// 1000 UMEE supplied and 100 UMEE borrowed, at $4.21
+	supplyIncome := sdk.MustNewDecFromStr("4210").Mul(app.LeverageKeeper.DeriveSupplyAPY(ctx, umeeDenom))
+	borrowCost := sdk.MustNewDecFromStr("421").Mul(app.LeverageKeeper.DeriveBorrowAPY(ctx, umeeDenom))
+	expected := types.QueryAccountNetAPYResponse{
+		Net_APY:         supplyIncome.Sub(borrowCost).Quo(sdk.MustNewDecFromStr("3789")),
+		Equity:          sdk.MustNewDecFromStr("3789"),
+		SupplyIncome:    supplyIncome,
+		IncentiveIncome: sdk.ZeroDec(),
+		BorrowCost:      borrowCost,
+	}
+	require.Equal(expected, *resp)
+
+}
+
+func (s *IntegrationTestSuite) TestQuerier_AccountEffectiveBorrowRate() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+
+	query := func(addr sdk.AccAddress) *types.QueryAccountEffectiveBorrowRateResponse {
+		resp, err := s.queryClient.AccountEffectiveBorrowRate(ctx.Context(),
+			&types.QueryAccountEffectiveBorrowRate{Address: addr.String()})
+		require.NoError(err)
+		return resp
+	}
+
+	// account with no borrows has a zero effective rate
+	empty := s.newAccount()
+	require.Equal(types.QueryAccountEffectiveBorrowRateResponse{
+		EffectiveRate: sdk.ZeroDec(),
+		BorrowedValue: sdk.ZeroDec(),
+		BorrowCost:    sdk.ZeroDec(),
+	}, *query(empty))
+
+	// a supplier provides ATOM liquidity
+	supplier := s.newAccount(coin.New(atomDenom, 50_000000))
+	s.supply(supplier, coin.New(atomDenom, 50_000000))
+
+	// creates account which has supplied and collateralized 1000 UMEE, and borrowed 100 UMEE and 10 ATOM,
+	// so UMEE and ATOM borrow APYs differ because of their different supply utilization
+	addr := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(addr, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 1000_000000))
+	s.borrow(addr, coin.New(umeeDenom, 100_000000))
+	s.borrow(addr, coin.New(atomDenom, 10_000000))
+
+	// 100 UMEE at $4.21 and 10 ATOM at $39.38
+	atomValue := sdk.MustNewDecFromStr("393.8")
+	umeeValue := sdk.MustNewDecFromStr("421")
+	atomAPY := app.LeverageKeeper.DeriveBorrowAPY(ctx, atomDenom)
+	umeeAPY := app.LeverageKeeper.DeriveBorrowAPY(ctx, umeeDenom)
+	require.NotEqual(atomAPY, umeeAPY)
+	borrowCost := atomValue.Mul(atomAPY).Add(umeeValue.Mul(umeeAPY))
+
+	require.Equal(types.QueryAccountEffectiveBorrowRateResponse{
+		EffectiveRate: borrowCost.Quo(sdk.MustNewDecFromStr("814.8")),
+		BorrowedValue: sdk.MustNewDecFromStr("814.8"),
+		BorrowCost:    borrowCost,
+		Components: []types.BorrowRateComponent{
+			{Denom: atomDenom, BorrowedValue: atomValue, Borrow_APY: atomAPY},
+			{Denom: umeeDenom, BorrowedValue: umeeValue, Borrow_APY: umeeAPY},
+		},
+	}, *query(addr))
+
+	_, err := s.queryClient.AccountEffectiveBorrowRate(ctx.Context(), &types.QueryAccountEffectiveBorrowRate{})
+	require.ErrorContains(err, "empty address")
+}
+
+func (s *IntegrationTestSuite) TestQuerier_FreeCollateral() {
+	ctx, require := s.ctx, s.Require()
+
+	// creates account which has supplied and collateralized 1000 UMEE
+	addr := s.newAccount(coin.New(umeeDenom, 1000_000000), coin.New(atomDenom, 10_000000))
+	s.supply(addr, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 1000_000000))
+
+	// with no borrows, all collateral is free
+	resp, err := s.queryClient.FreeCollateral(ctx.Context(), &types.QueryFreeCollateral{Address: addr.String()})
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(coin.New("u/"+umeeDenom, 1000_000000)), resp.FreeCollateral)
+
+	// borrow 100 UMEE, which pins 400 u/UMEE at collateral weight 0.25, leaving 600 free
+	s.borrow(addr, coin.New(umeeDenom, 100_000000))
+	resp, err = s.queryClient.FreeCollateral(ctx.Context(), &types.QueryFreeCollateral{Address: addr.String()})
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(coin.New("u/"+umeeDenom, 600_000000)), resp.FreeCollateral)
+
+	// add 10 ATOM collateral, which is fully free because UMEE collateral covers all borrows
+	s.supply(addr, coin.New(atomDenom, 10_000000))
+	s.collateralize(addr, coin.New("u/"+atomDenom, 10_000000))
+	resp, err = s.queryClient.FreeCollateral(ctx.Context(), &types.QueryFreeCollateral{Address: addr.String()})
+	require.NoError(err)
+	// ATOM borrow limit 98.45 raises unused borrow limit to 729.95, out of UMEE's 1052.5
+	require.Equal(sdk.NewCoins(
+		coin.New("u/"+atomDenom, 10_000000),
+		coin.New("u/"+umeeDenom, 693_539192),
+	), resp.FreeCollateral)
+
+	// account with no collateral has none free
+	empty := s.newAccount()
+	resp, err = s.queryClient.FreeCollateral(ctx.Context(), &types.QueryFreeCollateral{Address: empty.String()})
+	require.NoError(err)
+	require.Empty(resp.FreeCollateral)
+
+	_, err = s.queryClient.FreeCollateral(ctx.Context(), &types.QueryFreeCollateral{})
+	require.ErrorContains(err, "empty address")
+}
+
+func (s *IntegrationTestSuite) TestQuerier_IncentiveEligibility() {
+	ctx, require := s.ctx, s.Require()
+
+	// creates account which has supplied and collateralized 1000 UMEE
+	addr := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(addr, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 1000_000000))
+
+	// no incentive programs exist in this suite, so the result is empty
+	resp, err := s.queryClient.IncentiveEligibility(ctx.Context(), &types.QueryIncentiveEligibility{
+		Address: addr.String(),
+	})
+	require.NoError(err)
+	require.Empty(resp.Programs)
+
+	_, err = s.queryClient.IncentiveEligibility(ctx.Context(), &types.QueryIncentiveEligibility{})
+	require.ErrorContains(err, "empty address")
+}
+
+func (s *IntegrationTestSuite) TestQuerier_TopBorrowers() {
+	ctx, require := s.ctx, s.Require()
+
+	// three borrowers using UMEE collateral, with liquidation threshold 0.26
+	small := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(small, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(small, coin.New("u/"+umeeDenom, 1000_000000))
+	s.borrow(small, coin.New(umeeDenom, 10_000000))
+	medium := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(medium, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(medium, coin.New("u/"+umeeDenom, 1000_000000))
+	s.borrow(medium, coin.New(umeeDenom, 100_000000))
+	large := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(large, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(large, coin.New("u/"+umeeDenom, 1000_000000))
+	s.borrow(large, coin.New(umeeDenom, 200_000000))
+
+	// accounts which only supply are not borrowers
+	supplier := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(supplier, coin.New(umeeDenom, 1000_000000))
+
+	resp, err := s.queryClient.TopBorrowers(ctx.Context(), &types.QueryTopBorrowers{Limit: 2})
+	require.NoError(err)
+	expected := []types.TopBorrower{
+		{
+			Address:       large.String(),
+			BorrowedValue: sdk.MustNewDecFromStr("842"),
+			HealthFactor:  sdk.MustNewDecFromStr("1.3"), // 4210 * 0.26 / 842
+		},
+		{
+			Address:       medium.String(),
+			BorrowedValue: sdk.MustNewDecFromStr("421"),
+			HealthFactor:  sdk.MustNewDecFromStr("2.6"),
+		},
+	}
+	require.Equal(expected, resp.Borrowers)
+
+	// a larger limit returns all borrowers
+	resp, err = s.queryClient.TopBorrowers(ctx.Context(), &types.QueryTopBorrowers{Limit: 1000})
+	require.NoError(err)
+	expected = append(expected, types.TopBorrower{
+		Address:       small.String(),
+		BorrowedValue: sdk.MustNewDecFromStr("42.1"),
+		HealthFactor:  sdk.MustNewDecFromStr("26"),
+	})
+	require.Equal(expected, resp.Borrowers)
+
+	// a borrower of ATOM using UMEE collateral
+	atomSupplier := s.newAccount(coin.New(atomDenom, 100_000000))
+	s.supply(atomSupplier, coin.New(atomDenom, 100_000000))
+	atomBorrower := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(atomBorrower, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(atomBorrower, coin.New("u/"+umeeDenom, 1000_000000))
+	s.borrow(atomBorrower, coin.New(atomDenom, 1_000000))
+
+	// with UMEE prices missing, UMEE borrows have no value and collateral health factors are unknown
+	s.mockOracle.Clear("UMEE")
+	resp, err = s.queryClient.TopBorrowers(ctx.Context(), &types.QueryTopBorrowers{Limit: 10})
+	require.NoError(err)
+	expected = []types.TopBorrower{{
+		Address:       atomBorrower.String(),
+		BorrowedValue: sdk.MustNewDecFromStr("39.38"),
+		HealthFactor:  types.UnknownHealthFactor,
+	}}
+	require.Equal(expected, resp.Borrowers)
+	s.mockOracle.Reset()
+
+	_, err = s.queryClient.TopBorrowers(ctx.Context(), &types.QueryTopBorrowers{})
+	require.ErrorContains(err, "limit must be positive")
+}
+
+func (s *IntegrationTestSuite) TestQuerier_TopSuppliers() {
+	ctx, require := s.ctx, s.Require()
+
+	// supplies 100 UMEE and collateralizes half of it
+	umeeSupplier := s.newAccount(coin.New(umeeDenom, 100_000000))
+	s.supply(umeeSupplier, coin.New(umeeDenom, 100_000000))
+	s.collateralize(umeeSupplier, coin.New("u/"+umeeDenom, 50_000000))
+	// supplies 10 ATOM and 10 UMEE
+	mixedSupplier := s.newAccount(coin.New(atomDenom, 10_000000), coin.New(umeeDenom, 10_000000))
+	s.supply(mixedSupplier, coin.New(atomDenom, 10_000000), coin.New(umeeDenom, 10_000000))
+	// a borrower's wallet tokens are not supplied
+	borrower := s.newAccount(coin.New(umeeDenom, 1000_000000))
+
+	resp, err := s.queryClient.TopSuppliers(ctx.Context(), &types.QueryTopSuppliers{Limit: 10})
+	require.NoError(err)
+	expected := []types.TopSupplier{
+		{
+			Address:       mixedSupplier.String(),
+			SuppliedValue: sdk.MustNewDecFromStr("435.9"), // 393.8 + 42.1
+			Supplied:      sdk.NewCoins(coin.New(atomDenom, 10_000000), coin.New(umeeDenom, 10_000000)),
+		},
+		{
+			Address:       umeeSupplier.String(),
+			SuppliedValue: sdk.MustNewDecFromStr("421"),
+			Supplied:      sdk.NewCoins(coin.New(umeeDenom, 100_000000)),
+		},
+	}
+	require.Equal(expected, resp.Suppliers)
+	for _, supplier := range resp.Suppliers {
+		require.NotEqual(borrower.String(), supplier.Address)
+	}
+
+	// limit and denom filters
+	resp, err = s.queryClient.TopSuppliers(ctx.Context(), &types.QueryTopSuppliers{Limit: 1, Denom: umeeDenom})
+	require.NoError(err)
+	expected = []types.TopSupplier{{
+		Address:       umeeSupplier.String(),
+		SuppliedValue: sdk.MustNewDecFromStr("421"),
+		Supplied:      sdk.NewCoins(coin.New(umeeDenom, 100_000000)),
+	}}
+	require.Equal(expected, resp.Suppliers)
+
+	_, err = s.queryClient.TopSuppliers(ctx.Context(), &types.QueryTopSuppliers{Limit: 1, Denom: "abcd"})
+	require.ErrorIs(err, types.ErrNotRegisteredToken)
+	_, err = s.queryClient.TopSuppliers(ctx.Context(), &types.QueryTopSuppliers{})
+	require.ErrorContains(err, "limit must be positive")
+}
+
+func (s *IntegrationTestSuite) TestQuerier_LiquidationBuffer() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+
+	atom, err := app.LeverageKeeper.GetTokenSettings(ctx, atomDenom)
+	require.NoError(err)
+	atom.CollateralWeight = sdk.MustNewDecFromStr("0.6")
+	atom.LiquidationThreshold = sdk.MustNewDecFromStr("0.75")
+	require.NoError(app.LeverageKeeper.SetTokenSettings(ctx, atom))
+
+	resp, err := s.queryClient.LiquidationBuffer(ctx.Context(), &types.QueryLiquidationBuffer{})
+	require.NoError(err)
+	require.Len(resp.Buffers, 5)
+	for _, b := range resp.Buffers {
+		token, err := app.LeverageKeeper.GetTokenSettings(ctx, b.Denom)
+		require.NoError(err)
+		require.Equal(token.LiquidationThreshold.Sub(token.CollateralWeight), b.Buffer, b.Denom)
+	}
+
+	resp, err = s.queryClient.LiquidationBuffer(ctx.Context(), &types.QueryLiquidationBuffer{Denom: atomDenom})
+	require.NoError(err)
+	require.Equal([]types.LiquidationBuffer{{Denom: atomDenom, Buffer: sdk.MustNewDecFromStr("0.15")}}, resp.Buffers)
+
+	_, err = s.queryClient.LiquidationBuffer(ctx.Context(), &types.QueryLiquidationBuffer{Denom: "abcd"})
+	require.ErrorIs(err, types.ErrNotRegisteredToken)
+}
+
+func (s *IntegrationTestSuite) TestQuerier_CollateralPriceFloors() {
+	ctx, require := s.ctx, s.Require()
+
+	// create and fund a supplier which supplies 100 ATOM
+	supplier := s.newAccount(coin.New(atomDenom, 100_000000))
+	s.supply(supplier, coin.New(atomDenom, 100_000000))
+
+	// creates account which has supplied and collateralized 100 UMEE and 1 ATOM
+	addr := s.newAccount(coin.New(umeeDenom, 100_000000), coin.New(atomDenom, 1_000000))
+	s.supply(addr, coin.New(umeeDenom, 100_000000), coin.New(atomDenom, 1_000000))
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 100_000000), coin.New("u/"+atomDenom, 1_000000))
+
+	// with no borrows, no price drop makes the account eligible for liquidation
+	resp, err := s.queryClient.CollateralPriceFloors(ctx.Context(), &types.QueryCollateralPriceFloors{
+		Address: addr.String(),
+	})
+	require.NoError(err)
+	require.Equal([]types.CollateralPriceFloor{
+		{Denom: atomDenom, PriceFloor: sdk.ZeroDec()},
+		{Denom: umeeDenom, PriceFloor: sdk.ZeroDec()},
+	}, resp.Floors)
+
+	// borrow 2 ATOM ($78.76). ATOM collateral contributes $10.2388 to liquidation threshold,
+	// so UMEE must provide $68.5212 from 100 UMEE at a liquidation threshold of 0.26.
+	// A drop in ATOM price reduces borrowed value more than liquidation threshold.
+	s.borrow(addr, coin.New(atomDenom, 2_000000))
+	resp, err = s.queryClient.CollateralPriceFloors(ctx.Context(), &types.QueryCollateralPriceFloors{
+		Address: addr.String(),
+	})
+	require.NoError(err)
+	require.Equal([]types.CollateralPriceFloor{
+		{Denom: atomDenom, PriceFloor: sdk.ZeroDec()},
+		{Denom: umeeDenom, PriceFloor: sdk.MustNewDecFromStr("68.5212").Quo(sdk.NewDec(26))},
+	}, resp.Floors)
+
+	// account with no collateral has no floors
+	empty := s.newAccount()
+	resp, err = s.queryClient.CollateralPriceFloors(ctx.Context(), &types.QueryCollateralPriceFloors{
+		Address: empty.String(),
+	})
+	require.NoError(err)
+	require.Empty(resp.Floors)
+
+	_, err = s.queryClient.CollateralPriceFloors(ctx.Context(), &types.QueryCollateralPriceFloors{})
+	require.ErrorContains(err, "empty address")
+}
+
+func (s *IntegrationTestSuite) TestQuerier_BorrowAPY() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+
+	dec := func(s string) *sdk.Dec {
+		d := sdk.MustNewDecFromStr(s)
+		return &d
+	}
+
+	// without an override, the current supply utilization is used
+	resp, err := s.queryClient.BorrowAPY(ctx.Context(), &types.QueryBorrowAPY{Denom: umeeDenom})
+	require.NoError(err)
+	require.Equal(app.LeverageKeeper.DeriveBorrowAPY(ctx, umeeDenom), resp.Borrow_APY)
+	require.Equal(app.LeverageKeeper.SupplyUtilization(ctx, umeeDenom), resp.Utilization)
+
+	// at zero utilization, borrow APY is the base borrow rate
+	resp, err = s.queryClient.BorrowAPY(ctx.Context(), &types.QueryBorrowAPY{Denom: umeeDenom, Utilization: dec("0")})
+	require.NoError(err)
+	require.Equal(sdk.MustNewDecFromStr("0.02"), resp.Borrow_APY)
+
+	// halfway to the kink at 80% utilization, borrow APY is halfway between 2% and 22%
+	resp, err = s.queryClient.BorrowAPY(ctx.Context(), &types.QueryBorrowAPY{Denom: umeeDenom, Utilization: dec("0.4")})
+	require.NoError(err)
+	require.Equal(sdk.MustNewDecFromStr("0.12"), resp.Borrow_APY)
+	require.Equal(sdk.MustNewDecFromStr("0.4"), resp.Utilization)
+
+	// halfway between the kink and full utilization, borrow APY is halfway between 22% and 152%
+	resp, err = s.queryClient.BorrowAPY(ctx.Context(), &types.QueryBorrowAPY{Denom: umeeDenom, Utilization: dec("0.9")})
+	require.NoError(err)
+	require.Equal(sdk.MustNewDecFromStr("0.87"), resp.Borrow_APY)
+
+	resp, err = s.queryClient.BorrowAPY(ctx.Context(), &types.QueryBorrowAPY{Denom: umeeDenom, Utilization: dec("1")})
+	require.NoError(err)
+	require.Equal(sdk.MustNewDecFromStr("1.52"), resp.Borrow_APY)
+
+	_, err = s.queryClient.BorrowAPY(ctx.Context(), &types.QueryBorrowAPY{Denom: umeeDenom, Utilization: dec("1.01")})
+	require.ErrorContains(err, "utilization must be between 0 and 1")
+	_, err = s.queryClient.BorrowAPY(ctx.Context(), &types.QueryBorrowAPY{Denom: umeeDenom, Utilization: dec("-0.1")})
+	require.ErrorContains(err, "utilization must be between 0 and 1")
+	_, err = s.queryClient.BorrowAPY(ctx.Context(), &types.QueryBorrowAPY{Denom: "abcd"})
+	require.ErrorIs(err, types.ErrNotRegisteredToken)
+	_, err = s.queryClient.BorrowAPY(ctx.Context(), &types.QueryBorrowAPY{})
+	require.ErrorContains(err, "empty denom")
+}
+
+func (s *IntegrationTestSuite) TestQuerier_SupplyAPY() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+
+	// a borrower supplies and collateralizes 100 UMEE, then borrows 20 UMEE so suppliers earn interest
+	borrower := s.newAccount(coin.New(umeeDenom, 100_000000))
+	s.supply(borrower, coin.New(umeeDenom, 100_000000))
+	s.collateralize(borrower, coin.New("u/"+umeeDenom, 100_000000))
+	s.borrow(borrower, coin.New(umeeDenom, 20_000000))
+	gross := app.LeverageKeeper.DeriveSupplyAPY(ctx, umeeDenom)
+	require.True(gross.IsPositive())
+
+	query := func(holdingPeriod time.Duration) *types.QuerySupplyAPYResponse {
+		resp, err := s.queryClient.SupplyAPY(ctx.Context(),
+			&types.QuerySupplyAPY{Denom: umeeDenom, HoldingPeriod: holdingPeriod})
+		require.NoError(err)
+		require.Equal(gross, resp.GrossSupply_APY)
+		return resp
+	}
+
+	// without a withdraw fee, the gross supply APY is returned for any holding period
+	require.Equal(gross, query(0).Supply_APY)
+	require.Equal(gross, query(24*time.Hour).Supply_APY)
+	require.Equal(sdk.ZeroDec(), query(24*time.Hour).WithdrawFee)
+
+	// with a 0.1% withdraw fee, a one year holding period costs 0.1% APY plus the fee on interest
+	umee, err := app.LeverageKeeper.GetTokenSettings(ctx, umeeDenom)
+	require.NoError(err)
+	umee.WithdrawFee = sdk.MustNewDecFromStr("0.001")
+	require.NoError(app.LeverageKeeper.SetTokenSettings(ctx, umee))
+	feeFactor := sdk.MustNewDecFromStr("0.999")
+
+	resp := query(365 * 24 * time.Hour)
+	require.Equal(umee.WithdrawFee, resp.WithdrawFee)
+	require.Equal(gross.Mul(feeFactor).Sub(sdk.MustNewDecFromStr("0.001")), resp.Supply_APY)
+
+	// a tenth of a year amortizes the fee to 1% APY
+	resp = query(876 * time.Hour)
+	require.Equal(gross.Mul(feeFactor).Sub(sdk.MustNewDecFromStr("0.01")), resp.Supply_APY)
+	require.True(resp.Supply_APY.LT(gross))
+
+	// without a holding period, the fee is not amortized
+	require.Equal(gross, query(0).Supply_APY)
+
+	_, err = s.queryClient.SupplyAPY(ctx.Context(), &types.QuerySupplyAPY{Denom: umeeDenom, HoldingPeriod: -time.Hour})
+	require.ErrorContains(err, "holding period must not be negative")
+	_, err = s.queryClient.SupplyAPY(ctx.Context(), &types.QuerySupplyAPY{Denom: "abcd"})
+	require.ErrorIs(err, types.ErrNotRegisteredToken)
+	_, err = s.queryClient.SupplyAPY(ctx.Context(), &types.QuerySupplyAPY{})
+	require.ErrorContains(err, "empty denom")
+}
+
+func (s *IntegrationTestSuite) TestQuerier_ModuleConfig() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+
+	enabled := func() map[string]bool {
+		resp, err := s.queryClient.ModuleConfig(ctx.Context(), &types.QueryModuleConfig{})
+		require.NoError(err)
+		require.Equal(uint64(types.ConsensusVersion), resp.ConsensusVersion)
+		m := map[string]bool{}
+		for _, f := range resp.Features {
+			m[f.Name] = f.Enabled
+		}
+		return m
+	}
+
+	// the test suite runs with liquidator queries enabled
+	require.Equal(map[string]bool{
+		"borrow_paused":            false,
+		"supply_paused":            false,
+		"borrow_cooldown":          false,
+		"max_withdraw_rate":        false,
+		"liquidation_dust_repay":   false,
+		"annual_compounding":       false,
+		"price_circuit_breaker":    false,
+		"liquidation_grace_period": false,
+		"liquidator_queries":       true,
+	}, enabled())
+
+	params := app.LeverageKeeper.GetParams(ctx)
+	params.BorrowPaused = true
+	params.BorrowCooldownBlocks = 10
+	params.CompoundingMode = types.CompoundingMode_COMPOUNDING_MODE_ANNUAL
+	params.CircuitBreakerDeviation = sdk.MustNewDecFromStr("0.2")
+	params.LiquidationGracePeriod = 5
+	app.LeverageKeeper.SetParams(ctx, params)
+
+	require.Equal(map[string]bool{
+		"borrow_paused":            true,
+		"supply_paused":            false,
+		"borrow_cooldown":          true,
+		"max_withdraw_rate":        false,
+		"liquidation_dust_repay":   false,
+		"annual_compounding":       true,
+		"price_circuit_breaker":    true,
+		"liquidation_grace_period": true,
+		"liquidator_queries":       true,
+	}, enabled())
+}
+
+func (s *IntegrationTestSuite) TestQuerier_RequiredCollateral() {
+	ctx, srv, require := s.ctx, s.msgSrvr, s.Require()
+
+	required := func(req *types.QueryRequiredCollateral) sdk.Coin {
+		resp, err := s.queryClient.RequiredCollateral(ctx.Context(), req)
+		require.NoError(err)
+		return resp.Required
+	}
+
+	// borrowing 1 ATOM ($39.38) needs a borrow limit of $39.38, which is 157.52 UMEE value
+	// at a collateral weight of 0.25, or 37.415677 UMEE at $4.21 (rounded up)
+	require.Equal(coin.New(umeeDenom, 37_415677), required(&types.QueryRequiredCollateral{
+		Borrow:          coin.New(atomDenom, 1_000000),
+		CollateralDenom: umeeDenom,
+	}))
+
+	// with a safety margin of 0.2, the borrow limit must be $49.225 instead
+	margin := sdk.MustNewDecFromStr("0.2")
+	require.Equal(coin.New(umeeDenom, 46_769597), required(&types.QueryRequiredCollateral{
+		Borrow:          coin.New(atomDenom, 1_000000),
+		CollateralDenom: umeeDenom,
+		SafetyMargin:    &margin,
+	}))
+
+	// an account with 10 UMEE collateral already has a borrow limit of $10.525
+	supplier := s.newAccount(coin.New(atomDenom, 100_000000))
+	s.supply(supplier, coin.New(atomDenom, 100_000000))
+	addr := s.newAccount(coin.New(umeeDenom, 100_000000))
+	s.supply(addr, coin.New(umeeDenom, 10_000000))
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 10_000000))
+	req := &types.QueryRequiredCollateral{
+		Address:         addr.String(),
+		Borrow:          coin.New(atomDenom, 1_000000),
+		CollateralDenom: umeeDenom,
+	}
+	amount := required(req)
+	require.Equal(coin.New(umeeDenom, 27_415677), amount)
+
+	// supplying the required collateral enables the borrow, but one less token does not
+	cacheCtx, _ := ctx.CacheContext()
+	_, err := srv.SupplyCollateral(cacheCtx, types.NewMsgSupplyCollateral(addr, amount.SubAmount(sdk.OneInt())))
+	require.NoError(err)
+	_, err = srv.Borrow(cacheCtx, types.NewMsgBorrow(addr, coin.New(atomDenom, 1_000000)))
+	require.ErrorIs(err, types.ErrUndercollaterized)
+	_, err = srv.SupplyCollateral(ctx, types.NewMsgSupplyCollateral(addr, amount))
+	require.NoError(err)
+	_, err = srv.Borrow(ctx, types.NewMsgBorrow(addr, coin.New(atomDenom, 1_000000)))
+	require.NoError(err)
+
+	// with 90 UMEE collateral, the borrow limit of $94.725 already covers borrowing a second ATOM
+	s.supply(addr, coin.New(umeeDenom, 52_584323))
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 52_584323))
+	require.Equal(coin.Zero(umeeDenom), required(&types.QueryRequiredCollateral{
+		Address:         addr.String(),
+		Borrow:          coin.New(atomDenom, 1_000000),
+		CollateralDenom: umeeDenom,
+	}))
+
+	margin = sdk.OneDec()
+	_, err = s.queryClient.RequiredCollateral(ctx.Context(), &types.QueryRequiredCollateral{
+		Borrow: coin.New(atomDenom, 1_000000), CollateralDenom: umeeDenom, SafetyMargin: &margin,
+	})
+	require.ErrorContains(err, "safety margin must be at least 0 and less than 1")
+	_, err = s.queryClient.RequiredCollateral(ctx.Context(), &types.QueryRequiredCollateral{
+		Borrow: coin.New(atomDenom, 1_000000), CollateralDenom: "abcd",
+	})
+	require.ErrorIs(err, types.ErrNotRegisteredToken)
+	_, err = s.queryClient.RequiredCollateral(ctx.Context(), &types.QueryRequiredCollateral{
+		Borrow: coin.New(atomDenom, 1_000000),
+	})
+	require.ErrorContains(err, "empty collateral denom")
+}
+
+func (s *IntegrationTestSuite) TestQuerier_HealthDistribution() {
+	ctx, require := s.ctx, s.Require()
+
+	// three borrowers using UMEE collateral, with health factors 26, 2.6 and 1.3
+	small := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(small, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(small, coin.New("u/"+umeeDenom, 1000_000000))
+	s.borrow(small, coin.New(umeeDenom, 10_000000))
+	medium := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(medium, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(medium, coin.New("u/"+umeeDenom, 1000_000000))
+	s.borrow(medium, coin.New(umeeDenom, 100_000000))
+	large := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(large, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(large, coin.New("u/"+umeeDenom, 1000_000000))
+	s.borrow(large, coin.New(umeeDenom, 200_000000))
+
+	bucket := func(min, max string, borrowers uint64, value string) types.HealthBucket {
+		b := types.HealthBucket{Borrowers: borrowers, BorrowedValue: sdk.MustNewDecFromStr(value)}
+		if min != "" {
+			d := sdk.MustNewDecFromStr(min)
+			b.MinHealthFactor = &d
+		}
+		if max != "" {
+			d := sdk.MustNewDecFromStr(max)
+			b.MaxHealthFactor = &d
+		}
+		return b
+	}
+
+	// default bounds
+	resp, err := s.queryClient.HealthDistribution(ctx.Context(), &types.QueryHealthDistribution{})
+	require.NoError(err)
+	require.Equal([]types.HealthBucket{
+		bucket("", "1", 0, "0"),
+		bucket("1", "1.1", 0, "0"),
+		bucket("1.1", "1.5", 1, "842"),
+		bucket("1.5", "", 2, "463.1"),
+	}, resp.Buckets)
+	require.Equal(uint64(0), resp.UnknownBorrowers)
+	require.Equal(sdk.ZeroDec(), resp.UnknownBorrowedValue)
+
+	// a health factor equal to a bound is in the bucket above it
+	resp, err = s.queryClient.HealthDistribution(ctx.Context(), &types.QueryHealthDistribution{
+		Bounds: []sdk.Dec{sdk.MustNewDecFromStr("2.6"), sdk.NewDec(10)},
+	})
+	require.NoError(err)
+	require.Equal([]types.HealthBucket{
+		bucket("", "2.6", 1, "842"),
+		bucket("2.6", "10", 1, "421"),
+		bucket("10", "", 1, "42.1"),
+	}, resp.Buckets)
+
+	// invalid bounds
+	_, err = s.queryClient.HealthDistribution(ctx.Context(), &types.QueryHealthDistribution{
+		Bounds: []sdk.Dec{sdk.NewDec(2), sdk.NewDec(2)},
+	})
+	require.ErrorContains(err, "bounds must be strictly increasing")
+	_, err = s.queryClient.HealthDistribution(ctx.Context(), &types.QueryHealthDistribution{
+		Bounds: []sdk.Dec{sdk.ZeroDec()},
+	})
+	require.ErrorContains(err, "bounds must be positive")
+
+	// a borrower of ATOM using UMEE collateral
+	atomSupplier := s.newAccount(coin.New(atomDenom, 100_000000))
+	s.supply(atomSupplier, coin.New(atomDenom, 100_000000))
+	atomBorrower := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(atomBorrower, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(atomBorrower, coin.New("u/"+umeeDenom, 1000_000000))
+	s.borrow(atomBorrower, coin.New(atomDenom, 1_000000))
+
+	// with UMEE prices missing, UMEE borrows have no value and collateral health factors are unknown
+	s.mockOracle.Clear("UMEE")
+	resp, err = s.queryClient.HealthDistribution(ctx.Context(), &types.QueryHealthDistribution{})
+	require.NoError(err)
+	for _, b := range resp.Buckets {
+		require.Equal(uint64(0), b.Borrowers)
+	}
+	require.Equal(uint64(1), resp.UnknownBorrowers)
+	require.Equal(sdk.MustNewDecFromStr("39.38"), resp.UnknownBorrowedValue)
+}
+
+func (s *IntegrationTestSuite) TestQuerier_InterestParams() {
+	ctx, require := s.ctx, s.Require()
+
+	resp, err := s.queryClient.InterestParams(ctx.Context(), &types.QueryInterestParams{Denom: umeeDenom})
+	require.NoError(err)
+	require.Equal(&types.QueryInterestParamsResponse{
+		BaseBorrowRate:  sdk.MustNewDecFromStr("0.02"),
+		KinkBorrowRate:  sdk.MustNewDecFromStr("0.22"),
+		MaxBorrowRate:   sdk.MustNewDecFromStr("1.52"),
+		KinkUtilization: sdk.MustNewDecFromStr("0.8"),
+		LowSlope:        sdk.MustNewDecFromStr("0.25"), // (0.22 - 0.02) / 0.8
+		HighSlope:       sdk.MustNewDecFromStr("6.5"),  // (1.52 - 0.22) / 0.2
+		ReserveFactor:   sdk.MustNewDecFromStr("0.2"),
+		Blacklist:       false,
+	}, resp)
+
+	_, err = s.queryClient.InterestParams(ctx.Context(), &types.QueryInterestParams{})
+	require.ErrorContains(err, "empty denom")
+	_, err = s.queryClient.InterestParams(ctx.Context(), &types.QueryInterestParams{Denom: "abcd"})
+	require.ErrorIs(err, types.ErrNotRegisteredToken)
+}
+
+func (s *IntegrationTestSuite) TestQuerier_GlobalStats() {
+	app, ctx, srv, require := s.app, s.ctx, s.msgSrvr, s.Require()
+
+	initial, err := s.queryClient.GlobalStats(ctx.Context(), &types.QueryGlobalStats{})
+	require.NoError(err)
+	require.Equal(uint64(len(app.LeverageKeeper.GetAllRegisteredTokens(ctx))), initial.Markets)
+
+	// a borrower which collateralizes 1000 UMEE and borrows 10 UMEE and 1 ATOM
+	atomSupplier := s.newAccount(coin.New(atomDenom, 100_000000))
+	s.supply(atomSupplier, coin.New(atomDenom, 100_000000))
+	borrower := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(borrower, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(borrower, coin.New("u/"+umeeDenom, 1000_000000))
+	s.borrow(borrower, coin.New(umeeDenom, 10_000000), coin.New(atomDenom, 1_000000))
+
+	// accounts with collateral are counted as suppliers, and borrowers are counted once
+	resp, err := s.queryClient.GlobalStats(ctx.Context(), &types.QueryGlobalStats{})
+	require.NoError(err)
+	require.Equal(initial.Borrowers+1, resp.Borrowers)
+	require.Equal(initial.Suppliers+1, resp.Suppliers)
+	// supplied value increases by 1000 UMEE ($4210) and 100 ATOM ($3938)
+	require.Equal(initial.TotalSuppliedValue.Add(sdk.MustNewDecFromStr("8148")), resp.TotalSuppliedValue)
+	// borrowed value increases by 10 UMEE ($42.1) and 1 ATOM ($39.38)
+	require.Equal(initial.TotalBorrowedValue.Add(sdk.MustNewDecFromStr("81.48")), resp.TotalBorrowedValue)
+
+	// the migration which sets the counters agrees with the counters maintained since
+	require.NoError(keeper.NewMigrator(&app.LeverageKeeper).Migrate1to2(ctx))
+	migrated, err := s.queryClient.GlobalStats(ctx.Context(), &types.QueryGlobalStats{})
+	require.NoError(err)
+	require.Equal(resp, migrated)
+
+	// repaying one of two borrowed denoms does not change the number of borrowers
+	_, err = srv.Repay(ctx, types.NewMsgRepay(borrower, coin.New(atomDenom, 1_000000)))
+	require.NoError(err)
+	resp, err = s.queryClient.GlobalStats(ctx.Context(), &types.QueryGlobalStats{})
+	require.NoError(err)
+	require.Equal(initial.Borrowers+1, resp.Borrowers)
+
+	// repaying all borrows and decollateralizing all collateral stops counting the account
+	_, err = srv.Repay(ctx, types.NewMsgRepay(borrower, coin.New(umeeDenom, 10_000000)))
+	require.NoError(err)
+	_, err = srv.Decollateralize(ctx, types.NewMsgDecollateralize(borrower, coin.New("u/"+umeeDenom, 1000_000000)))
+	require.NoError(err)
+	resp, err = s.queryClient.GlobalStats(ctx.Context(), &types.QueryGlobalStats{})
+	require.NoError(err)
+	require.Equal(initial.Borrowers, resp.Borrowers)
+	require.Equal(initial.Suppliers, resp.Suppliers)
+}
+
+func (s *IntegrationTestSuite) TestQuerier_CollateralizePreview() {
+	require := s.Require()
+
+	// create an account which supplies 100 UMEE and 10 ATOM, and collateralizes 100 u/UMEE
+	addr := s.newAccount(coin.New(umeeDenom, 100_000000), coin.New(atomDenom, 10_000000))
+	s.supply(addr, coin.New(umeeDenom, 100_000000), coin.New(atomDenom, 10_000000))
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 100_000000))
+
+	// collateral weights are always 0.25 in testing, so 100 u/UMEE provide $105.25 of borrow limit
+	// and 10 u/ATOM would add $98.45
+	resp, err := s.queryClient.CollateralizePreview(context.Background(), &types.QueryCollateralizePreview{
+		Address: addr.String(),
+		Asset:   coin.New("u/"+atomDenom, 10_000000),
+	})
+	require.NoError(err)
+	require.Equal(&types.QueryCollateralizePreviewResponse{
+		BorrowLimit:         sdk.MustNewDecFromStr("203.7"),
+		BorrowLimitIncrease: sdk.MustNewDecFromStr("98.45"),
+		WhatIf:              false,
+	}, resp)
+
+	// the preview matches the borrow limit after actually collateralizing
+	s.collateralize(addr, coin.New("u/"+atomDenom, 10_000000))
+	summary, err := s.queryClient.AccountSummary(context.Background(),
+		&types.QueryAccountSummary{Address: addr.String()})
+	require.NoError(err)
+	require.Equal(resp.BorrowLimit, summary.BorrowLimit)
+
+	// the wallet no longer holds any u/ATOM, so previewing more assumes the balance exists
+	resp, err = s.queryClient.CollateralizePreview(context.Background(), &types.QueryCollateralizePreview{
+		Address: addr.String(),
+		Asset:   coin.New("u/"+atomDenom, 10_000000),
+	})
+	require.NoError(err)
+	require.Equal(&types.QueryCollateralizePreviewResponse{
+		BorrowLimit:         sdk.MustNewDecFromStr("302.15"),
+		BorrowLimitIncrease: sdk.MustNewDecFromStr("98.45"),
+		WhatIf:              true,
+	}, resp)
+
+	_, err = s.queryClient.CollateralizePreview(context.Background(), &types.QueryCollateralizePreview{
+		Address: addr.String(),
+		Asset:   coin.New(atomDenom, 10_000000),
+	})
+	require.ErrorIs(err, types.ErrNotUToken)
+
+	_, err = s.queryClient.CollateralizePreview(context.Background(), &types.QueryCollateralizePreview{})
+	require.ErrorContains(err, "empty address")
+}
+
+func (s *IntegrationTestSuite) TestQuerier_AccountLiquidationView() {
+	require := s.Require()
+
+	// create an account which supplies and collateralizes 100 UMEE and borrows 10 UMEE
+	addr := s.newAccount(coin.New(umeeDenom, 100_000000))
+	s.supply(addr, coin.New(umeeDenom, 100_000000))
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 100_000000))
+	s.borrow(addr, coin.New(umeeDenom, 10_000000))
+
+	query := func() *types.QueryAccountLiquidationViewResponse {
+		resp, err := s.queryClient.AccountLiquidationView(context.Background(),
+			&types.QueryAccountLiquidationView{Address: addr.String()})
+		require.NoError(err)
+		return resp
+	}
+
+	// liquidation thresholds are always 0.26 in testing, so $421 of collateral has a $109.46 threshold
+	require.Equal(&types.QueryAccountLiquidationViewResponse{
+		LiquidationThreshold: sdk.MustNewDecFromStr("109.46"),
+		BorrowedValue:        sdk.MustNewDecFromStr("42.1"),
+		Shortfall:            sdk.ZeroDec(),
+		Liquidatable:         false,
+	}, query())
+
+	// artificially borrow 80 more UMEE, for a total of $378.90
+	s.forceBorrow(addr, coin.New(umeeDenom, 80_000000))
+	require.Equal(&types.QueryAccountLiquidationViewResponse{
+		LiquidationThreshold: sdk.MustNewDecFromStr("109.46"),
+		BorrowedValue:        sdk.MustNewDecFromStr("378.9"),
+		Shortfall:            sdk.MustNewDecFromStr("269.44"),
+		Liquidatable:         true,
+	}, query())
+
+	// an account with no positions is not liquidatable
+	resp, err := s.queryClient.AccountLiquidationView(context.Background(),
+		&types.QueryAccountLiquidationView{Address: s.newAccount().String()})
+	require.NoError(err)
+	require.False(resp.Liquidatable)
+	require.Equal(sdk.ZeroDec(), resp.Shortfall)
+
+	_, err = s.queryClient.AccountLiquidationView(context.Background(), &types.QueryAccountLiquidationView{})
+	require.ErrorContains(err, "empty address")
+}
+
+func (s *IntegrationTestSuite) TestQuerier_LifetimeReserves() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+
+	// creates account which has supplied and collateralized 1000 UMEE, and borrowed 200 UMEE
+	addr := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(addr, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 1000_000000))
+	s.borrow(addr, coin.New(umeeDenom, 200_000000))
+
+	query := func() *types.QueryLifetimeReservesResponse {
+		resp, err := s.queryClient.LifetimeReserves(ctx.Context(), &types.QueryLifetimeReserves{Denom: umeeDenom})
+		require.NoError(err)
+		return resp
+	}
+	require.Equal(coin.Zero(umeeDenom), query().LifetimeReserves)
+
+	// accrue a day of interest, which generates reserves
+	start := time.Unix(0, 0).Add(100 * time.Hour)
+	require.NoError(app.LeverageKeeper.AccrueAllInterest(ctx.WithBlockTime(start)))
+	require.NoError(app.LeverageKeeper.AccrueAllInterest(ctx.WithBlockTime(start.Add(24 * time.Hour))))
+	reserves := app.LeverageKeeper.GetReserves(ctx, umeeDenom)
+	require.True(reserves.IsPositive())
+	require.Equal(reserves, query().LifetimeReserves)
+
+	// withdrawing reserves does not reduce lifetime reserves
+	govAccAddr := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress().String()
+	_, err := s.msgSrvr.WithdrawReserves(ctx, types.NewMsgWithdrawReserves(
+		govAccAddr, "reserves", "withdraw reserves", s.newAccount().String(), coin.Zero(umeeDenom),
+	))
+	require.NoError(err)
+	require.Equal(coin.Zero(umeeDenom), app.LeverageKeeper.GetReserves(ctx, umeeDenom))
+	require.Equal(reserves, query().LifetimeReserves)
+
+	// lifetime reserves are counted from genesis, unless they began counting at an upgrade
+	require.Equal(int64(0), query().SinceHeight)
+	require.NoError(keeper.NewMigrator(&app.LeverageKeeper).Migrate1to2(ctx.WithBlockHeight(50)))
+	require.Equal(int64(50), query().SinceHeight)
+
+	_, err = s.queryClient.LifetimeReserves(ctx.Context(), &types.QueryLifetimeReserves{Denom: "uabcd"})
+	require.ErrorIs(err, types.ErrNotRegisteredToken)
+	_, err = s.queryClient.LifetimeReserves(ctx.Context(), &types.QueryLifetimeReserves{})
+	require.ErrorContains(err, "empty denom")
+}
+
+func (s *IntegrationTestSuite) TestQuerier_LiquidationRewardsPaid() {
+	app, ctx, srv, require := s.app, s.ctx, s.msgSrvr, s.Require()
+
+	// create a liquidator which has 1000 ATOM
+	liquidator := s.newAccount(coin.New(atomDenom, 1000_000000))
+
+	// create a borrower which supplies and collateralizes 1000 ATOM
+	borrower := s.newAccount(coin.New(atomDenom, 1000_000000))
+	s.supply(borrower, coin.New(atomDenom, 1000_000000))
+	s.collateralize(borrower, coin.New("u/"+atomDenom, 1000_000000))
+	// artificially borrow 500 ATOM - this can be liquidated without bad debt
+	s.forceBorrow(borrower, coin.New(atomDenom, 500_000000))
+
+	query := func(denom string) *types.QueryLiquidationRewardsPaidResponse {
+		resp, err := s.queryClient.LiquidationRewardsPaid(ctx.Context(),
+			&types.QueryLiquidationRewardsPaid{Denom: denom})
+		require.NoError(err)
+		return resp
+	}
+	require.Equal(coin.Zero(atomDenom), query(atomDenom).RewardsPaid)
+
+	liquidate := func(rewardDenom string) {
+		_, err := srv.Liquidate(ctx, &types.MsgLiquidate{
+			Liquidator:  liquidator.String(),
+			Borrower:    borrower.String(),
+			Repayment:   coin.New(atomDenom, 100_000000),
+			RewardDenom: rewardDenom,
+		})
+		require.NoError(err)
+	}
+
+	// a direct liquidation rewards 109 ATOM
+	liquidate(atomDenom)
+	require.Equal(coin.New(atomDenom, 109_000000), query(atomDenom).RewardsPaid)
+
+	// a uToken liquidation rewards 110 u/ATOM, which is counted in ATOM at an exchange rate of 1
+	liquidate("u/" + atomDenom)
+	require.Equal(coin.New(atomDenom, 219_000000), query(atomDenom).RewardsPaid)
+	require.Equal(query(atomDenom), query("u/"+atomDenom))
+	require.Equal(coin.Zero(umeeDenom), query(umeeDenom).RewardsPaid)
+
+	// rewards paid are counted from genesis, unless they began counting at an upgrade
+	require.Equal(int64(0), query(atomDenom).SinceHeight)
+	require.NoError(keeper.NewMigrator(&app.LeverageKeeper).Migrate1to2(ctx.WithBlockHeight(50)))
+	require.Equal(int64(50), query(atomDenom).SinceHeight)
+
+	_, err := s.queryClient.LiquidationRewardsPaid(ctx.Context(), &types.QueryLiquidationRewardsPaid{Denom: "uabcd"})
+	require.ErrorIs(err, types.ErrNotRegisteredToken)
+	_, err = s.queryClient.LiquidationRewardsPaid(ctx.Context(), &types.QueryLiquidationRewardsPaid{})
+	require.ErrorContains(err, "empty denom")
+}
+
+func (s *IntegrationTestSuite) TestQuerier_MarketQueriesAcceptUTokenDenom() {
+	ctx, require := s.ctx, s.Require()
+
+	// supply, collateralize and borrow UMEE so the market has nonzero utilization
+	addr := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(addr, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 1000_000000))
+	s.borrow(addr, coin.New(umeeDenom, 40_000000))
+
+	// each query returns the same response for a token's base denom and its uToken denom,
+	// and a clear error for a denom which is neither
+	queries := map[string]func(denom string) (any, error){
+		"MarketSummary": func(denom string) (any, error) {
+			return s.queryClient.MarketSummary(ctx.Context(), &types.QueryMarketSummary{Denom: denom})
+		},
+		"EffectiveReserveFactor": func(denom string) (any, error) {
+			return s.queryClient.EffectiveReserveFactor(ctx.Context(), &types.QueryEffectiveReserveFactor{Denom: denom})
+		},
+		"InterestIndex": func(denom string) (any, error) {
+			return s.queryClient.InterestIndex(ctx.Context(), &types.QueryInterestIndex{Denom: denom})
+		},
+		"AverageBorrowAPY": func(denom string) (any, error) {
+			return s.queryClient.AverageBorrowAPY(ctx.Context(),
+				&types.QueryAverageBorrowAPY{Denom: denom, Lookback: time.Hour})
+		},
+		"ReserveCoverage": func(denom string) (any, error) {
+			return s.queryClient.ReserveCoverage(ctx.Context(), &types.QueryReserveCoverage{Denom: denom})
+		},
+		"BorrowAPY": func(denom string) (any, error) {
+			return s.queryClient.BorrowAPY(ctx.Context(), &types.QueryBorrowAPY{Denom: denom})
+		},
+		"SupplyAPY": func(denom string) (any, error) {
+			return s.queryClient.SupplyAPY(ctx.Context(), &types.QuerySupplyAPY{Denom: denom, HoldingPeriod: time.Hour})
+		},
+		"AccrualStaleness": func(denom string) (any, error) {
+			return s.queryClient.AccrualStaleness(ctx.Context(), &types.QueryAccrualStaleness{Denom: denom})
+		},
+		"InterestParams": func(denom string) (any, error) {
+			return s.queryClient.InterestParams(ctx.Context(), &types.QueryInterestParams{Denom: denom})
+		},
+		"LifetimeReserves": func(denom string) (any, error) {
+			return s.queryClient.LifetimeReserves(ctx.Context(), &types.QueryLifetimeReserves{Denom: denom})
+		},
+		"APYSeries": func(denom string) (any, error) {
+			return s.queryClient.APYSeries(ctx.Context(), &types.QueryAPYSeries{Denom: denom, Interval: time.Hour})
+		},
+		"ExchangeRateTrend": func(denom string) (any, error) {
+			return s.queryClient.ExchangeRateTrend(ctx.Context(),
+				&types.QueryExchangeRateTrend{Denom: denom, Lookback: time.Hour})
+		},
+		"DebtReserveRatioSeries": func(denom string) (any, error) {
+			return s.queryClient.DebtReserveRatioSeries(ctx.Context(),
+				&types.QueryDebtReserveRatioSeries{Denom: denom, Interval: time.Hour})
+		},
+	}
+	for name, query := range queries {
+		base, err := query(umeeDenom)
+		require.NoError(err, name)
+		uToken, err := query("u/" + umeeDenom)
+		require.NoError(err, name)
+		require.Equal(base, uToken, name)
+
+		for _, denom := range []string{"abcd", "u/abcd", "u/u/" + umeeDenom} {
+			_, err = query(denom)
+			require.ErrorIs(err, types.ErrNotRegisteredToken, name)
+			require.ErrorContains(err, "neither a registered token nor its uToken", name)
+		}
+	}
+}
+
+func (s *IntegrationTestSuite) TestQuerier_SolvencyPriceFloor() {
+	ctx, require := s.ctx, s.Require()
+
+	query := func(denom string) *types.QuerySolvencyPriceFloorResponse {
+		resp, err := s.queryClient.SolvencyPriceFloor(ctx.Context(), &types.QuerySolvencyPriceFloor{Denom: denom})
+		require.NoError(err)
+		return resp
+	}
+
+	// create a supplier to provide liquidity
+	supplier := s.newAccount(coin.New(umeeDenom, 1000_000000), coin.New(atomDenom, 1000_000000))
+	s.supply(supplier, coin.New(umeeDenom, 1000_000000), coin.New(atomDenom, 1000_000000))
+
+	// a borrower collateralizes 100 ATOM ($3938) and borrows 500 UMEE ($2105)
+	borrower := s.newAccount(coin.New(atomDenom, 100_000000))
+	s.supply(borrower, coin.New(atomDenom, 100_000000))
+	s.collateralize(borrower, coin.New("u/"+atomDenom, 100_000000))
+	s.forceBorrow(borrower, coin.New(umeeDenom, 500_000000))
+
+	// collateral covers borrows until ATOM falls to 39.38 * 2105 / 3938 = 21.05
+	resp := query(atomDenom)
+	require.Equal(sdk.MustNewDecFromStr("21.05"), *resp.PriceFloor)
+	require.Equal(sdk.MustNewDecFromStr("39.38"), resp.Price)
+	require.Equal(sdk.MustNewDecFromStr("3938"), resp.CollateralValue)
+	require.Equal(sdk.MustNewDecFromStr("2105"), resp.BorrowedValue)
+
+	// uToken denoms are accepted
+	require.Equal(sdk.MustNewDecFromStr("21.05"), *query("u/" + atomDenom).PriceFloor)
+
+	// UMEE is only borrowed, so any drop in its price keeps the system solvent
+	require.Equal(sdk.ZeroDec(), *query(umeeDenom).PriceFloor)
+
+	// an account without collateral borrows 100 ATOM ($3938)
+	s.forceBorrow(s.newAccount(), coin.New(atomDenom, 100_000000))
+
+	// no ATOM price makes collateral cover borrows, but a UMEE price of zero would
+	require.Nil(query(atomDenom).PriceFloor)
+	require.Equal(sdk.ZeroDec(), *query(umeeDenom).PriceFloor)
+
+	// unregistered tokens are rejected
+	_, err := s.queryClient.SolvencyPriceFloor(ctx.Context(), &types.QuerySolvencyPriceFloor{Denom: "uabcd"})
+	require.ErrorIs(err, types.ErrNotRegisteredToken)
+}
+
+func (s *IntegrationTestSuite) TestQuerier_AccountCollateralQuality() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+
+	// ATOM is higher quality collateral than UMEE, which keeps the testing defaults of 0.25 and 0.26
+	atom, err := app.LeverageKeeper.GetTokenSettings(ctx, atomDenom)
+	require.NoError(err)
+	atom.CollateralWeight = sdk.MustNewDecFromStr("0.5")
+	atom.LiquidationThreshold = sdk.MustNewDecFromStr("0.6")
+	require.NoError(app.LeverageKeeper.SetTokenSettings(ctx, atom))
+
+	// an account with no collateral has zero averages
+	addr := s.newAccount(coin.New(umeeDenom, 100_000000), coin.New(atomDenom, 10_000000))
+	resp, err := s.queryClient.AccountCollateralQuality(ctx.Context(),
+		&types.QueryAccountCollateralQuality{Address: addr.String()})
+	require.NoError(err)
+	require.Equal(sdk.ZeroDec(), resp.CollateralWeight)
+	require.Equal(sdk.ZeroDec(), resp.LiquidationThreshold)
+
+	// collateralizing only ATOM yields its own parameters
+	s.supply(addr, coin.New(umeeDenom, 100_000000), coin.New(atomDenom, 10_000000))
+	s.collateralize(addr, coin.New("u/"+atomDenom, 10_000000))
+	resp, err = s.queryClient.AccountCollateralQuality(ctx.Context(),
+		&types.QueryAccountCollateralQuality{Address: addr.String()})
+	require.NoError(err)
+	require.Equal(sdk.MustNewDecFromStr("0.5"), resp.CollateralWeight)
+	require.Equal(sdk.MustNewDecFromStr("0.6"), resp.LiquidationThreshold)
+
+	// with $393.80 of ATOM and $421.00 of UMEE collateral, averages are weighted by value
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 100_000000))
+	resp, err = s.queryClient.AccountCollateralQuality(ctx.Context(),
+		&types.QueryAccountCollateralQuality{Address: addr.String()})
+	require.NoError(err)
+	totalValue := sdk.MustNewDecFromStr("814.8")
+	require.Equal(sdk.MustNewDecFromStr("302.15").Quo(totalValue), resp.CollateralWeight)     // 196.90 + 105.25
+	require.Equal(sdk.MustNewDecFromStr("345.74").Quo(totalValue), resp.LiquidationThreshold) // 236.28 + 109.46
+
+	// multiplied by collateral value, the averages reproduce the account's limits
+	summary, err := s.queryClient.AccountSummary(ctx.Context(), &types.QueryAccountSummary{Address: addr.String()})
+	require.NoError(err)
+	require.Equal(summary.CollateralValue, totalValue)
+	tolerance := sdk.SmallestDec().MulInt64(1000)
+	require.True(summary.BorrowLimit.Sub(resp.CollateralWeight.Mul(totalValue)).Abs().LTE(tolerance))
+	require.True(summary.LiquidationThreshold.Sub(resp.LiquidationThreshold.Mul(totalValue)).Abs().LTE(tolerance))
+
+	_, err = s.queryClient.AccountCollateralQuality(ctx.Context(), &types.QueryAccountCollateralQuality{})
+	require.ErrorContains(err, "empty address")
+}
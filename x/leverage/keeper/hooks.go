@@ -54,3 +54,51 @@ func (k Keeper) reduceBondTo(ctx sdk.Context, addr sdk.AccAddress, collateral sd
 	}
 	return nil
 }
+
+// pendingIncentives returns the rewards an account has pending on its collateral of a given uToken denom,
+// summed across all modules which have registered bond hooks. Returns empty coins if no such modules exist.
+func (k Keeper) pendingIncentives(ctx sdk.Context, addr sdk.AccAddress, uDenom string) sdk.Coins {
+	rewards := sdk.NewCoins()
+	for _, h := range k.bondHooks {
+		rewards = rewards.Add(h.PendingRewards(ctx, addr, uDenom)...)
+	}
+	return rewards
+}
+
+// incentiveIncome estimates the annual USD value of incentive rewards an account earns on its bonded collateral
+// of a given uToken denom, using spot prices. Sums the estimates of each module which has registered bond hooks,
+// using the amount bonded to that module. Returns zero if no such modules exist.
+func (k Keeper) incentiveIncome(ctx sdk.Context, addr sdk.AccAddress, uDenom string) (sdk.Dec, error) {
+	income := sdk.ZeroDec()
+	for _, h := range k.bondHooks {
+		bonded := h.GetBonded(ctx, addr, uDenom)
+		if !bonded.IsPositive() {
+			continue
+		}
+		token, err := k.ExchangeUToken(ctx, sdk.NewCoin(uDenom, bonded))
+		if err != nil {
+			return sdk.ZeroDec(), err
+		}
+		value, err := k.VisibleTokenValue(ctx, sdk.NewCoins(token), types.PriceModeSpot)
+		if err != nil {
+			return sdk.ZeroDec(), err
+		}
+		income = income.Add(value.Mul(h.IncentiveAPY(ctx, uDenom)))
+	}
+	return income, nil
+}
+
+// incentiveEligibility returns, for each ongoing incentive program of each module which has registered
+// bond hooks, whether an account earns rewards from that program and at what rate. Returns an empty
+// list if no such modules or programs exist.
+func (k Keeper) incentiveEligibility(ctx sdk.Context, addr sdk.AccAddress) []types.IncentiveEligibility {
+	result := []types.IncentiveEligibility{}
+	for _, h := range k.bondHooks {
+		for _, e := range h.IncentiveEligibility(ctx, addr) {
+			e.Collateral = k.GetCollateral(ctx, addr, e.BondDenom)
+			e.Eligible = e.Bonded.IsPositive()
+			result = append(result, e)
+		}
+	}
+	return result
+}
@@ -16,6 +16,5 @@ func (k Keeper) DonateCollateral(ctx sdk.Context, fromAddr sdk.AccAddress, uToke
 	}
 
 	// increase module reserves
-	reserves := k.GetReserves(ctx, token.Denom)
-	return k.setReserves(ctx, reserves.Add(token))
+	return k.addReserves(ctx, token)
 }
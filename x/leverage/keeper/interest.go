@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -23,8 +24,45 @@ func (k Keeper) DeriveBorrowAPY(ctx sdk.Context, denom string) sdk.Dec {
 		return sdk.ZeroDec()
 	}
 
-	utilization := k.SupplyUtilization(ctx, denom)
+	return borrowAPYAtUtilization(token, k.rateUtilization(ctx, denom))
+}
+
+// rateUtilization returns the supply utilization from which a token's borrow APY is derived. This is
+// its current supply utilization, unless UtilizationSmoothingFactor is below 1, in which case it is
+// the smoothed utilization recorded at the token's last interest accrual.
+func (k Keeper) rateUtilization(ctx sdk.Context, denom string) sdk.Dec {
+	if k.GetParams(ctx).UtilizationSmoothingFactor.GTE(sdk.OneDec()) {
+		return k.SupplyUtilization(ctx, denom)
+	}
+	return k.getSmoothedUtilization(ctx, denom)
+}
+
+// updateSmoothedUtilization moves a token's smoothed supply utilization towards its current supply
+// utilization by a smoothing factor. A factor of 1 sets it to the current utilization.
+func (k Keeper) updateSmoothedUtilization(ctx sdk.Context, denom string, factor sdk.Dec) error {
+	current := k.SupplyUtilization(ctx, denom)
+	previous := k.getSmoothedUtilization(ctx, denom)
+	smoothed := factor.Mul(current).Add(sdk.OneDec().Sub(factor).Mul(previous))
+	return k.setSmoothedUtilization(ctx, denom, smoothed)
+}
+
+// BorrowAPYAtUtilization derives the borrow interest rate a token denom would have at a
+// hypothetical supply utilization, using its token-specific params. Returns zero for
+// blacklisted tokens, which do not accrue interest.
+func (k Keeper) BorrowAPYAtUtilization(ctx sdk.Context, denom string, utilization sdk.Dec) (sdk.Dec, error) {
+	token, err := k.GetTokenSettings(ctx, denom)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+	if token.Blacklist {
+		return sdk.ZeroDec(), nil
+	}
+	return borrowAPYAtUtilization(token, utilization), nil
+}
 
+// borrowAPYAtUtilization computes a token's borrow interest rate at a given supply utilization
+// using its token-specific params. Utilization is expected to be between zero and one.
+func borrowAPYAtUtilization(token types.Token, utilization sdk.Dec) sdk.Dec {
 	if utilization.GTE(token.KinkUtilization) {
 		return Interpolate(
 			utilization,           // x
@@ -61,9 +99,43 @@ func (k Keeper) DeriveSupplyAPY(ctx sdk.Context, denom string) sdk.Dec {
 	return borrowRate.Mul(utilization).Mul(sdk.OneDec().Sub(reduction))
 }
 
+// RealizedSupplyAPY derives the supply interest rate on a token denom realized by a supplier who
+// withdraws after a holding period, assuming the current supply APY applies throughout. The token's
+// withdraw fee is charged on the withdrawn principal and simple interest, then amortized over the
+// holding period:
+//
+//	realized APY = supply APY * (1 - withdraw fee) - withdraw fee / holding period (in years)
+//
+// A zero holding period or withdraw fee returns the gross supply APY.
+func (k Keeper) RealizedSupplyAPY(ctx sdk.Context, denom string, holdingPeriod time.Duration) (sdk.Dec, error) {
+	token, err := k.GetTokenSettings(ctx, denom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	supplyAPY := k.DeriveSupplyAPY(ctx, denom)
+	if holdingPeriod <= 0 || !token.WithdrawFee.IsPositive() {
+		return supplyAPY, nil
+	}
+
+	years := sdk.NewDec(int64(holdingPeriod.Seconds())).QuoInt64(types.SecondsPerYear)
+	if !years.IsPositive() {
+		return supplyAPY, nil
+	}
+	feeCost := token.WithdrawFee.Quo(years)
+	return supplyAPY.Mul(sdk.OneDec().Sub(token.WithdrawFee)).Sub(feeCost), nil
+}
+
+// interestSlopes returns the increase in a token's borrow interest rate per unit of supply
+// utilization below and above its kink utilization.
+func interestSlopes(token types.Token) (low, high sdk.Dec) {
+	low = token.KinkBorrowRate.Sub(token.BaseBorrowRate).Quo(token.KinkUtilization)
+	high = token.MaxBorrowRate.Sub(token.KinkBorrowRate).Quo(sdk.OneDec().Sub(token.KinkUtilization))
+	return low, high
+}
+
 // AccrueAllInterest is called by EndBlock to update borrow positions.
 // It accrues interest on all open borrows, increase reserves, funds
-// oracle rewards, and sets LastInterestTime to BlockTime.
+// oracle rewards, and sets LastInterestTime to BlockTime and LastInterestHeight to BlockHeight.
 func (k Keeper) AccrueAllInterest(ctx sdk.Context) error {
 	currentTime := ctx.BlockTime().Unix()
 	prevInterestTime := k.getLastInterestTime(ctx)
@@ -100,7 +172,8 @@ func (k Keeper) AccrueAllInterest(ctx sdk.Context) error {
 
 	// fetch required parameters
 	tokens := k.GetAllRegisteredTokens(ctx)
-	oracleRewardFactor := k.GetParams(ctx).OracleRewardFactor
+	params := k.GetParams(ctx)
+	oracleRewardFactor := params.OracleRewardFactor
 
 	// create sdk.Coins objects to track oracle rewards, new reserves, and total interest accrued
 	oracleRewards := sdk.NewCoins()
@@ -114,15 +187,28 @@ func (k Keeper) AccrueAllInterest(ctx sdk.Context) error {
 			continue
 		}
 
-		// interest is accrued by continuous compound interest on each denom's Interest Scalar
+		// update smoothed utilization before deriving borrow APY from it
+		if err := k.updateSmoothedUtilization(ctx, token.BaseDenom, params.UtilizationSmoothingFactor); err != nil {
+			return err
+		}
+
+		// interest is accrued by compound interest on each denom's Interest Scalar
 		scalar := k.getInterestScalar(ctx, token.BaseDenom)
-		// calculate e^(APY*time)
-		exponential := ApproxExponential(k.DeriveBorrowAPY(ctx, token.BaseDenom).Mul(yearsElapsed))
-		// multiply interest scalar by e^(APY*time)
+		borrowAPY := k.DeriveBorrowAPY(ctx, token.BaseDenom)
+		supplyAPY := k.DeriveSupplyAPY(ctx, token.BaseDenom)
+		// calculate e^(APY*time) or (1+APY)^time, depending on compounding mode
+		exponential := compoundInterest(params.CompoundingMode, borrowAPY, yearsElapsed)
+		// multiply interest scalar by the compounding factor
 		if err := k.setInterestScalar(ctx, token.BaseDenom, scalar.Mul(exponential)); err != nil {
 			return err
 		}
 
+		// record the borrow and supply APY for trailing averages and APY series
+		if err := k.recordBorrowAPYSample(ctx, token.BaseDenom, borrowAPY, supplyAPY,
+			currentTime-prevInterestTime); err != nil {
+			return err
+		}
+
 		// apply (pre-accural) interest scalar to borrows to get total borrowed before interest accrued
 		prevTotalBorrowed := k.getAdjustedTotalBorrowed(ctx, token.BaseDenom).Mul(scalar)
 
@@ -151,7 +237,7 @@ func (k Keeper) AccrueAllInterest(ctx sdk.Context) error {
 
 	// apply all reserve increases accumulated when iterating over denoms
 	for _, coin := range newReserves {
-		if err := k.setReserves(ctx, coin.Add(k.GetReserves(ctx, coin.Denom))); err != nil {
+		if err := k.addReserves(ctx, coin); err != nil {
 			return err
 		}
 	}
@@ -161,11 +247,22 @@ func (k Keeper) AccrueAllInterest(ctx sdk.Context) error {
 		return err
 	}
 
+	// record exchange rates, total borrowed and reserves once reserves and oracle rewards have been deducted
+	for _, token := range tokens {
+		if token.Blacklist {
+			continue
+		}
+		if err := k.recordMarketSample(ctx, token.BaseDenom); err != nil {
+			return err
+		}
+	}
+
 	// set LastInterestTime
 	err := k.setLastInterestTime(ctx, currentTime)
 	if err != nil {
 		return err
 	}
+	k.setLastInterestHeight(ctx)
 
 	// Because this action is not caused by a message, logging and
 	// events are here instead of msg_server.go
@@ -184,3 +281,280 @@ func (k Keeper) AccrueAllInterest(ctx sdk.Context) error {
 	})
 	return nil
 }
+
+// compoundInterest returns the factor by which interest scalars increase when a borrow APY is applied over
+// a time period measured in years. Under continuous compounding, this is e^(APY*time). Under annual
+// compounding, this is (1+APY)^time, computed as e^(time*ln(1+APY)).
+func compoundInterest(mode types.CompoundingMode, apy, years sdk.Dec) sdk.Dec {
+	if mode == types.CompoundingMode_COMPOUNDING_MODE_ANNUAL {
+		return ApproxExponential(years.Mul(ApproxLog1p(apy)))
+	}
+	return ApproxExponential(apy.Mul(years))
+}
+
+// recordBorrowAPYSample adds a borrow and supply APY which applied to a token for a number of seconds,
+// ending at the current block time, to the token's sample for the current sampling interval. Samples
+// are kept in a ring buffer of BorrowAPYSampleCount slots, so a sample overwrites the one from the same
+// slot in an earlier cycle.
+func (k Keeper) recordBorrowAPYSample(ctx sdk.Context, denom string, apy, supplyAPY sdk.Dec, seconds int64,
+) error {
+	if seconds <= 0 {
+		return nil
+	}
+	interval := ctx.BlockTime().Unix() / types.BorrowAPYSampleSeconds
+	slot := uint64(interval % types.BorrowAPYSampleCount)
+
+	sample := k.getBorrowAPYSample(ctx, denom, slot)
+	if sample.Interval != interval {
+		sample = types.BorrowAPYSample{Interval: interval, ApySeconds: sdk.ZeroDec()}
+	}
+	if sample.SupplyApySeconds.IsNil() {
+		sample.SupplyApySeconds = sdk.ZeroDec()
+	}
+	sample.Seconds += seconds
+	sample.ApySeconds = sample.ApySeconds.Add(apy.MulInt64(seconds))
+	sample.SupplyApySeconds = sample.SupplyApySeconds.Add(supplyAPY.MulInt64(seconds))
+	return k.setBorrowAPYSample(ctx, denom, slot, sample)
+}
+
+// recordMarketSample stores a token's current uToken exchange rate, total borrowed, reserves and the
+// block time in the token's sample for the current sampling interval, if interest was recorded in that interval.
+func (k Keeper) recordMarketSample(ctx sdk.Context, denom string) error {
+	interval := ctx.BlockTime().Unix() / types.BorrowAPYSampleSeconds
+	slot := uint64(interval % types.BorrowAPYSampleCount)
+
+	sample := k.getBorrowAPYSample(ctx, denom, slot)
+	if sample.Interval != interval || sample.Seconds == 0 {
+		return nil
+	}
+	sample.ExchangeRate = k.DeriveExchangeRate(ctx, denom)
+	sample.ExchangeRateTime = ctx.BlockTime().Unix()
+	sample.TotalBorrowed = k.GetTotalBorrowed(ctx, denom).Amount
+	sample.Reserves = k.GetReserves(ctx, denom).Amount
+	return k.setBorrowAPYSample(ctx, denom, slot, sample)
+}
+
+// AverageBorrowAPY returns the time-weighted average borrow APY of a token over all sampling intervals
+// which overlap a trailing lookback window ending at the current block time, and the total number of
+// seconds sampled. The average is zero if no interest accrued on the token during the window.
+func (k Keeper) AverageBorrowAPY(ctx sdk.Context, denom string, lookback time.Duration) (sdk.Dec, int64, error) {
+	lookbackSeconds := int64(lookback / time.Second)
+	if lookbackSeconds <= 0 || lookbackSeconds > types.BorrowAPYSampleCount*types.BorrowAPYSampleSeconds {
+		return sdk.ZeroDec(), 0, types.ErrInvalidLookback.Wrapf(
+			"%s must be between 1s and %s", lookback, time.Duration(types.BorrowAPYSampleCount*types.BorrowAPYSampleSeconds)*time.Second)
+	}
+	if _, err := k.GetTokenSettings(ctx, denom); err != nil {
+		return sdk.ZeroDec(), 0, err
+	}
+
+	now := ctx.BlockTime().Unix()
+	firstInterval := (now - lookbackSeconds) / types.BorrowAPYSampleSeconds
+	lastInterval := now / types.BorrowAPYSampleSeconds
+
+	seconds, apySeconds := int64(0), sdk.ZeroDec()
+	iterator := func(_, val []byte) error {
+		var sample types.BorrowAPYSample
+		if err := k.cdc.Unmarshal(val, &sample); err != nil {
+			return err
+		}
+		if sample.Interval >= firstInterval && sample.Interval <= lastInterval {
+			seconds += sample.Seconds
+			apySeconds = apySeconds.Add(sample.ApySeconds)
+		}
+		return nil
+	}
+	if err := k.iterate(ctx, types.KeyBorrowAPYSampleNoSlot(denom), iterator); err != nil {
+		return sdk.ZeroDec(), 0, err
+	}
+
+	if seconds == 0 {
+		return sdk.ZeroDec(), 0, nil
+	}
+	return apySeconds.QuoInt64(seconds), seconds, nil
+}
+
+// ExchangeRateTrend returns a token's current uToken exchange rate, the latest rate recorded at or
+// before one lookback period ago along with the time it was recorded, and the growth between the two
+// scaled linearly to one year. If no rate was recorded that long ago, the oldest recorded rate is used
+// instead, and if none is recorded the current rate and block time are returned with zero growth.
+func (k Keeper) ExchangeRateTrend(ctx sdk.Context, denom string, lookback time.Duration,
+) (current, past sdk.Dec, pastTime time.Time, growth sdk.Dec, err error) {
+	lookbackSeconds := int64(lookback / time.Second)
+	if lookbackSeconds <= 0 || lookbackSeconds > types.BorrowAPYSampleCount*types.BorrowAPYSampleSeconds {
+		return sdk.Dec{}, sdk.Dec{}, time.Time{}, sdk.Dec{}, types.ErrInvalidLookback.Wrapf(
+			"%s must be between 1s and %s", lookback, time.Duration(types.BorrowAPYSampleCount*types.BorrowAPYSampleSeconds)*time.Second)
+	}
+	if _, err := k.GetTokenSettings(ctx, denom); err != nil {
+		return sdk.Dec{}, sdk.Dec{}, time.Time{}, sdk.Dec{}, err
+	}
+
+	now := ctx.BlockTime().Unix()
+	target := now - lookbackSeconds
+	current = k.DeriveExchangeRate(ctx, denom)
+
+	// find the latest sample at or before the target, or failing that the oldest sample
+	var before, oldest *types.BorrowAPYSample
+	iterator := func(_, val []byte) error {
+		var sample types.BorrowAPYSample
+		if err := k.cdc.Unmarshal(val, &sample); err != nil {
+			return err
+		}
+		if sample.ExchangeRate.IsNil() {
+			return nil
+		}
+		if sample.ExchangeRateTime <= target && (before == nil || sample.ExchangeRateTime > before.ExchangeRateTime) {
+			before = &sample
+		}
+		if oldest == nil || sample.ExchangeRateTime < oldest.ExchangeRateTime {
+			oldest = &sample
+		}
+		return nil
+	}
+	if err := k.iterate(ctx, types.KeyBorrowAPYSampleNoSlot(denom), iterator); err != nil {
+		return sdk.Dec{}, sdk.Dec{}, time.Time{}, sdk.Dec{}, err
+	}
+	if before == nil {
+		before = oldest
+	}
+	if before == nil || before.ExchangeRateTime >= now || !before.ExchangeRate.IsPositive() {
+		return current, current, ctx.BlockTime().UTC(), sdk.ZeroDec(), nil
+	}
+
+	elapsed := now - before.ExchangeRateTime
+	growth = current.Quo(before.ExchangeRate).Sub(sdk.OneDec()).MulInt64(types.SecondsPerYear).QuoInt64(elapsed)
+	return current, before.ExchangeRate, time.Unix(before.ExchangeRateTime, 0).UTC(), growth, nil
+}
+
+// seriesIntervals validates the start time and interval of a series over a token's stored samples,
+// returning the first and last sampling interval covered and the number of sampling intervals per point.
+// A zero since starts the series at the oldest stored sample.
+func (k Keeper) seriesIntervals(ctx sdk.Context, denom string, since time.Time, interval time.Duration,
+) (first, last, step int64, err error) {
+	sampleLength := time.Duration(types.BorrowAPYSampleSeconds) * time.Second
+	if interval <= 0 || interval%sampleLength != 0 {
+		return 0, 0, 0, types.ErrInvalidInterval.Wrapf("%s must be a positive multiple of %s", interval, sampleLength)
+	}
+	if _, err := k.GetTokenSettings(ctx, denom); err != nil {
+		return 0, 0, 0, err
+	}
+
+	last = ctx.BlockTime().Unix() / types.BorrowAPYSampleSeconds
+	oldest := last - types.BorrowAPYSampleCount + 1
+	first = oldest
+	if !since.IsZero() {
+		first = since.Unix() / types.BorrowAPYSampleSeconds
+		if first < oldest || first > last {
+			return 0, 0, 0, types.ErrInvalidLookback.Wrapf("%s must be between %s and the current block time",
+				since.UTC(), time.Unix(oldest*types.BorrowAPYSampleSeconds, 0).UTC())
+		}
+	}
+	return first, last, int64(interval / sampleLength), nil
+}
+
+// APYSeries returns the time-weighted average supply and borrow APY of a token over consecutive
+// intervals of equal length, from the start of the sampling interval containing since up to the
+// current block time. A zero since starts the series at the oldest stored sample. The interval must
+// be a whole number of sampling intervals. Intervals in which no interest accrued are omitted, and
+// samples recorded before supply APY was tracked contribute zero supply APY.
+func (k Keeper) APYSeries(ctx sdk.Context, denom string, since time.Time, interval time.Duration,
+) ([]types.APYPoint, error) {
+	firstInterval, lastInterval, step, err := k.seriesIntervals(ctx, denom, since, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	n := (lastInterval-firstInterval)/step + 1
+	seconds := make([]int64, n)
+	borrowAPYSeconds := make([]sdk.Dec, n)
+	supplyAPYSeconds := make([]sdk.Dec, n)
+	for i := range seconds {
+		borrowAPYSeconds[i] = sdk.ZeroDec()
+		supplyAPYSeconds[i] = sdk.ZeroDec()
+	}
+
+	iterator := func(_, val []byte) error {
+		var sample types.BorrowAPYSample
+		if err := k.cdc.Unmarshal(val, &sample); err != nil {
+			return err
+		}
+		if sample.Interval < firstInterval || sample.Interval > lastInterval {
+			return nil
+		}
+		i := (sample.Interval - firstInterval) / step
+		seconds[i] += sample.Seconds
+		borrowAPYSeconds[i] = borrowAPYSeconds[i].Add(sample.ApySeconds)
+		if !sample.SupplyApySeconds.IsNil() {
+			supplyAPYSeconds[i] = supplyAPYSeconds[i].Add(sample.SupplyApySeconds)
+		}
+		return nil
+	}
+	if err := k.iterate(ctx, types.KeyBorrowAPYSampleNoSlot(denom), iterator); err != nil {
+		return nil, err
+	}
+
+	points := []types.APYPoint{}
+	for i := range seconds {
+		if seconds[i] == 0 {
+			continue
+		}
+		start := (firstInterval + int64(i)*step) * types.BorrowAPYSampleSeconds
+		points = append(points, types.APYPoint{
+			Time:           time.Unix(start, 0).UTC(),
+			Supply_APY:     supplyAPYSeconds[i].QuoInt64(seconds[i]),
+			Borrow_APY:     borrowAPYSeconds[i].QuoInt64(seconds[i]),
+			SampledSeconds: seconds[i],
+		})
+	}
+	return points, nil
+}
+
+// DebtReserveRatioSeries returns a token's total borrowed amount, reserves, and their ratio as last
+// recorded within each of consecutive intervals of equal length, from the start of the sampling interval
+// containing since up to the current block time. A zero since starts the series at the oldest stored
+// sample. The interval must be a whole number of sampling intervals. Intervals in which nothing was
+// recorded are omitted, and the ratio is nil when reserves are zero.
+func (k Keeper) DebtReserveRatioSeries(ctx sdk.Context, denom string, since time.Time, interval time.Duration,
+) ([]types.DebtReserveRatioPoint, error) {
+	firstInterval, lastInterval, step, err := k.seriesIntervals(ctx, denom, since, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	latest := make([]*types.BorrowAPYSample, (lastInterval-firstInterval)/step+1)
+	iterator := func(_, val []byte) error {
+		var sample types.BorrowAPYSample
+		if err := k.cdc.Unmarshal(val, &sample); err != nil {
+			return err
+		}
+		if sample.Interval < firstInterval || sample.Interval > lastInterval ||
+			sample.TotalBorrowed.IsNil() || sample.Reserves.IsNil() {
+			return nil
+		}
+		i := (sample.Interval - firstInterval) / step
+		if latest[i] == nil || sample.ExchangeRateTime > latest[i].ExchangeRateTime {
+			latest[i] = &sample
+		}
+		return nil
+	}
+	if err := k.iterate(ctx, types.KeyBorrowAPYSampleNoSlot(denom), iterator); err != nil {
+		return nil, err
+	}
+
+	points := []types.DebtReserveRatioPoint{}
+	for _, sample := range latest {
+		if sample == nil {
+			continue
+		}
+		point := types.DebtReserveRatioPoint{
+			Time:          time.Unix(sample.ExchangeRateTime, 0).UTC(),
+			TotalBorrowed: sample.TotalBorrowed,
+			Reserves:      sample.Reserves,
+		}
+		if sample.Reserves.IsPositive() {
+			ratio := toDec(sample.TotalBorrowed).QuoInt(sample.Reserves)
+			point.Ratio = &ratio
+		}
+		points = append(points, point)
+	}
+	return points, nil
+}
@@ -1,10 +1,13 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	appparams "github.com/umee-network/umee/v5/app/params"
 	"github.com/umee-network/umee/v5/util/coin"
+	"github.com/umee-network/umee/v5/x/leverage/types"
 )
 
 func (s *IntegrationTestSuite) TestAccrueZeroInterest() {
@@ -43,6 +46,42 @@ func (s *IntegrationTestSuite) TestAccrueZeroInterest() {
 	require.Equal(sdk.MustNewDecFromStr("0.000948"), supplyAPY)
 }
 
+func (s *IntegrationTestSuite) TestUtilizationSmoothing() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+
+	// creates account which has supplied and collateralized 1000 UMEE
+	addr := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(addr, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 1000_000000))
+
+	// accrue once at zero utilization to record the smoothed utilization and starting time
+	startCtx := ctx.WithBlockTime(time.Unix(1_000_000, 0))
+	require.NoError(app.LeverageKeeper.AccrueAllInterest(startCtx))
+
+	// utilization spikes from 0% to 80%
+	s.forceBorrow(addr, coin.New(umeeDenom, 800_000000))
+
+	// without smoothing, borrow APY jumps to the kink rate immediately
+	require.Equal(sdk.MustNewDecFromStr("0.22"), app.LeverageKeeper.DeriveBorrowAPY(startCtx, umeeDenom))
+
+	// with a smoothing factor of 0.5, borrow APY remains at the base rate until interest accrues
+	params := app.LeverageKeeper.GetParams(ctx)
+	params.UtilizationSmoothingFactor = sdk.MustNewDecFromStr("0.5")
+	app.LeverageKeeper.SetParams(ctx, params)
+	require.Equal(sdk.MustNewDecFromStr("0.02"), app.LeverageKeeper.DeriveBorrowAPY(startCtx, umeeDenom))
+
+	// each accrual moves smoothed utilization halfway towards 80%: first to 40%, then to 60%
+	accrualCtx := startCtx.WithBlockTime(startCtx.BlockTime().Add(24 * time.Hour))
+	require.NoError(app.LeverageKeeper.AccrueAllInterest(accrualCtx))
+	require.Equal(sdk.MustNewDecFromStr("0.12"), app.LeverageKeeper.DeriveBorrowAPY(accrualCtx, umeeDenom))
+
+	accrualCtx = accrualCtx.WithBlockTime(accrualCtx.BlockTime().Add(24 * time.Hour))
+	require.NoError(app.LeverageKeeper.AccrueAllInterest(accrualCtx))
+	// the day of interest accrued on borrows raised current utilization slightly above 80%
+	rate := app.LeverageKeeper.DeriveBorrowAPY(accrualCtx, umeeDenom)
+	require.True(rate.Sub(sdk.MustNewDecFromStr("0.17")).Abs().LT(sdk.MustNewDecFromStr("0.0001")), rate.String())
+}
+
 func (s *IntegrationTestSuite) TestDynamicInterest() {
 	app, ctx, require := s.app, s.ctx, s.Require()
 
@@ -90,3 +129,299 @@ func (s *IntegrationTestSuite) TestDynamicInterest_InvalidAsset() {
 	rate := app.LeverageKeeper.DeriveBorrowAPY(ctx, "uabc")
 	require.Equal(sdk.ZeroDec(), rate)
 }
+
+func (s *IntegrationTestSuite) TestAverageBorrowAPY() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+
+	// creates account which has supplied and collateralized 1000 UMEE, and borrowed 40 UMEE
+	addr := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(addr, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 1000_000000))
+	s.borrow(addr, coin.New(umeeDenom, 40_000000))
+
+	// accrues interest at a given number of hours, returning the borrow APY that applied
+	accrue := func(hours time.Duration) sdk.Dec {
+		apy := app.LeverageKeeper.DeriveBorrowAPY(ctx, umeeDenom)
+		require.NoError(app.LeverageKeeper.AccrueAllInterest(ctx.WithBlockTime(time.Unix(0, 0).Add(hours))))
+		return apy
+	}
+	average := func(hours, lookback time.Duration) (sdk.Dec, int64) {
+		apy, seconds, err := app.LeverageKeeper.AverageBorrowAPY(
+			ctx.WithBlockTime(time.Unix(0, 0).Add(hours)), umeeDenom, lookback)
+		require.NoError(err)
+		return apy, seconds
+	}
+
+	// set the starting time, then accrue 30 minutes of interest in the 100th hour
+	accrue(100 * time.Hour)
+	apy1 := accrue(100*time.Hour + 30*time.Minute)
+
+	// borrow more to increase APY, then accrue an hour of interest in the 101st hour
+	s.borrow(addr, coin.New(umeeDenom, 160_000000))
+	apy2 := accrue(101*time.Hour + 30*time.Minute)
+	require.True(apy2.GT(apy1))
+
+	// a one hour lookback overlaps both sampling intervals
+	apy, seconds := average(101*time.Hour+30*time.Minute, time.Hour)
+	require.Equal(int64(5400), seconds)
+	require.Equal(apy1.MulInt64(1800).Add(apy2.MulInt64(3600)).QuoInt64(5400), apy)
+
+	// a 30 minute lookback only overlaps the current interval
+	apy, seconds = average(101*time.Hour+30*time.Minute, 30*time.Minute)
+	require.Equal(int64(3600), seconds)
+	require.Equal(apy2, apy)
+
+	// after a week, the sample for the 101st hour is overwritten by the sample for the 269th hour
+	apy3 := accrue(269*time.Hour + 30*time.Minute)
+	apy, seconds = average(269*time.Hour+30*time.Minute, 168*time.Hour)
+	require.Equal(int64(168*3600), seconds)
+	require.Equal(apy3, apy)
+
+	// invalid lookbacks and denoms
+	_, _, err := app.LeverageKeeper.AverageBorrowAPY(ctx, umeeDenom, 0)
+	require.ErrorIs(err, types.ErrInvalidLookback)
+	_, _, err = app.LeverageKeeper.AverageBorrowAPY(ctx, umeeDenom, 169*time.Hour)
+	require.ErrorIs(err, types.ErrInvalidLookback)
+	_, _, err = app.LeverageKeeper.AverageBorrowAPY(ctx, "abcd", time.Hour)
+	require.ErrorIs(err, types.ErrNotRegisteredToken)
+}
+
+func (s *IntegrationTestSuite) TestExchangeRateTrend() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+
+	// creates account which has supplied and collateralized 1000 UMEE, and borrowed 200 UMEE
+	addr := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(addr, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 1000_000000))
+	s.borrow(addr, coin.New(umeeDenom, 200_000000))
+
+	// accrues interest at a given number of hours, returning the exchange rate afterwards
+	at := func(hours time.Duration) sdk.Context {
+		return ctx.WithBlockTime(time.Unix(0, 0).Add(hours))
+	}
+	accrue := func(hours time.Duration) sdk.Dec {
+		require.NoError(app.LeverageKeeper.AccrueAllInterest(at(hours)))
+		return app.LeverageKeeper.DeriveExchangeRate(at(hours), umeeDenom)
+	}
+	trend := func(hours, lookback time.Duration, denom string) (sdk.Dec, sdk.Dec, time.Time, sdk.Dec) {
+		current, past, pastTime, growth, err := app.LeverageKeeper.ExchangeRateTrend(at(hours), denom, lookback)
+		require.NoError(err)
+		return current, past, pastTime, growth
+	}
+
+	// set the starting time, then record exchange rates after 30 minutes and 90 minutes of interest
+	accrue(100 * time.Hour)
+	rate1 := accrue(100*time.Hour + 30*time.Minute)
+	rate2 := accrue(101*time.Hour + 30*time.Minute)
+	require.True(rate2.GT(rate1))
+	now := 101*time.Hour + 30*time.Minute
+
+	// a one hour lookback finds the rate recorded exactly one hour ago
+	current, past, pastTime, growth := trend(now, time.Hour, umeeDenom)
+	require.Equal(rate2, current)
+	require.Equal(rate1, past)
+	require.Equal(time.Unix(0, 0).Add(100*time.Hour+30*time.Minute).UTC(), pastTime)
+	require.Equal(rate2.Quo(rate1).Sub(sdk.OneDec()).MulInt64(types.SecondsPerYear).QuoInt64(3600), growth)
+	require.True(growth.IsPositive())
+
+	// a 30 minute lookback uses the latest rate recorded before then, which is the same one
+	_, past, pastTime, _ = trend(now, 30*time.Minute, umeeDenom)
+	require.Equal(rate1, past)
+	require.Equal(time.Unix(0, 0).Add(100*time.Hour+30*time.Minute).UTC(), pastTime)
+
+	// a lookback beyond the oldest recorded rate uses the oldest rate
+	_, past, _, _ = trend(now, 168*time.Hour, umeeDenom)
+	require.Equal(rate1, past)
+
+	// a one second lookback skips the rate recorded in the current block, and annualizes over the
+	// full hour since the previous rate was recorded
+	_, past, _, shortGrowth := trend(now, time.Second, umeeDenom)
+	require.Equal(rate1, past)
+	require.Equal(growth, shortGrowth)
+
+	// ATOM has no borrows, so its exchange rate is flat
+	current, past, _, growth = trend(now, time.Hour, atomDenom)
+	require.Equal(sdk.OneDec(), current)
+	require.Equal(sdk.OneDec(), past)
+	require.Equal(sdk.ZeroDec(), growth)
+
+	// invalid lookbacks and denoms
+	_, _, _, _, err := app.LeverageKeeper.ExchangeRateTrend(ctx, umeeDenom, 0)
+	require.ErrorIs(err, types.ErrInvalidLookback)
+	_, _, _, _, err = app.LeverageKeeper.ExchangeRateTrend(ctx, umeeDenom, 169*time.Hour)
+	require.ErrorIs(err, types.ErrInvalidLookback)
+	_, _, _, _, err = app.LeverageKeeper.ExchangeRateTrend(ctx, "abcd", time.Hour)
+	require.ErrorIs(err, types.ErrNotRegisteredToken)
+}
+
+func (s *IntegrationTestSuite) TestDebtReserveRatioSeries() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+
+	// creates account which has supplied and collateralized 1000 UMEE, and borrowed 200 UMEE
+	addr := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(addr, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 1000_000000))
+	s.borrow(addr, coin.New(umeeDenom, 200_000000))
+
+	at := func(hours time.Duration) sdk.Context {
+		return ctx.WithBlockTime(time.Unix(0, 0).Add(hours))
+	}
+	// accrues interest at a given number of hours, returning the expected point recorded afterwards
+	accrue := func(hours time.Duration) types.DebtReserveRatioPoint {
+		require.NoError(app.LeverageKeeper.AccrueAllInterest(at(hours)))
+		borrowed := app.LeverageKeeper.GetTotalBorrowed(ctx, umeeDenom).Amount
+		reserves := app.LeverageKeeper.GetReserves(ctx, umeeDenom).Amount
+		point := types.DebtReserveRatioPoint{
+			Time:          time.Unix(0, 0).Add(hours).UTC(),
+			TotalBorrowed: borrowed,
+			Reserves:      reserves,
+		}
+		if reserves.IsPositive() {
+			ratio := sdk.NewDecFromInt(borrowed).QuoInt(reserves)
+			point.Ratio = &ratio
+		}
+		return point
+	}
+	now := at(102*time.Hour + 30*time.Minute)
+	series := func(since time.Time, interval time.Duration) []types.DebtReserveRatioPoint {
+		points, err := app.LeverageKeeper.DebtReserveRatioSeries(now, umeeDenom, since, interval)
+		require.NoError(err)
+		return points
+	}
+
+	// set the starting time, then record state twice in the 100th hour and once in the 102nd hour
+	accrue(100 * time.Hour)
+	accrue(100*time.Hour + 15*time.Minute)
+	point1 := accrue(100*time.Hour + 45*time.Minute)
+	point2 := accrue(102*time.Hour + 30*time.Minute)
+	require.True(point1.Reserves.IsPositive())
+	require.True(point2.Reserves.GT(point1.Reserves))
+
+	// hourly points use the latest state recorded in each hour, and the empty 101st hour is omitted
+	require.Equal([]types.DebtReserveRatioPoint{point1, point2}, series(time.Time{}, time.Hour))
+	require.Equal([]types.DebtReserveRatioPoint{point2}, series(time.Unix(0, 0).Add(101*time.Hour), time.Hour))
+	// a three hour interval keeps only the latest state
+	require.Equal([]types.DebtReserveRatioPoint{point2}, series(time.Unix(0, 0).Add(100*time.Hour), 3*time.Hour))
+
+	// ATOM has no reserves, so its ratio is null
+	points, err := app.LeverageKeeper.DebtReserveRatioSeries(now, atomDenom, time.Time{}, time.Hour)
+	require.NoError(err)
+	require.Len(points, 2)
+	require.True(points[0].Reserves.IsZero())
+	require.Nil(points[0].Ratio)
+
+	// invalid intervals, start times, and denoms
+	_, err = app.LeverageKeeper.DebtReserveRatioSeries(now, umeeDenom, time.Time{}, 90*time.Minute)
+	require.ErrorIs(err, types.ErrInvalidInterval)
+	_, err = app.LeverageKeeper.DebtReserveRatioSeries(now, umeeDenom, time.Unix(0, 0).Add(103*time.Hour), time.Hour)
+	require.ErrorIs(err, types.ErrInvalidLookback)
+	_, err = app.LeverageKeeper.DebtReserveRatioSeries(now, "abcd", time.Time{}, time.Hour)
+	require.ErrorIs(err, types.ErrNotRegisteredToken)
+}
+
+func (s *IntegrationTestSuite) TestAPYSeries() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+
+	// creates account which has supplied and collateralized 1000 UMEE, and borrowed 40 UMEE
+	addr := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(addr, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 1000_000000))
+	s.borrow(addr, coin.New(umeeDenom, 40_000000))
+
+	// accrues interest at a given number of hours, returning the supply and borrow APY that applied
+	accrue := func(hours time.Duration) (sdk.Dec, sdk.Dec) {
+		supplyAPY := app.LeverageKeeper.DeriveSupplyAPY(ctx, umeeDenom)
+		borrowAPY := app.LeverageKeeper.DeriveBorrowAPY(ctx, umeeDenom)
+		require.NoError(app.LeverageKeeper.AccrueAllInterest(ctx.WithBlockTime(time.Unix(0, 0).Add(hours))))
+		return supplyAPY, borrowAPY
+	}
+	now := ctx.WithBlockTime(time.Unix(0, 0).Add(101*time.Hour + 30*time.Minute))
+	series := func(since time.Time, interval time.Duration) []types.APYPoint {
+		points, err := app.LeverageKeeper.APYSeries(now, umeeDenom, since, interval)
+		require.NoError(err)
+		return points
+	}
+
+	// set the starting time, then accrue 30 minutes of interest in the 100th hour
+	accrue(100 * time.Hour)
+	supply1, borrow1 := accrue(100*time.Hour + 30*time.Minute)
+
+	// borrow more to increase APY, then accrue an hour of interest in the 101st hour
+	s.borrow(addr, coin.New(umeeDenom, 160_000000))
+	supply2, borrow2 := accrue(101*time.Hour + 30*time.Minute)
+	require.True(supply2.GT(supply1))
+	require.True(borrow2.GT(borrow1))
+
+	// hourly points starting within the 100th hour
+	hourly := []types.APYPoint{
+		{
+			Time:           time.Unix(0, 0).Add(100 * time.Hour).UTC(),
+			Supply_APY:     supply1,
+			Borrow_APY:     borrow1,
+			SampledSeconds: 1800,
+		},
+		{
+			Time:           time.Unix(0, 0).Add(101 * time.Hour).UTC(),
+			Supply_APY:     supply2,
+			Borrow_APY:     borrow2,
+			SampledSeconds: 3600,
+		},
+	}
+	require.Equal(hourly, series(time.Unix(0, 0).Add(100*time.Hour+15*time.Minute), time.Hour))
+	// a zero start uses all stored samples, and empty intervals are omitted
+	require.Equal(hourly, series(time.Time{}, time.Hour))
+
+	// a two hour interval averages both samples into one point
+	require.Equal([]types.APYPoint{{
+		Time:           time.Unix(0, 0).Add(100 * time.Hour).UTC(),
+		Supply_APY:     supply1.MulInt64(1800).Add(supply2.MulInt64(3600)).QuoInt64(5400),
+		Borrow_APY:     borrow1.MulInt64(1800).Add(borrow2.MulInt64(3600)).QuoInt64(5400),
+		SampledSeconds: 5400,
+	}}, series(time.Unix(0, 0).Add(100*time.Hour), 2*time.Hour))
+
+	// invalid intervals, start times, and denoms
+	_, err := app.LeverageKeeper.APYSeries(now, umeeDenom, time.Time{}, 0)
+	require.ErrorIs(err, types.ErrInvalidInterval)
+	_, err = app.LeverageKeeper.APYSeries(now, umeeDenom, time.Time{}, 90*time.Minute)
+	require.ErrorIs(err, types.ErrInvalidInterval)
+	_, err = app.LeverageKeeper.APYSeries(now, umeeDenom, time.Unix(0, 0).Add(102*time.Hour), time.Hour)
+	require.ErrorIs(err, types.ErrInvalidLookback)
+	_, err = app.LeverageKeeper.APYSeries(now, umeeDenom, time.Unix(0, 0).Add(-70*time.Hour), time.Hour)
+	require.ErrorIs(err, types.ErrInvalidLookback)
+	_, err = app.LeverageKeeper.APYSeries(now, "abcd", time.Time{}, time.Hour)
+	require.ErrorIs(err, types.ErrNotRegisteredToken)
+}
+
+func (s *IntegrationTestSuite) TestCompoundingModes() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+
+	// creates account which has supplied and collateralized 1000 UMEE, and borrowed 200 UMEE
+	addr := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(addr, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 1000_000000))
+	s.borrow(addr, coin.New(umeeDenom, 200_000000))
+
+	// accrues interest weekly for a year under a given compounding mode, returning the final borrow
+	accrueYear := func(mode types.CompoundingMode) sdk.Coin {
+		cacheCtx, _ := ctx.CacheContext()
+		params := app.LeverageKeeper.GetParams(cacheCtx)
+		params.CompoundingMode = mode
+		app.LeverageKeeper.SetParams(cacheCtx, params)
+
+		start := time.Unix(0, 0).Add(100 * time.Hour)
+		for week := 0; week <= 52; week++ {
+			weekCtx := cacheCtx.WithBlockTime(start.Add(time.Duration(week) * 7 * 24 * time.Hour))
+			require.NoError(app.LeverageKeeper.AccrueAllInterest(weekCtx))
+		}
+		return app.LeverageKeeper.GetBorrow(cacheCtx, addr, umeeDenom)
+	}
+
+	continuous := accrueYear(types.CompoundingMode_COMPOUNDING_MODE_CONTINUOUS)
+	annual := accrueYear(types.CompoundingMode_COMPOUNDING_MODE_ANNUAL)
+
+	// both modes accrue interest, but continuous mode compounds APY as a continuously
+	// compounded rate, so it accrues more than annual mode over a long horizon
+	original := coin.New(umeeDenom, 200_000000)
+	require.True(annual.Amount.GT(original.Amount), annual)
+	require.True(continuous.Amount.GT(annual.Amount), "continuous %s, annual %s", continuous, annual)
+}
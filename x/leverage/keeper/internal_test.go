@@ -21,6 +21,7 @@ type TestKeeper struct {
 func NewTestKeeper(
 	cdc codec.Codec,
 	storeKey storetypes.StoreKey,
+	tStoreKey storetypes.StoreKey,
 	paramSpace paramtypes.Subspace,
 	bk types.BankKeeper,
 	ok types.OracleKeeper,
@@ -29,6 +30,7 @@ func NewTestKeeper(
 	k := NewKeeper(
 		cdc,
 		storeKey,
+		tStoreKey,
 		paramSpace,
 		bk,
 		ok,
@@ -56,3 +58,11 @@ func (tk *TestKeeper) SetInterestScalar(ctx sdk.Context, denom string, scalar sd
 func (tk *TestKeeper) SetReserveAmount(ctx sdk.Context, coin sdk.Coin) error {
 	return tk.Keeper.setReserves(ctx, coin)
 }
+
+func (tk *TestKeeper) ComputeExchangeRate(ctx sdk.Context, denom string) sdk.Dec {
+	return tk.Keeper.computeExchangeRate(ctx, denom)
+}
+
+func (tk *TestKeeper) CheckLiquidationWatchlistLimit(ctx sdk.Context, limit int) {
+	tk.Keeper.checkLiquidationWatchlist(ctx, limit)
+}
@@ -1,10 +1,19 @@
 package keeper
 
 import (
+	"bytes"
+	"container/heap"
+	"sort"
+
 	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/umee-network/umee/v5/util"
+	"github.com/umee-network/umee/v5/util/sdkutil"
 	"github.com/umee-network/umee/v5/util/store"
 	"github.com/umee-network/umee/v5/x/leverage/types"
 )
@@ -16,7 +25,8 @@ func (k Keeper) iterate(ctx sdk.Context, prefix []byte, cb func(key, val []byte)
 	return store.Iterate(ctx.KVStore(k.storeKey), prefix, cb)
 }
 
-// getAllBadDebts gets bad debt instances across all borrowers.
+// getAllBadDebts gets bad debt instances across all borrowers, ordered by
+// borrower address bytes and then by denom.
 func (k Keeper) getAllBadDebts(ctx sdk.Context) []types.BadDebt {
 	prefix := types.KeyPrefixBadDebt
 	badDebts := []types.BadDebt{}
@@ -34,7 +44,7 @@ func (k Keeper) getAllBadDebts(ctx sdk.Context) []types.BadDebt {
 }
 
 // GetAllRegisteredTokens returns all the registered tokens from the x/leverage
-// module's KVStore.
+// module's KVStore, sorted by base denom.
 func (k Keeper) GetAllRegisteredTokens(ctx sdk.Context) []types.Token {
 	return store.MustLoadAll[*types.Token](ctx.KVStore(k.storeKey), types.KeyPrefixRegisteredToken)
 }
@@ -61,6 +71,31 @@ func (k Keeper) GetAllReserves(ctx sdk.Context) sdk.Coins {
 	return reserves
 }
 
+// GetReserveState returns the reserves and total bad debt of every token denom which has either,
+// sorted by denom. Bad debt is the current amount owed, including interest, by each borrow marked as
+// bad debt. The reserve and bad debt stores are each iterated once.
+func (k Keeper) GetReserveState(ctx sdk.Context) []types.ReserveState {
+	reserves := k.GetAllReserves(ctx)
+	badDebt := sdk.NewCoins()
+	for _, bd := range k.getAllBadDebts(ctx) {
+		addr := sdk.MustAccAddressFromBech32(bd.Address)
+		badDebt = badDebt.Add(k.GetBorrow(ctx, addr, bd.Denom))
+	}
+
+	states := []types.ReserveState{}
+	for _, c := range reserves.Add(badDebt...) {
+		r := sdk.NewCoin(c.Denom, reserves.AmountOf(c.Denom))
+		b := sdk.NewCoin(c.Denom, badDebt.AmountOf(c.Denom))
+		states = append(states, types.ReserveState{
+			Denom:       c.Denom,
+			Reserves:    r,
+			BadDebt:     b,
+			NetCoverage: toDec(r.Amount.Sub(b.Amount)),
+		})
+	}
+	return states
+}
+
 // GetBorrowerBorrows returns an sdk.Coins object containing all open borrows
 // associated with an address.
 func (k Keeper) GetBorrowerBorrows(ctx sdk.Context, borrowerAddr sdk.AccAddress) sdk.Coins {
@@ -108,11 +143,19 @@ func (k Keeper) GetBorrowerCollateral(ctx sdk.Context, borrowerAddr sdk.AccAddre
 	return totalCollateral
 }
 
-// GetEligibleLiquidationTargets returns a list of borrower addresses eligible for liquidation.
-func (k Keeper) GetEligibleLiquidationTargets(ctx sdk.Context) ([]sdk.AccAddress, error) {
+// GetEligibleLiquidationTargets returns a list of borrower addresses eligible for liquidation,
+// ordered by borrower address bytes. The order does not depend on the checkedAddrs map,
+// which is only used to skip borrowers with multiple borrowed denoms. If rewardDenom is not empty,
+// borrowers without collateral in that base token or uToken denom are skipped before their
+// eligibility is computed.
+func (k Keeper) GetEligibleLiquidationTargets(ctx sdk.Context, rewardDenom string) ([]sdk.AccAddress, error) {
 	prefix := types.KeyPrefixAdjustedBorrow
 	liquidationTargets := []sdk.AccAddress{}
 	checkedAddrs := map[string]struct{}{}
+	rewardUDenom := rewardDenom
+	if rewardDenom != "" && !types.HasUTokenPrefix(rewardDenom) {
+		rewardUDenom = types.ToUTokenDenom(rewardDenom)
+	}
 
 	iterator := func(key, _ []byte) error {
 		borrowerAddr := types.AddressFromKey(key, prefix)
@@ -123,6 +166,11 @@ func (k Keeper) GetEligibleLiquidationTargets(ctx sdk.Context) ([]sdk.AccAddress
 		}
 		checkedAddrs[borrowerAddr.String()] = struct{}{}
 
+		// skip borrowers which cannot pay the requested reward denom
+		if rewardUDenom != "" && k.GetCollateral(ctx, borrowerAddr, rewardUDenom).IsZero() {
+			return nil
+		}
+
 		// get borrower's total borrowed
 		borrowed := k.GetBorrowerBorrows(ctx, borrowerAddr)
 
@@ -160,6 +208,409 @@ func (k Keeper) GetEligibleLiquidationTargets(ctx sdk.Context) ([]sdk.AccAddress
 	return liquidationTargets, nil
 }
 
+// GetDebtByCollateral attributes the spot value of every borrower's debt to that borrower's largest-value
+// collateral denom, returning the total attributed to each collateral uToken denom sorted by denom.
+// Debt of borrowers with no collateral of known price is returned as unattributed value. Borrowed
+// and collateral assets missing prices are skipped. This iterates over all open borrows in the module,
+// so its cost is proportional to the number of borrow positions.
+func (k Keeper) GetDebtByCollateral(ctx sdk.Context) ([]types.CollateralDebt, sdk.Dec, error) {
+	prefix := types.KeyPrefixAdjustedBorrow
+	debts := map[string]*types.CollateralDebt{}
+	unattributed := sdk.ZeroDec()
+	checkedAddrs := map[string]struct{}{}
+
+	iterator := func(key, _ []byte) error {
+		borrowerAddr := types.AddressFromKey(key, prefix)
+
+		// borrowers with multiple borrowed denoms are only counted once
+		if _, ok := checkedAddrs[borrowerAddr.String()]; ok {
+			return nil
+		}
+		checkedAddrs[borrowerAddr.String()] = struct{}{}
+
+		borrowValue, err := k.VisibleTokenValue(ctx, k.GetBorrowerBorrows(ctx, borrowerAddr), types.PriceModeSpot)
+		if err != nil {
+			return err
+		}
+
+		// find the collateral denom with the largest value, preferring the first in denom order on ties
+		dominant := ""
+		dominantValue := sdk.ZeroDec()
+		for _, c := range k.GetBorrowerCollateral(ctx, borrowerAddr) {
+			v, err := k.VisibleCollateralValue(ctx, sdk.NewCoins(c))
+			if err != nil {
+				return err
+			}
+			if v.GT(dominantValue) {
+				dominant = c.Denom
+				dominantValue = v
+			}
+		}
+
+		if dominant == "" {
+			unattributed = unattributed.Add(borrowValue)
+			return nil
+		}
+		if _, ok := debts[dominant]; !ok {
+			debts[dominant] = &types.CollateralDebt{Denom: dominant, BorrowedValue: sdk.ZeroDec()}
+		}
+		debts[dominant].BorrowedValue = debts[dominant].BorrowedValue.Add(borrowValue)
+		debts[dominant].Borrowers++
+		return nil
+	}
+
+	if err := k.iterate(ctx, prefix, iterator); err != nil {
+		return nil, sdk.ZeroDec(), err
+	}
+
+	result := make([]types.CollateralDebt, 0, len(debts))
+	for _, d := range debts {
+		result = append(result, *d)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Denom < result[j].Denom
+	})
+	return result, unattributed, nil
+}
+
+// GetAggregateBorrowUtilization sums the borrowed value and borrow limit of every account with collateral
+// or borrows, computed the same way as the BorrowUtilization query, and returns total borrowed value divided
+// by total borrow limit along with both sums and the number of accounts. The utilization is zero when the
+// total borrow limit is zero, and is not capped at 1. Assets missing prices are skipped. This iterates over
+// all collateral and borrow positions in the module, so its cost is proportional to the number of positions.
+func (k Keeper) GetAggregateBorrowUtilization(ctx sdk.Context) (utilization, borrowedValue, borrowLimit sdk.Dec,
+	accounts uint64, err error,
+) {
+	borrowedValue, borrowLimit = sdk.ZeroDec(), sdk.ZeroDec()
+	checkedAddrs := map[string]struct{}{}
+
+	iteratorFor := func(prefix []byte) func(key, _ []byte) error {
+		return func(key, _ []byte) error {
+			addr := types.AddressFromKey(key, prefix)
+
+			// accounts with multiple positions are only counted once
+			if _, ok := checkedAddrs[addr.String()]; ok {
+				return nil
+			}
+			checkedAddrs[addr.String()] = struct{}{}
+
+			_, borrowed, limit, _, err := k.BorrowUtilization(ctx, addr)
+			if err != nil {
+				return err
+			}
+			borrowedValue = borrowedValue.Add(borrowed)
+			borrowLimit = borrowLimit.Add(limit)
+			accounts++
+			return nil
+		}
+	}
+
+	for _, prefix := range [][]byte{types.KeyPrefixCollateralAmount, types.KeyPrefixAdjustedBorrow} {
+		if err := k.iterate(ctx, prefix, iteratorFor(prefix)); err != nil {
+			return sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), 0, err
+		}
+	}
+
+	utilization = sdk.ZeroDec()
+	if borrowLimit.IsPositive() {
+		utilization = borrowedValue.Quo(borrowLimit)
+	}
+	return utilization, borrowedValue, borrowLimit, accounts, nil
+}
+
+// GetCollateralComposition sums the collateral of every account for each collateral uToken denom,
+// returning the spot value of each denom and its portion of the total collateral value, sorted by denom.
+// Collateral missing prices is valued at zero and excluded from the total. This iterates over all
+// collateral positions in the module, so its cost is proportional to the number of positions.
+func (k Keeper) GetCollateralComposition(ctx sdk.Context) ([]types.CollateralShare, sdk.Dec, error) {
+	prefix := types.KeyPrefixCollateralAmount
+	totals := sdk.NewCoins()
+
+	iterator := func(key, val []byte) error {
+		denom := types.DenomFromKeyWithAddress(key, prefix)
+
+		var amount sdkmath.Int
+		if err := amount.Unmarshal(val); err != nil {
+			// improperly marshaled collateral amount should never happen
+			return err
+		}
+
+		totals = totals.Add(sdk.NewCoin(denom, amount))
+		return nil
+	}
+
+	if err := k.iterate(ctx, prefix, iterator); err != nil {
+		return nil, sdk.ZeroDec(), err
+	}
+
+	// sdk.Coins are already sorted by denom
+	composition := make([]types.CollateralShare, 0, len(totals))
+	totalValue := sdk.ZeroDec()
+	for _, c := range totals {
+		v, err := k.VisibleCollateralValue(ctx, sdk.NewCoins(c))
+		if err != nil {
+			return nil, sdk.ZeroDec(), err
+		}
+		composition = append(composition, types.CollateralShare{Collateral: c, Value: v, Share: sdk.ZeroDec()})
+		totalValue = totalValue.Add(v)
+	}
+	if totalValue.IsPositive() {
+		for i := range composition {
+			composition[i].Share = composition[i].Value.Quo(totalValue)
+		}
+	}
+	return composition, totalValue, nil
+}
+
+// GetTopBorrowers returns up to limit borrowers with the largest borrowed value using spot prices, sorted
+// by borrowed value in descending order and then by address. Borrowed assets missing prices are skipped,
+// and borrowers with no borrowed value are omitted. This iterates over all open borrows in the module,
+// so its cost is proportional to the number of borrow positions, while only limit borrowers are kept
+// in memory at a time.
+func (k Keeper) GetTopBorrowers(ctx sdk.Context, limit int) ([]types.TopBorrower, error) {
+	prefix := types.KeyPrefixAdjustedBorrow
+	// among equal borrowed values, the later address is considered smaller
+	top := &topHeap[types.TopBorrower]{less: func(a, b types.TopBorrower) bool {
+		if a.BorrowedValue.Equal(b.BorrowedValue) {
+			return a.Address > b.Address
+		}
+		return a.BorrowedValue.LT(b.BorrowedValue)
+	}}
+	checkedAddrs := map[string]struct{}{}
+
+	iterator := func(key, _ []byte) error {
+		borrowerAddr := types.AddressFromKey(key, prefix)
+
+		// borrowers with multiple borrowed denoms are only counted once
+		if _, ok := checkedAddrs[borrowerAddr.String()]; ok {
+			return nil
+		}
+		checkedAddrs[borrowerAddr.String()] = struct{}{}
+
+		borrowValue, err := k.VisibleTokenValue(ctx, k.GetBorrowerBorrows(ctx, borrowerAddr), types.PriceModeSpot)
+		if err != nil {
+			return err
+		}
+		if !borrowValue.IsPositive() {
+			return nil
+		}
+
+		b := types.TopBorrower{Address: borrowerAddr.String(), BorrowedValue: borrowValue}
+		if top.Len() == limit {
+			if !top.less(top.items[0], b) {
+				// smaller than every borrower already kept
+				return nil
+			}
+			heap.Pop(top)
+		}
+
+		// health factor is only computed for borrowers which are kept
+		b.HealthFactor = types.UnknownHealthFactor
+		liquidationLimit, err := k.CalculateLiquidationThreshold(ctx, k.GetBorrowerCollateral(ctx, borrowerAddr))
+		if nonOracleError(err) {
+			return err
+		}
+		if err == nil {
+			b.HealthFactor = liquidationLimit.Quo(borrowValue)
+		}
+		heap.Push(top, b)
+		return nil
+	}
+
+	if err := k.iterate(ctx, prefix, iterator); err != nil {
+		return nil, err
+	}
+
+	return top.sorted(), nil
+}
+
+// GetHealthDistribution counts borrowers, and sums their borrowed value using spot prices, in buckets of
+// health factor separated by the given bounds, which must be strictly increasing. The returned buckets
+// are in increasing order of health factor, with one more bucket than there are bounds. Borrowers whose
+// liquidation threshold cannot be computed due to missing collateral prices are counted separately.
+// Borrowed assets missing prices are skipped, and borrowers with no borrowed value are omitted. This
+// iterates over all open borrows in the module, so its cost is proportional to the number of borrow positions.
+func (k Keeper) GetHealthDistribution(ctx sdk.Context, bounds []sdk.Dec) (
+	buckets []types.HealthBucket, unknownBorrowers uint64, unknownValue sdk.Dec, err error,
+) {
+	buckets = make([]types.HealthBucket, len(bounds)+1)
+	for i := range buckets {
+		buckets[i].BorrowedValue = sdk.ZeroDec()
+		if i > 0 {
+			buckets[i].MinHealthFactor = &bounds[i-1]
+		}
+		if i < len(bounds) {
+			buckets[i].MaxHealthFactor = &bounds[i]
+		}
+	}
+	unknownValue = sdk.ZeroDec()
+
+	prefix := types.KeyPrefixAdjustedBorrow
+	checkedAddrs := map[string]struct{}{}
+
+	iterator := func(key, _ []byte) error {
+		borrowerAddr := types.AddressFromKey(key, prefix)
+
+		// borrowers with multiple borrowed denoms are only counted once
+		if _, ok := checkedAddrs[borrowerAddr.String()]; ok {
+			return nil
+		}
+		checkedAddrs[borrowerAddr.String()] = struct{}{}
+
+		borrowValue, err := k.VisibleTokenValue(ctx, k.GetBorrowerBorrows(ctx, borrowerAddr), types.PriceModeSpot)
+		if err != nil {
+			return err
+		}
+		if !borrowValue.IsPositive() {
+			return nil
+		}
+
+		liquidationLimit, err := k.CalculateLiquidationThreshold(ctx, k.GetBorrowerCollateral(ctx, borrowerAddr))
+		if nonOracleError(err) {
+			return err
+		}
+		if err != nil {
+			unknownBorrowers++
+			unknownValue = unknownValue.Add(borrowValue)
+			return nil
+		}
+
+		// the bucket is the number of bounds at or below the health factor
+		healthFactor := liquidationLimit.Quo(borrowValue)
+		i := sort.Search(len(bounds), func(i int) bool { return bounds[i].GT(healthFactor) })
+		buckets[i].Borrowers++
+		buckets[i].BorrowedValue = buckets[i].BorrowedValue.Add(borrowValue)
+		return nil
+	}
+
+	if err := k.iterate(ctx, prefix, iterator); err != nil {
+		return nil, 0, sdk.Dec{}, err
+	}
+
+	return buckets, unknownBorrowers, unknownValue, nil
+}
+
+// GetTopSuppliers returns up to limit suppliers with the largest supplied value using spot prices, sorted by
+// supplied value in descending order and then by address. Supplied value includes both uTokens held in
+// wallets and collateral. If denom is not empty, only tokens of that base denom are counted. Assets missing
+// prices are skipped, and suppliers with no supplied value are omitted. Wallet balances are found using the
+// bank module's index of owners of each uToken denom, so the cost of this query is proportional to the
+// number of uToken holders and collateral entries rather than all balances on the chain.
+func (k Keeper) GetTopSuppliers(ctx sdk.Context, denom string, limit int) ([]types.TopSupplier, error) {
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName).String()
+	counted := func(uDenom string) bool {
+		if denom != "" {
+			return uDenom == types.ToUTokenDenom(denom)
+		}
+		return types.HasUTokenPrefix(uDenom)
+	}
+	uDenoms := []string{}
+	if denom != "" {
+		uDenoms = append(uDenoms, types.ToUTokenDenom(denom))
+	} else {
+		for _, t := range k.GetAllRegisteredTokens(ctx) {
+			uDenoms = append(uDenoms, types.ToUTokenDenom(t.BaseDenom))
+		}
+	}
+
+	// collect the uTokens of each supplier, from wallets and then collateral
+	uTokens := map[string]sdk.Coins{}
+	for _, uDenom := range uDenoms {
+		req := &banktypes.QueryDenomOwnersRequest{Denom: uDenom, Pagination: &query.PageRequest{}}
+		for {
+			resp, err := k.bankKeeper.DenomOwners(sdk.WrapSDKContext(ctx), req)
+			if err != nil {
+				return nil, err
+			}
+			for _, owner := range resp.DenomOwners {
+				// collateral uTokens are held by the module account, and are counted below
+				if owner.Address != moduleAddr {
+					uTokens[owner.Address] = uTokens[owner.Address].Add(owner.Balance)
+				}
+			}
+			if resp.Pagination == nil || len(resp.Pagination.NextKey) == 0 {
+				break
+			}
+			req.Pagination = &query.PageRequest{Key: resp.Pagination.NextKey}
+		}
+	}
+	prefix := types.KeyPrefixCollateralAmount
+	iterator := func(key, val []byte) error {
+		uDenom := types.DenomFromKeyWithAddress(key, prefix)
+		if !counted(uDenom) {
+			return nil
+		}
+		var amount sdkmath.Int
+		if err := amount.Unmarshal(val); err != nil {
+			// improperly marshaled amount should never happen
+			return err
+		}
+		addr := types.AddressFromKey(key, prefix).String()
+		uTokens[addr] = uTokens[addr].Add(sdk.NewCoin(uDenom, amount))
+		return nil
+	}
+	if err := k.iterate(ctx, prefix, iterator); err != nil {
+		return nil, err
+	}
+
+	// among equal supplied values, the later address is considered smaller
+	top := &topHeap[types.TopSupplier]{less: func(a, b types.TopSupplier) bool {
+		if a.SuppliedValue.Equal(b.SuppliedValue) {
+			return a.Address > b.Address
+		}
+		return a.SuppliedValue.LT(b.SuppliedValue)
+	}}
+	for addr, u := range uTokens {
+		supplied, err := k.ExchangeUTokens(ctx, u)
+		if err != nil {
+			return nil, err
+		}
+		value, err := k.VisibleTokenValue(ctx, supplied, types.PriceModeSpot)
+		if err != nil {
+			return nil, err
+		}
+		if !value.IsPositive() {
+			continue
+		}
+		s := types.TopSupplier{Address: addr, SuppliedValue: value, Supplied: supplied}
+		if top.Len() < limit {
+			heap.Push(top, s)
+		} else if top.less(top.items[0], s) {
+			heap.Pop(top)
+			heap.Push(top, s)
+		}
+	}
+
+	return top.sorted(), nil
+}
+
+// topHeap is a min-heap used to keep the largest items seen during iteration, ordered by a less function.
+type topHeap[T any] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+func (h topHeap[T]) Len() int           { return len(h.items) }
+func (h topHeap[T]) Less(i, j int) bool { return h.less(h.items[i], h.items[j]) }
+func (h topHeap[T]) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *topHeap[T]) Push(x any)        { h.items = append(h.items, x.(T)) }
+
+func (h *topHeap[T]) Pop() any {
+	n := len(h.items)
+	x := h.items[n-1]
+	h.items = h.items[:n-1]
+	return x
+}
+
+// sorted empties the heap, returning its items from largest to smallest.
+func (h *topHeap[T]) sorted() []T {
+	result := make([]T, h.Len())
+	for i := len(result) - 1; i >= 0; i-- {
+		result[i] = heap.Pop(h).(T)
+	}
+	return result
+}
+
 // SweepBadDebts attempts to repay all bad debts in the system.
 func (k Keeper) SweepBadDebts(ctx sdk.Context) error {
 	prefix := types.KeyPrefixBadDebt
@@ -195,6 +646,107 @@ func (k Keeper) SweepBadDebts(ctx sdk.Context) error {
 	return k.iterate(ctx, prefix, iterator)
 }
 
+// liquidationWatchRatio is the fraction of an account's liquidation threshold which its borrowed value
+// must reach for the account to stay on the liquidation watchlist without changing its position.
+var liquidationWatchRatio = sdk.MustNewDecFromStr("0.9")
+
+// maxLiquidationWatchChecks is the maximum number of liquidation watchlist accounts checked per block.
+const maxLiquidationWatchChecks = 100
+
+// CheckLiquidationWatchlist checks accounts on the liquidation watchlist, emitting an
+// EventBecameLiquidatable for each one which has become eligible for liquidation since it was last
+// checked. Accounts are added to the watchlist whenever their borrows or collateral change, and are
+// removed once they have no borrows or their borrowed value is below liquidationWatchRatio times their
+// liquidation threshold, so only recently changed or near-threshold accounts are watched. At most
+// maxLiquidationWatchChecks accounts are checked per block, resuming after the last account checked
+// in the previous block. Accounts which cannot be checked are logged and skipped. Called by EndBlock
+// after interest accrues.
+func (k Keeper) CheckLiquidationWatchlist(ctx sdk.Context) {
+	k.checkLiquidationWatchlist(ctx, maxLiquidationWatchChecks)
+}
+
+// checkLiquidationWatchlist checks up to limit accounts on the liquidation watchlist, starting after
+// the liquidation watch cursor and wrapping around to the start of the watchlist. The cursor is then
+// moved to the last account checked.
+func (k Keeper) checkLiquidationWatchlist(ctx sdk.Context, limit int) {
+	kvs := ctx.KVStore(k.storeKey)
+	watchStore := prefix.NewStore(kvs, types.KeyPrefixLiquidationWatch)
+	cursor := kvs.Get(types.KeyLiquidationWatchCursor)
+
+	// the watchlist is collected first, since checking accounts modifies it
+	keys := [][]byte{}
+	wasLiquidatable := []bool{}
+	collect := func(start, end []byte) {
+		iter := watchStore.Iterator(start, end)
+		defer iter.Close()
+		for ; iter.Valid() && len(keys) < limit; iter.Next() {
+			keys = append(keys, append([]byte{}, iter.Key()...))
+			wasLiquidatable = append(wasLiquidatable, iter.Value()[0] == 0x01)
+		}
+	}
+	var next []byte
+	if cursor != nil {
+		next = append(append([]byte{}, cursor...), 0x00)
+	}
+	collect(next, nil)
+	if next != nil {
+		collect(nil, next)
+	}
+
+	for i, key := range keys {
+		addr := types.AddressFromKey(util.ConcatBytes(0, types.KeyPrefixLiquidationWatch, key),
+			types.KeyPrefixLiquidationWatch)
+		if err := k.checkLiquidationWatch(ctx, addr, wasLiquidatable[i]); err != nil {
+			k.Logger(ctx).Error("failed to check liquidation watchlist account", "address", addr.String(),
+				"error", err.Error())
+		}
+	}
+
+	switch {
+	case len(keys) == 0:
+		if cursor != nil {
+			kvs.Delete(types.KeyLiquidationWatchCursor)
+		}
+	case !bytes.Equal(cursor, keys[len(keys)-1]):
+		kvs.Set(types.KeyLiquidationWatchCursor, keys[len(keys)-1])
+	}
+}
+
+// checkLiquidationWatch checks a single account on the liquidation watchlist, emitting an
+// EventBecameLiquidatable if it is eligible for liquidation but was not when last checked.
+func (k Keeper) checkLiquidationWatch(ctx sdk.Context, addr sdk.AccAddress, wasLiquidatable bool) error {
+	borrowed := k.GetBorrowerBorrows(ctx, addr)
+	if borrowed.IsZero() {
+		k.unwatchLiquidation(ctx, addr)
+		return nil
+	}
+	borrowedValue, err := k.TotalTokenValue(ctx, borrowed, types.PriceModeSpot)
+	if err != nil {
+		return err
+	}
+	liquidationThreshold, err := k.CalculateLiquidationThreshold(ctx, k.GetBorrowerCollateral(ctx, addr))
+	if err != nil {
+		return err
+	}
+
+	liquidatable := borrowedValue.GT(liquidationThreshold)
+	switch {
+	case liquidatable && !wasLiquidatable:
+		sdkutil.Emit(&ctx, &types.EventBecameLiquidatable{
+			Address:   addr.String(),
+			Shortfall: borrowedValue.Sub(liquidationThreshold),
+		})
+		k.setLiquidationWatch(ctx, addr, true)
+	case liquidatable:
+		// already reported, so it stays on the watchlist without another event
+	case borrowedValue.LT(liquidationThreshold.Mul(liquidationWatchRatio)):
+		k.unwatchLiquidation(ctx, addr)
+	default:
+		k.setLiquidationWatch(ctx, addr, false)
+	}
+	return nil
+}
+
 // GetAllUTokenSupply returns total supply of all uToken denoms.
 func (k Keeper) GetAllUTokenSupply(ctx sdk.Context) sdk.Coins {
 	prefix := types.KeyPrefixUtokenSupply
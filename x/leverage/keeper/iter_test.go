@@ -1,8 +1,13 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
 	"github.com/umee-network/umee/v5/util/coin"
+	"github.com/umee-network/umee/v5/x/leverage/types"
 )
 
 func (s *IntegrationTestSuite) TestGetEligibleLiquidationTargets_OneAddrOneAsset() {
@@ -16,7 +21,7 @@ func (s *IntegrationTestSuite) TestGetEligibleLiquidationTargets_OneAddrOneAsset
 	// user borrows 250 umee (max current allowed)
 	s.borrow(addr, coin.New(umeeDenom, 250))
 
-	zeroAddresses, err := app.LeverageKeeper.GetEligibleLiquidationTargets(ctx)
+	zeroAddresses, err := app.LeverageKeeper.GetEligibleLiquidationTargets(ctx, "")
 	require.NoError(err)
 	require.Equal([]sdk.AccAddress{}, zeroAddresses)
 
@@ -27,7 +32,7 @@ func (s *IntegrationTestSuite) TestGetEligibleLiquidationTargets_OneAddrOneAsset
 
 	require.NoError(app.LeverageKeeper.SetTokenSettings(ctx, umeeToken))
 
-	targetAddress, err := app.LeverageKeeper.GetEligibleLiquidationTargets(ctx)
+	targetAddress, err := app.LeverageKeeper.GetEligibleLiquidationTargets(ctx, "")
 	require.NoError(err)
 	require.Equal([]sdk.AccAddress{addr}, targetAddress)
 }
@@ -43,7 +48,7 @@ func (s *IntegrationTestSuite) TestGetEligibleLiquidationTargets_OneAddrTwoAsset
 	// user borrows 250 umee (max current allowed)
 	s.borrow(addr, coin.New(umeeDenom, 250))
 
-	zeroAddresses, err := app.LeverageKeeper.GetEligibleLiquidationTargets(ctx)
+	zeroAddresses, err := app.LeverageKeeper.GetEligibleLiquidationTargets(ctx, "")
 	require.NoError(err)
 	require.Equal([]sdk.AccAddress{}, zeroAddresses)
 
@@ -72,7 +77,7 @@ func (s *IntegrationTestSuite) TestGetEligibleLiquidationTargets_OneAddrTwoAsset
 
 	require.NoError(app.LeverageKeeper.SetTokenSettings(s.ctx, atomIBCToken))
 
-	targetAddr, err := app.LeverageKeeper.GetEligibleLiquidationTargets(ctx)
+	targetAddr, err := app.LeverageKeeper.GetEligibleLiquidationTargets(ctx, "")
 	require.NoError(err)
 	require.Equal([]sdk.AccAddress{addr}, targetAddr)
 }
@@ -88,7 +93,7 @@ func (s *IntegrationTestSuite) TestGetEligibleLiquidationTargets_TwoAddr() {
 	// user borrows 250 umee (max current allowed)
 	s.borrow(addr, coin.New(umeeDenom, 250))
 
-	zeroAddresses, err := app.LeverageKeeper.GetEligibleLiquidationTargets(ctx)
+	zeroAddresses, err := app.LeverageKeeper.GetEligibleLiquidationTargets(ctx, "")
 	require.NoError(err)
 	require.Equal([]sdk.AccAddress{}, zeroAddresses)
 
@@ -114,7 +119,188 @@ func (s *IntegrationTestSuite) TestGetEligibleLiquidationTargets_TwoAddr() {
 
 	require.NoError(app.LeverageKeeper.SetTokenSettings(s.ctx, atomIBCToken))
 
-	targets, err := app.LeverageKeeper.GetEligibleLiquidationTargets(ctx)
+	targets, err := app.LeverageKeeper.GetEligibleLiquidationTargets(ctx, "")
 	require.NoError(err)
 	require.Equal([]sdk.AccAddress{addr, addr2}, targets)
+
+	// a reward denom filter returns only borrowers holding that collateral, by base or uToken denom
+	targets, err = app.LeverageKeeper.GetEligibleLiquidationTargets(ctx, atomDenom)
+	require.NoError(err)
+	require.Equal([]sdk.AccAddress{addr2}, targets)
+	targets, err = app.LeverageKeeper.GetEligibleLiquidationTargets(ctx, "u/"+umeeDenom)
+	require.NoError(err)
+	require.Equal([]sdk.AccAddress{addr}, targets)
+	targets, err = app.LeverageKeeper.GetEligibleLiquidationTargets(ctx, daiDenom)
+	require.NoError(err)
+	require.Equal([]sdk.AccAddress{}, targets)
+}
+
+func (s *IntegrationTestSuite) TestCheckLiquidationWatchlist() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+
+	// creates account which has supplied and collateralized 1000 UMEE, and borrowed 250 UMEE (its borrow limit)
+	addr := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(addr, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(addr, coin.New("u/"+umeeDenom, 1000_000000))
+	s.borrow(addr, coin.New(umeeDenom, 250_000000))
+
+	// Note: Setting umee liquidation threshold equal to its collateral weight, so the borrower
+	// is exactly at their liquidation threshold and any interest makes them eligible for liquidation
+	umeeToken := newToken("uumee", "UMEE", 6)
+	umeeToken.LiquidationThreshold = umeeToken.CollateralWeight
+	require.NoError(app.LeverageKeeper.SetTokenSettings(ctx, umeeToken))
+
+	// returns the EventBecameLiquidatable events emitted for addr by a watchlist check
+	check := func(ctx sdk.Context) []*types.EventBecameLiquidatable {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		app.LeverageKeeper.CheckLiquidationWatchlist(ctx)
+		events := []*types.EventBecameLiquidatable{}
+		for _, e := range ctx.EventManager().Events() {
+			msg, err := sdk.ParseTypedEvent(abci.Event(e))
+			require.NoError(err)
+			if event, ok := msg.(*types.EventBecameLiquidatable); ok && event.Address == addr.String() {
+				events = append(events, event)
+			}
+		}
+		return events
+	}
+
+	// borrower is healthy before interest accrues
+	require.Empty(check(ctx))
+
+	// interest accrues for a day, pushing the borrower over their liquidation threshold
+	startCtx := ctx.WithBlockTime(time.Unix(1_000_000, 0))
+	require.NoError(app.LeverageKeeper.AccrueAllInterest(startCtx))
+	accrualCtx := startCtx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(startCtx.BlockTime().Add(24 * time.Hour))
+	require.NoError(app.LeverageKeeper.AccrueAllInterest(accrualCtx))
+
+	borrowedValue, err := app.LeverageKeeper.TotalTokenValue(
+		accrualCtx, app.LeverageKeeper.GetBorrowerBorrows(accrualCtx, addr), types.PriceModeSpot,
+	)
+	require.NoError(err)
+	liquidationThreshold, err := app.LeverageKeeper.CalculateLiquidationThreshold(
+		accrualCtx, app.LeverageKeeper.GetBorrowerCollateral(accrualCtx, addr),
+	)
+	require.NoError(err)
+	require.True(borrowedValue.GT(liquidationThreshold), "borrowed %s, threshold %s", borrowedValue, liquidationThreshold)
+
+	// event is emitted once when the borrower becomes liquidatable
+	events := check(accrualCtx)
+	require.Len(events, 1)
+	require.Equal(borrowedValue.Sub(liquidationThreshold), events[0].Shortfall)
+
+	// a borrower who remains liquidatable is not reported again
+	require.Empty(check(accrualCtx))
+}
+
+func (s *IntegrationTestSuite) TestCheckLiquidationWatchlistAccrualOnly() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+
+	// UMEE borrows accrue interest at 300% per year
+	umeeToken, err := app.LeverageKeeper.GetTokenSettings(ctx, umeeDenom)
+	require.NoError(err)
+	umeeToken.BaseBorrowRate = sdk.MustNewDecFromStr("3.0")
+	umeeToken.KinkBorrowRate = sdk.MustNewDecFromStr("3.0")
+	umeeToken.MaxBorrowRate = sdk.MustNewDecFromStr("3.0")
+	require.NoError(app.LeverageKeeper.SetTokenSettings(ctx, umeeToken))
+
+	// a supplier provides UMEE, and two borrowers each collateralize 10 ATOM ($393.80, liquidation
+	// threshold $102.388). One borrows 22 UMEE ($92.62), within 90% of its liquidation threshold, and
+	// the other borrows 10 UMEE ($42.10), far below it.
+	supplier := s.newAccount(coin.New(umeeDenom, 100_000000))
+	s.supply(supplier, coin.New(umeeDenom, 100_000000))
+	near := s.newAccount(coin.New(atomDenom, 10_000000))
+	s.supply(near, coin.New(atomDenom, 10_000000))
+	s.collateralize(near, coin.New("u/"+atomDenom, 10_000000))
+	s.borrow(near, coin.New(umeeDenom, 22_000000))
+	far := s.newAccount(coin.New(atomDenom, 10_000000))
+	s.supply(far, coin.New(atomDenom, 10_000000))
+	s.collateralize(far, coin.New("u/"+atomDenom, 10_000000))
+	s.borrow(far, coin.New(umeeDenom, 10_000000))
+
+	// returns the addresses reported by EventBecameLiquidatable events in a watchlist check
+	check := func(ctx sdk.Context) map[string]sdk.Dec {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		app.LeverageKeeper.CheckLiquidationWatchlist(ctx)
+		shortfalls := map[string]sdk.Dec{}
+		for _, e := range ctx.EventManager().Events() {
+			msg, err := sdk.ParseTypedEvent(abci.Event(e))
+			require.NoError(err)
+			if event, ok := msg.(*types.EventBecameLiquidatable); ok {
+				shortfalls[event.Address] = event.Shortfall
+			}
+		}
+		return shortfalls
+	}
+
+	// both borrowers are healthy, and the far borrower is removed from the watchlist
+	require.Empty(check(ctx))
+
+	// 300 days of interest alone pushes both borrowers over their liquidation thresholds, without any
+	// change to their borrows or collateral
+	startCtx := ctx.WithBlockTime(time.Unix(1_000_000, 0))
+	require.NoError(app.LeverageKeeper.AccrueAllInterest(startCtx))
+	accrualCtx := startCtx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(startCtx.BlockTime().Add(300 * 24 * time.Hour))
+	require.NoError(app.LeverageKeeper.AccrueAllInterest(accrualCtx))
+
+	borrowedValue, err := app.LeverageKeeper.TotalTokenValue(
+		accrualCtx, app.LeverageKeeper.GetBorrowerBorrows(accrualCtx, near), types.PriceModeSpot,
+	)
+	require.NoError(err)
+	require.True(borrowedValue.GT(sdk.MustNewDecFromStr("102.388")), "borrowed %s", borrowedValue)
+
+	// only the borrower which stayed on the watchlist is reported
+	shortfalls := check(accrualCtx)
+	require.Equal(map[string]sdk.Dec{
+		near.String(): borrowedValue.Sub(sdk.MustNewDecFromStr("102.388")),
+	}, shortfalls)
+}
+
+func (s *IntegrationTestSuite) TestCheckLiquidationWatchlistLimit() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+
+	// creates three accounts which have each supplied and collateralized 1000 UMEE, and borrowed
+	// 250 UMEE (their borrow limit)
+	for i := 0; i < 3; i++ {
+		addr := s.newAccount(coin.New(umeeDenom, 1000_000000))
+		s.supply(addr, coin.New(umeeDenom, 1000_000000))
+		s.collateralize(addr, coin.New("u/"+umeeDenom, 1000_000000))
+		s.borrow(addr, coin.New(umeeDenom, 250_000000))
+	}
+
+	// Note: Setting umee liquidation threshold equal to its collateral weight, so the borrowers
+	// are exactly at their liquidation threshold and any interest makes them eligible for liquidation
+	umeeToken := newToken("uumee", "UMEE", 6)
+	umeeToken.LiquidationThreshold = umeeToken.CollateralWeight
+	require.NoError(app.LeverageKeeper.SetTokenSettings(ctx, umeeToken))
+
+	// interest accrues for a day, pushing every borrower over their liquidation threshold
+	startCtx := ctx.WithBlockTime(time.Unix(1_000_000, 0))
+	require.NoError(app.LeverageKeeper.AccrueAllInterest(startCtx))
+	accrualCtx := startCtx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(startCtx.BlockTime().Add(24 * time.Hour))
+	require.NoError(app.LeverageKeeper.AccrueAllInterest(accrualCtx))
+
+	// returns the addresses reported by a watchlist check of at most two accounts
+	check := func(ctx sdk.Context) []string {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		s.tk.CheckLiquidationWatchlistLimit(ctx, 2)
+		addrs := []string{}
+		for _, e := range ctx.EventManager().Events() {
+			msg, err := sdk.ParseTypedEvent(abci.Event(e))
+			require.NoError(err)
+			if event, ok := msg.(*types.EventBecameLiquidatable); ok {
+				addrs = append(addrs, event.Address)
+			}
+		}
+		return addrs
+	}
+
+	// the first block checks two accounts, and the next block resumes with the third before wrapping
+	// around to an account which was already reported
+	first := check(accrualCtx)
+	require.Len(first, 2)
+	second := check(accrualCtx)
+	require.Len(second, 1)
+	require.NotContains(first, second[0])
+	require.Empty(check(accrualCtx))
 }
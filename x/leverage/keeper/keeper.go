@@ -17,6 +17,7 @@ import (
 type Keeper struct {
 	cdc                    codec.Codec
 	storeKey               storetypes.StoreKey
+	tStoreKey              storetypes.StoreKey
 	paramSpace             paramtypes.Subspace
 	bankKeeper             types.BankKeeper
 	oracleKeeper           types.OracleKeeper
@@ -29,6 +30,7 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.Codec,
 	storeKey storetypes.StoreKey,
+	tStoreKey storetypes.StoreKey,
 	paramSpace paramtypes.Subspace,
 	bk types.BankKeeper,
 	ok types.OracleKeeper,
@@ -42,6 +44,7 @@ func NewKeeper(
 	return Keeper{
 		cdc:                    cdc,
 		storeKey:               storeKey,
+		tStoreKey:              tStoreKey,
 		paramSpace:             paramSpace,
 		bankKeeper:             bk,
 		oracleKeeper:           ok,
@@ -79,9 +82,16 @@ func (k Keeper) ModuleBalance(ctx sdk.Context, denom string) sdk.Coin {
 }
 
 // Supply attempts to deposit assets into the leverage module account in
-// exchange for uTokens. If asset type is invalid or account balance is
-// insufficient, we return an error. Returns the amount of uTokens minted.
+// exchange for uTokens. If asset type is invalid, account balance is
+// insufficient, the account is frozen, or supplying is paused, we return an error.
+// Returns the amount of uTokens minted.
 func (k Keeper) Supply(ctx sdk.Context, supplierAddr sdk.AccAddress, coin sdk.Coin) (sdk.Coin, error) {
+	if k.GetParams(ctx).SupplyPaused {
+		return sdk.Coin{}, types.ErrSupplyPaused
+	}
+	if err := k.validateNotFrozen(ctx, supplierAddr); err != nil {
+		return sdk.Coin{}, err
+	}
 	if err := k.validateSupply(ctx, coin); err != nil {
 		return sdk.Coin{}, err
 	}
@@ -118,27 +128,44 @@ func (k Keeper) Supply(ctx sdk.Context, supplierAddr sdk.AccAddress, coin sdk.Co
 // Withdraw attempts to redeem uTokens from the leverage module in exchange for base tokens.
 // If there are not enough uTokens in balance, Withdraw will attempt to withdraw uToken collateral
 // to make up the difference. If the uToken denom is invalid or balances are insufficient to withdraw
-// the amount requested, returns an error. Returns the amount of base tokens received.
+// the amount requested, if the withdrawal would exceed the MaxWithdrawRatePerBlock of its token,
+// or if the account is frozen, returns an error. The token's WithdrawFee portion of the base tokens
+// is added to reserves, and the remainder is sent to the supplier. Returns the amount of base tokens
+// received and the fee.
 // This function does NOT check that a borrower remains under their borrow limit or that
 // collateral liquidity remains healthy - those assertions have been moved to MsgServer.
 // Returns a boolean which is true if some or all of the withdrawn uTokens were from collateral.
-func (k Keeper) Withdraw(ctx sdk.Context, supplierAddr sdk.AccAddress, uToken sdk.Coin) (sdk.Coin, bool, error) {
+func (k Keeper) Withdraw(ctx sdk.Context, supplierAddr sdk.AccAddress, uToken sdk.Coin,
+) (sdk.Coin, sdk.Coin, bool, error) {
+	return k.WithdrawTo(ctx, supplierAddr, supplierAddr, uToken)
+}
+
+// WithdrawTo is Withdraw, except the base tokens are sent to a recipient instead of the supplier.
+// The uTokens are still taken from the supplier's balance and collateral.
+func (k Keeper) WithdrawTo(ctx sdk.Context, supplierAddr, recipientAddr sdk.AccAddress, uToken sdk.Coin,
+) (sdk.Coin, sdk.Coin, bool, error) {
 	isFromCollateral := false
 
+	if err := k.validateNotFrozen(ctx, supplierAddr); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, isFromCollateral, err
+	}
 	if err := validateUToken(uToken); err != nil {
-		return sdk.Coin{}, isFromCollateral, err
+		return sdk.Coin{}, sdk.Coin{}, isFromCollateral, err
 	}
 
 	// calculate base asset amount to withdraw
 	token, err := k.ExchangeUToken(ctx, uToken)
 	if err != nil {
-		return sdk.Coin{}, isFromCollateral, err
+		return sdk.Coin{}, sdk.Coin{}, isFromCollateral, err
 	}
 
 	// Ensure module account has sufficient unreserved tokens to withdraw
 	availableAmount := k.AvailableLiquidity(ctx, token.Denom)
 	if token.Amount.GT(availableAmount) {
-		return sdk.Coin{}, isFromCollateral, types.ErrLendingPoolInsufficient.Wrap(token.String())
+		return sdk.Coin{}, sdk.Coin{}, isFromCollateral, types.ErrLendingPoolInsufficient.Wrap(token.String())
+	}
+	if err = k.checkWithdrawRate(ctx, token); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, isFromCollateral, err
 	}
 
 	// Withdraw will first attempt to use any uTokens in the supplier's wallet
@@ -154,14 +181,14 @@ func (k Keeper) Withdraw(ctx sdk.Context, supplierAddr sdk.AccAddress, uToken sd
 		collateral := k.GetBorrowerCollateral(ctx, supplierAddr)
 		collateralAmount := collateral.AmountOf(uToken.Denom)
 		if collateralAmount.LT(amountFromCollateral) {
-			return sdk.Coin{}, isFromCollateral, types.ErrInsufficientBalance.Wrapf(
+			return sdk.Coin{}, sdk.Coin{}, isFromCollateral, types.ErrInsufficientBalance.Wrapf(
 				"%s uToken balance + %s from collateral is less than %s to withdraw",
 				amountFromWallet, collateralAmount, uToken)
 		}
 
 		unbondedCollateral := k.unbondedCollateral(ctx, supplierAddr, uToken.Denom)
 		if unbondedCollateral.Amount.LT(amountFromCollateral) {
-			return sdk.Coin{}, isFromCollateral, types.ErrBondedCollateral.Wrapf(
+			return sdk.Coin{}, sdk.Coin{}, isFromCollateral, types.ErrBondedCollateral.Wrapf(
 				"%s unbonded collateral is less than %s to withdraw from collateral",
 				unbondedCollateral, amountFromCollateral)
 		}
@@ -169,42 +196,102 @@ func (k Keeper) Withdraw(ctx sdk.Context, supplierAddr sdk.AccAddress, uToken sd
 		// reduce the supplier's collateral by amountFromCollateral
 		newCollateral := sdk.NewCoin(uToken.Denom, collateralAmount.Sub(amountFromCollateral))
 		if err = k.setCollateral(ctx, supplierAddr, newCollateral); err != nil {
-			return sdk.Coin{}, isFromCollateral, err
+			return sdk.Coin{}, sdk.Coin{}, isFromCollateral, err
 		}
+		k.setCollateralChangeHeight(ctx, supplierAddr)
 	}
 
 	// transfer amountFromWallet uTokens to the module account
 	uTokens := sdk.NewCoins(sdk.NewCoin(uToken.Denom, amountFromWallet))
 	if err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, supplierAddr, types.ModuleName, uTokens); err != nil {
-		return sdk.Coin{}, isFromCollateral, err
+		return sdk.Coin{}, sdk.Coin{}, isFromCollateral, err
 	}
 
-	// send the base assets to supplier
-	tokens := sdk.NewCoins(token)
-	if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, supplierAddr, tokens); err != nil {
-		return sdk.Coin{}, isFromCollateral, err
+	// the withdraw fee remains in the module account as reserves
+	fee, err := k.withdrawFee(ctx, token)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, isFromCollateral, err
+	}
+	if fee.IsPositive() {
+		if err = k.addReserves(ctx, fee); err != nil {
+			return sdk.Coin{}, sdk.Coin{}, isFromCollateral, err
+		}
+	}
+
+	// send the remaining base assets to the recipient
+	received := token.Sub(fee)
+	tokens := sdk.NewCoins(received)
+	if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipientAddr, tokens); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, isFromCollateral, err
 	}
 
 	// burn the uTokens and set the new total uToken supply
 	if err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(uToken)); err != nil {
-		return sdk.Coin{}, isFromCollateral, err
+		return sdk.Coin{}, sdk.Coin{}, isFromCollateral, err
 	}
 	if err = k.setUTokenSupply(ctx, k.GetUTokenSupply(ctx, uToken.Denom).Sub(uToken)); err != nil {
-		return sdk.Coin{}, isFromCollateral, err
+		return sdk.Coin{}, sdk.Coin{}, isFromCollateral, err
+	}
+
+	// record the amount withdrawn during the current block
+	withdrawn := k.getBlockWithdrawals(ctx, token.Denom).Add(token.Amount)
+	if err = k.setBlockWithdrawals(ctx, sdk.NewCoin(token.Denom, withdrawn)); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, isFromCollateral, err
 	}
 
-	return token, isFromCollateral, nil
+	return received, fee, isFromCollateral, nil
+}
+
+// withdrawFee returns the portion of withdrawn base tokens which is added to reserves, according to
+// the token's WithdrawFee. The fee is rounded down.
+func (k Keeper) withdrawFee(ctx sdk.Context, token sdk.Coin) (sdk.Coin, error) {
+	settings, err := k.GetTokenSettings(ctx, token.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.NewCoin(token.Denom, settings.WithdrawFee.MulInt(token.Amount).TruncateInt()), nil
 }
 
 // Borrow attempts to borrow tokens from the leverage module account using
 // collateral uTokens. If asset type is invalid,  or module balance is insufficient,
-// we return an error.
-// This function does NOT check that a borrower remains under their borrow limit or that
-// collateral liquidity remains healthy - those assertions have been moved to MsgServer.
+// or the borrower changed its collateral within the last BorrowCooldownBlocks, or the borrower is frozen,
+// or borrowing is paused globally or by the token's price circuit breaker, or the borrow would exceed the
+// borrower's account borrow cap, we return an error. This function does NOT check that a borrower remains under
+// their borrow limit or that collateral liquidity remains healthy - those assertions have been moved to MsgServer.
 func (k Keeper) Borrow(ctx sdk.Context, borrowerAddr sdk.AccAddress, borrow sdk.Coin) error {
+	params := k.GetParams(ctx)
+	if params.BorrowPaused {
+		return types.ErrBorrowPaused
+	}
+	if err := k.validateNotFrozen(ctx, borrowerAddr); err != nil {
+		return err
+	}
 	if err := k.validateBorrow(ctx, borrow); err != nil {
 		return err
 	}
+	if err := k.validateNotSelfBorrow(ctx, borrowerAddr, borrow.Denom); err != nil {
+		return err
+	}
+	if err := k.validateCircuitBreaker(ctx, borrow.Denom); err != nil {
+		return err
+	}
+	if params.BorrowCooldownBlocks > 0 {
+		// accounts cannot borrow within a number of blocks after changing their collateral
+		lastChange := k.getCollateralChangeHeight(ctx, borrowerAddr)
+		if lastChange > 0 && ctx.BlockHeight() < lastChange+int64(params.BorrowCooldownBlocks) {
+			return types.ErrBorrowCooldown.Wrapf("collateral changed at height %d, borrowing allowed at height %d",
+				lastChange, lastChange+int64(params.BorrowCooldownBlocks))
+		}
+	}
+	if params.ZeroPricePolicy == types.ZeroPricePolicy_ZERO_PRICE_POLICY_TREAT_AS_UNBORROWABLE {
+		// tokens with zero or missing prices cannot be borrowed under this policy
+		if _, _, err := k.TokenPrice(ctx, borrow.Denom, types.PriceModeSpot); err != nil {
+			if nonOracleError(err) {
+				return err
+			}
+			return types.ErrUnborrowablePrice.Wrapf("%s: %s", borrow.Denom, err)
+		}
+	}
 
 	// Ensure module account has sufficient unreserved tokens to loan out
 	availableAmount := k.AvailableLiquidity(ctx, borrow.Denom)
@@ -215,6 +302,16 @@ func (k Keeper) Borrow(ctx sdk.Context, borrowerAddr sdk.AccAddress, borrow sdk.
 	// Determine amount of all tokens currently borrowed
 	borrowed := k.GetBorrowerBorrows(ctx, borrowerAddr)
 
+	if params.MaxAccountLeverage.IsPositive() {
+		collateral := k.GetBorrowerCollateral(ctx, borrowerAddr)
+		if err := k.checkAccountLeverage(ctx, collateral, borrowed.Add(borrow), params.MaxAccountLeverage); err != nil {
+			return err
+		}
+	}
+	if err := k.validateAccountBorrowCap(ctx, borrowerAddr, borrowed.Add(borrow)); err != nil {
+		return err
+	}
+
 	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
 		ctx, types.ModuleName, borrowerAddr, sdk.NewCoins(borrow)); err != nil {
 		return err
@@ -229,14 +326,21 @@ func (k Keeper) Borrow(ctx sdk.Context, borrowerAddr sdk.AccAddress, borrow sdk.
 // is insufficient, or borrower has no borrows in payment denom to repay, we return an error.
 // Additionally, if the amount provided is greater than the full repayment amount, only the
 // necessary amount is transferred. Because amount repaid may be less than the repayment attempted,
-// Repay returns the actual amount repaid.
+// Repay returns the actual amount repaid. Frozen accounts are allowed to repay.
 func (k Keeper) Repay(ctx sdk.Context, borrowerAddr sdk.AccAddress, payment sdk.Coin) (sdk.Coin, error) {
+	return k.RepayFor(ctx, borrowerAddr, borrowerAddr, payment)
+}
+
+// RepayFor is Repay, except the borrow position of a recipient is repaid instead of the borrower's.
+// The payment is still taken from the borrower's balance.
+func (k Keeper) RepayFor(ctx sdk.Context, borrowerAddr, recipientAddr sdk.AccAddress, payment sdk.Coin,
+) (sdk.Coin, error) {
 	if err := validateBaseToken(payment); err != nil {
 		return sdk.Coin{}, err
 	}
 
 	// determine amount of selected denom currently owed
-	owed := k.GetBorrow(ctx, borrowerAddr, payment.Denom)
+	owed := k.GetBorrow(ctx, recipientAddr, payment.Denom)
 	if owed.IsZero() {
 		// no need to repay - everything is all right
 		return coin.Zero(payment.Denom), nil
@@ -246,12 +350,235 @@ func (k Keeper) Repay(ctx sdk.Context, borrowerAddr sdk.AccAddress, payment sdk.
 	payment.Amount = sdk.MinInt(owed.Amount, payment.Amount)
 
 	// send payment to leverage module account
-	if err := k.repayBorrow(ctx, borrowerAddr, borrowerAddr, payment); err != nil {
+	if err := k.repayBorrow(ctx, borrowerAddr, recipientAddr, payment); err != nil {
 		return sdk.Coin{}, err
 	}
 	return payment, nil
 }
 
+// RepayWithdraw repays a borrow position, then withdraws uTokens, as a single atomic state transition.
+// If either step fails, or the borrower ends up over their borrow limit, or collateral liquidity
+// becomes unhealthy, no state is changed and an error is returned. Borrow limit is only checked after
+// both steps, so a withdrawal can use collateral freed by the repayment. Returns the amount repaid
+// the amount of base tokens received, and the withdraw fee.
+func (k Keeper) RepayWithdraw(ctx sdk.Context, borrowerAddr sdk.AccAddress, payment, uToken sdk.Coin,
+) (sdk.Coin, sdk.Coin, sdk.Coin, error) {
+	cacheCtx, write := ctx.CacheContext()
+
+	repaid, err := k.Repay(cacheCtx, borrowerAddr, payment)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+	received, fee, isFromCollateral, err := k.Withdraw(cacheCtx, borrowerAddr, uToken)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	// Fail here if borrower ends up over their borrow limit under current or historic prices
+	if isFromCollateral {
+		if err = k.assertBorrowerHealth(cacheCtx, borrowerAddr); err != nil {
+			return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+		}
+	}
+	// Ensure MinCollateralLiquidity is still satisfied after the transaction
+	if err = k.checkCollateralLiquidity(cacheCtx, received.Denom); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	write()
+	return repaid, received, fee, nil
+}
+
+// BorrowWithTopUp supplies and collateralizes only as much of the provided base token collateral as is
+// needed for a borrower to reach a target health factor after borrowing, then borrows the requested asset.
+// Any unused collateral remains in the borrower's wallet. All steps succeed or fail together.
+// Returns the base tokens supplied and the uTokens collateralized, which are zero if no top-up was needed.
+func (k Keeper) BorrowWithTopUp(ctx sdk.Context, borrowerAddr sdk.AccAddress, borrow, collateral sdk.Coin,
+	target sdk.Dec,
+) (sdk.Coin, sdk.Coin, error) {
+	cacheCtx, write := ctx.CacheContext()
+
+	topUp, err := k.topUpAmount(cacheCtx, borrowerAddr, borrow, collateral, target)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	uToken := sdk.NewCoin(types.ToUTokenDenom(collateral.Denom), sdk.ZeroInt())
+	if topUp.IsPositive() {
+		uToken, err = k.Supply(cacheCtx, borrowerAddr, topUp)
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+		if err = k.Collateralize(cacheCtx, borrowerAddr, uToken); err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+		// Fail here if MaxSupply or collateral share restrictions are violated
+		if err = k.checkMaxSupply(cacheCtx, topUp.Denom); err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+		if err = k.checkCollateralShare(cacheCtx, uToken.Denom); err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+	}
+
+	if err = k.Borrow(cacheCtx, borrowerAddr, borrow); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	// Fail here if borrower ends up over their borrow limit under current or historic prices
+	if err = k.assertBorrowerHealth(cacheCtx, borrowerAddr); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	// Check MaxSupplyUtilization and MinCollateralLiquidity after the borrow
+	if err = k.checkSupplyUtilization(cacheCtx, borrow.Denom); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	if err = k.checkCollateralLiquidity(cacheCtx, borrow.Denom); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	write()
+	return topUp, uToken, nil
+}
+
+// LeverageLoop supplies and collateralizes a base token, then repeatedly borrows the same token and supplies
+// and collateralizes each borrowed amount, until the total supplied reaches target leverage times the initial
+// asset. Each step is capped by the supplier's unused borrow limit and by the module's liquidity available
+// to lend. The loop stops early when a step would be dust or LeverageLoopMaxIterations is reached, and
+// reports the factor which limited it. All steps succeed or fail together.
+// Returns the uTokens collateralized, the base tokens borrowed, and the limiting factor.
+func (k Keeper) LeverageLoop(ctx sdk.Context, supplierAddr sdk.AccAddress, asset sdk.Coin, target sdk.Dec,
+) (sdk.Coin, sdk.Coin, types.LoopLimit, error) {
+	cacheCtx, write := ctx.CacheContext()
+
+	supplyCollateral := func(supply sdk.Coin) (sdk.Coin, error) {
+		uToken, err := k.Supply(cacheCtx, supplierAddr, supply)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		return uToken, k.Collateralize(cacheCtx, supplierAddr, uToken)
+	}
+
+	collateralized, err := supplyCollateral(asset)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, 0, err
+	}
+	borrowed := sdk.NewCoin(asset.Denom, sdk.ZeroInt())
+	goal := target.MulInt(asset.Amount).TruncateInt().Sub(asset.Amount)
+	dust := asset.Amount.QuoRaw(types.LeverageLoopDustDivisor)
+
+	limit := types.LoopLimit_LOOP_LIMIT_NONE
+	for i := 0; i < types.LeverageLoopMaxIterations; i++ {
+		remaining := goal.Sub(borrowed.Amount)
+		if !remaining.IsPositive() {
+			break
+		}
+		userMax, err := k.userMaxBorrow(cacheCtx, supplierAddr, asset.Denom)
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, 0, err
+		}
+		moduleMax, err := k.moduleMaxBorrow(cacheCtx, asset.Denom)
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, 0, err
+		}
+
+		// the smallest of the three caps determines the step, and the reason the loop stops if it does
+		step := remaining
+		limit = types.LoopLimit_LOOP_LIMIT_NONE
+		if userMax.Amount.LT(step) {
+			step = userMax.Amount
+			limit = types.LoopLimit_LOOP_LIMIT_COLLATERAL_WEIGHT
+		}
+		if moduleMax.LT(step) {
+			step = moduleMax
+			limit = types.LoopLimit_LOOP_LIMIT_LIQUIDITY
+		}
+		if step.LTE(dust) {
+			if limit == types.LoopLimit_LOOP_LIMIT_NONE {
+				limit = types.LoopLimit_LOOP_LIMIT_DUST
+			}
+			break
+		}
+
+		stepCoin := sdk.NewCoin(asset.Denom, step)
+		if err = k.Borrow(cacheCtx, supplierAddr, stepCoin); err != nil {
+			return sdk.Coin{}, sdk.Coin{}, 0, err
+		}
+		uToken, err := supplyCollateral(stepCoin)
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, 0, err
+		}
+		borrowed = borrowed.Add(stepCoin)
+		collateralized = collateralized.Add(uToken)
+	}
+
+	// Fail here if MaxSupply or collateral share restrictions are violated
+	if err = k.checkMaxSupply(cacheCtx, asset.Denom); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, 0, err
+	}
+	if err = k.checkCollateralShare(cacheCtx, collateralized.Denom); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, 0, err
+	}
+	// Fail here if borrower ends up over their borrow limit under current or historic prices
+	if err = k.assertBorrowerHealth(cacheCtx, supplierAddr); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, 0, err
+	}
+	// Check MaxSupplyUtilization and MinCollateralLiquidity after the borrows
+	if err = k.checkSupplyUtilization(cacheCtx, asset.Denom); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, 0, err
+	}
+	if err = k.checkCollateralLiquidity(cacheCtx, asset.Denom); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, 0, err
+	}
+
+	write()
+	return collateralized, borrowed, limit, nil
+}
+
+// SwapCollateral decollateralizes and withdraws uTokens from a borrower's collateral, then supplies and
+// collateralizes a different base token from the borrower's wallet, as a single atomic state transition.
+// The borrow limit, collateral share, MaxSupply and MinCollateralLiquidity are only checked at the final
+// state, so the swap cannot fail on a transient shortfall between steps. If any step or check fails, no
+// state is changed and an error is returned. Returns the base tokens received from the withdrawal, the
+// withdraw fee, and the uTokens collateralized.
+func (k Keeper) SwapCollateral(ctx sdk.Context, borrowerAddr sdk.AccAddress, uToken, asset sdk.Coin,
+) (sdk.Coin, sdk.Coin, sdk.Coin, error) {
+	cacheCtx, write := ctx.CacheContext()
+
+	// decollateralizing first ensures the withdrawn uTokens come from collateral, not the wallet
+	if err := k.Decollateralize(cacheCtx, borrowerAddr, uToken); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+	received, fee, _, err := k.Withdraw(cacheCtx, borrowerAddr, uToken)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+	collateralized, err := k.Supply(cacheCtx, borrowerAddr, asset)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+	if err = k.Collateralize(cacheCtx, borrowerAddr, collateralized); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	// Fail here if MaxSupply or collateral share restrictions are violated
+	if err = k.checkMaxSupply(cacheCtx, asset.Denom); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+	if err = k.checkCollateralShare(cacheCtx, collateralized.Denom); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+	// Fail here if borrower ends up over their borrow limit under current or historic prices
+	if err = k.assertBorrowerHealth(cacheCtx, borrowerAddr); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+	// Ensure MinCollateralLiquidity is still satisfied after the withdrawal
+	if err = k.checkCollateralLiquidity(cacheCtx, received.Denom); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	write()
+	return received, fee, collateralized, nil
+}
+
 // Collateralize enables selected uTokens for use as collateral by a single borrower.
 // This function does NOT check that collateral share and collateral liquidity remain healthy.
 // Those assertions have been moved to MsgServer.
@@ -264,6 +591,7 @@ func (k Keeper) Collateralize(ctx sdk.Context, borrowerAddr sdk.AccAddress, uTok
 	if err := k.setCollateral(ctx, borrowerAddr, currentCollateral.Add(uToken)); err != nil {
 		return err
 	}
+	k.setCollateralChangeHeight(ctx, borrowerAddr)
 
 	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, borrowerAddr, types.ModuleName, sdk.NewCoins(uToken))
 }
@@ -295,6 +623,7 @@ func (k Keeper) Decollateralize(ctx sdk.Context, borrowerAddr sdk.AccAddress, uT
 	if err := k.setCollateral(ctx, borrowerAddr, sdk.NewCoin(uToken.Denom, newCollateralAmount)); err != nil {
 		return err
 	}
+	k.setCollateralChangeHeight(ctx, borrowerAddr)
 	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, borrowerAddr, sdk.NewCoins(uToken))
 }
 
@@ -304,10 +633,15 @@ func (k Keeper) Decollateralize(ctx sdk.Context, borrowerAddr sdk.AccAddress, uT
 // attempted repayment is greater than the amount owed or the maximum that can be repaid due to parameters
 // or available balances, then a partial liquidation, equal to the maximum valid amount, is performed.
 // Because partial liquidation is possible and exchange rates vary, Liquidate returns the actual amount of
-// tokens repaid, collateral liquidated, and base tokens or uTokens rewarded.
+// tokens repaid, collateral liquidated, and base tokens or uTokens rewarded. Frozen accounts cannot act
+// as liquidators, but frozen borrowers can still be liquidated. Borrowers in their liquidation grace
+// period cannot be liquidated.
 func (k Keeper) Liquidate(
 	ctx sdk.Context, liquidatorAddr, borrowerAddr sdk.AccAddress, requestedRepay sdk.Coin, rewardDenom string,
 ) (repaid sdk.Coin, liquidated sdk.Coin, reward sdk.Coin, err error) {
+	if err := k.validateNotFrozen(ctx, liquidatorAddr); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
 	if err := k.validateAcceptedAsset(ctx, requestedRepay); err != nil {
 		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
 	}
@@ -322,6 +656,15 @@ func (k Keeper) Liquidate(
 	if err := k.validateAcceptedDenom(ctx, rewardDenom); err != nil {
 		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
 	}
+	// liquidations are paused if either token is paused by its price circuit breaker
+	for _, denom := range []string{requestedRepay.Denom, rewardDenom} {
+		if err := k.validateCircuitBreaker(ctx, denom); err != nil {
+			return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+		}
+	}
+	if err := k.validateLiquidationGracePeriod(ctx, borrowerAddr); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
 
 	tokenRepay, uTokenLiquidate, tokenReward, err := k.getLiquidationAmounts(
 		ctx,
@@ -345,15 +688,32 @@ func (k Keeper) Liquidate(
 		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
 	}
 
+	// the protocol's share of the liquidation incentive is taken from the reward and added to reserves
+	liquidatorReward := uTokenLiquidate
 	if directLiquidation {
-		err = k.liquidateCollateral(ctx, borrowerAddr, liquidatorAddr, uTokenLiquidate, tokenReward)
+		liquidatorReward = tokenReward
+	}
+	protocolCut, err := k.protocolLiquidationCut(ctx, rewardDenom, liquidatorReward, directLiquidation)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+	liquidatorReward = liquidatorReward.Sub(protocolCut)
+	if err = k.addLiquidationRewardsPaid(ctx, liquidatorReward); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	if directLiquidation {
+		err = k.liquidateCollateral(ctx, borrowerAddr, liquidatorAddr, uTokenLiquidate, liquidatorReward)
 	} else {
 		// send uTokens from borrower collateral to liquidator's account
-		err = k.decollateralize(ctx, borrowerAddr, liquidatorAddr, uTokenLiquidate)
+		err = k.decollateralize(ctx, borrowerAddr, liquidatorAddr, liquidatorReward)
 	}
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
 	}
+	if err = k.reserveLiquidationCut(ctx, borrowerAddr, protocolCut); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
 
 	// if borrower's collateral has reached zero, mark any remaining borrows as bad debt
 	if err := k.checkBadDebt(ctx, borrowerAddr); err != nil {
@@ -369,8 +729,5 @@ func (k Keeper) Liquidate(
 	}
 
 	// the last return value is the liquidator's selected reward
-	if directLiquidation {
-		return tokenRepay, uTokenLiquidate, tokenReward, nil
-	}
-	return tokenRepay, uTokenLiquidate, uTokenLiquidate, nil
+	return tokenRepay, uTokenLiquidate, liquidatorReward, nil
 }
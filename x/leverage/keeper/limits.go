@@ -135,6 +135,14 @@ func (k *Keeper) userMaxBorrow(ctx sdk.Context, addr sdk.AccAddress, denom strin
 	// determine the USD amount of borrow limit that is currently unused
 	unusedBorrowLimit := borrowLimit.Sub(borrowedValue)
 
+	// accounts with a borrow cap cannot borrow past it
+	if borrowCap := k.GetAccountBorrowCap(ctx, addr); borrowCap.IsPositive() {
+		if borrowCap.LTE(borrowedValue) {
+			return sdk.NewCoin(denom, sdk.ZeroInt()), nil
+		}
+		unusedBorrowLimit = sdk.MinDec(unusedBorrowLimit, borrowCap.Sub(borrowedValue))
+	}
+
 	// determine max borrow, using the higher of spot or historic prices for the token to borrow
 	maxBorrow, err := k.TokenWithValue(ctx, denom, unusedBorrowLimit, types.PriceModeHigh)
 	if nonOracleError(err) {
@@ -227,3 +235,249 @@ func (k Keeper) ModuleAvailableLiquidity(ctx sdk.Context, denom string) (sdkmath
 
 	return sdk.MaxInt(moduleAvailableLiquidity.TruncateInt(), sdk.ZeroInt()), nil
 }
+
+// userRepayToFreeCollateral calculates the USD value of borrows an account must repay so that its
+// full collateral balance of a given uToken denom could be withdrawn without exceeding its borrow limit.
+// Also returns, for each of the account's borrowed denoms, the amount which would suffice if repaid alone.
+// Borrowed denoms whose full balance would not suffice are omitted. Collateral bonded to incentive programs
+// is not freed by repaying, and is not considered here.
+func (k Keeper) userRepayToFreeCollateral(ctx sdk.Context, addr sdk.AccAddress, uDenom string,
+) (sdk.Dec, sdk.Coins, error) {
+	if !types.HasUTokenPrefix(uDenom) {
+		return sdk.ZeroDec(), nil, types.ErrNotUToken.Wrap(uDenom)
+	}
+
+	totalBorrowed := k.GetBorrowerBorrows(ctx, addr)
+	totalCollateral := k.GetBorrowerCollateral(ctx, addr)
+	thisCollateral := sdk.NewCoin(uDenom, totalCollateral.AmountOf(uDenom))
+	otherCollateral := totalCollateral.Sub(thisCollateral)
+
+	// calculate borrowed value for the account, using the higher of spot or historic prices for each token.
+	// missing prices on borrowed assets cause an error, since the required repayment cannot be known.
+	borrowedValue, err := k.TotalTokenValue(ctx, totalBorrowed, types.PriceModeHigh)
+	if err != nil {
+		return sdk.ZeroDec(), nil, err
+	}
+
+	// compute the borrow limit of all collateral except the denom being freed
+	// (also excluding collateral missing oracle prices)
+	otherBorrowLimit, err := k.VisibleBorrowLimit(ctx, otherCollateral)
+	if err != nil {
+		return sdk.ZeroDec(), nil, err
+	}
+	if borrowedValue.LTE(otherBorrowLimit) {
+		return sdk.ZeroDec(), sdk.NewCoins(), nil
+	}
+	repayValue := borrowedValue.Sub(otherBorrowLimit)
+
+	options := sdk.NewCoins()
+	for _, b := range totalBorrowed {
+		price, exp, err := k.TokenPrice(ctx, b.Denom, types.PriceModeHigh)
+		if err != nil {
+			return sdk.ZeroDec(), nil, err
+		}
+		// amount = USD value * 10^exponent / symbol price, rounded up
+		amount := exponent(repayValue, int32(exp)).Quo(price).Ceil().TruncateInt()
+		if amount.LTE(b.Amount) {
+			options = options.Add(sdk.NewCoin(b.Denom, amount))
+		}
+	}
+
+	return repayValue, options, nil
+}
+
+// userFreeCollateral calculates the amount of an account's collateral of a given uToken denom which is not
+// needed to cover its borrows, and could be decollateralized without exceeding its borrow limit. Collateral
+// bonded to incentive programs is excluded. If oracle prices are missing for some of the borrower's other
+// collateral, only the collateral whose prices are known is counted toward its borrow limit. If prices are
+// missing for this collateral, or for any borrowed asset, free collateral is zero unless there are no borrows.
+func (k Keeper) userFreeCollateral(ctx sdk.Context, addr sdk.AccAddress, uDenom string) (sdk.Coin, error) {
+	totalBorrowed := k.GetBorrowerBorrows(ctx, addr)
+	totalCollateral := k.GetBorrowerCollateral(ctx, addr)
+	thisCollateral := sdk.NewCoin(uDenom, totalCollateral.AmountOf(uDenom))
+	otherCollateral := totalCollateral.Sub(thisCollateral)
+	unbondedCollateral := k.unbondedCollateral(ctx, addr, uDenom)
+	none := sdk.NewCoin(uDenom, sdk.ZeroInt())
+
+	// calculate borrowed value for the account, using the higher of spot or historic prices for each token
+	borrowedValue, err := k.TotalTokenValue(ctx, totalBorrowed, types.PriceModeHigh)
+	if nonOracleError(err) {
+		return sdk.Coin{}, err
+	}
+	if err != nil {
+		// for missing prices on borrowed assets, no collateral is free
+		return none, nil
+	}
+
+	// if no borrows remain, or other collateral fully covers all borrows, all unbonded collateral is free
+	otherBorrowLimit, err := k.VisibleBorrowLimit(ctx, otherCollateral)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if borrowedValue.IsZero() || borrowedValue.LT(otherBorrowLimit) {
+		return unbondedCollateral, nil
+	}
+
+	// borrowers at or above their borrow limit have no free collateral
+	borrowLimit, err := k.VisibleBorrowLimit(ctx, totalCollateral)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if borrowLimit.LTE(borrowedValue) {
+		return none, nil
+	}
+
+	// free collateral is the fraction of this collateral's borrow limit contribution which is unused
+	specificBorrowLimit, err := k.CalculateBorrowLimit(ctx, sdk.NewCoins(thisCollateral))
+	if nonOracleError(err) {
+		return sdk.Coin{}, err
+	}
+	if err != nil || !specificBorrowLimit.IsPositive() {
+		return none, nil
+	}
+	unusedFraction := borrowLimit.Sub(borrowedValue).Quo(specificBorrowLimit)
+	free := unusedFraction.MulInt(thisCollateral.Amount).TruncateInt()
+
+	return sdk.NewCoin(uDenom, sdk.MinInt(free, unbondedCollateral.Amount)), nil
+}
+
+// FreeCollateral returns, for each of an account's collateral denoms, the amount of uTokens which is not
+// needed to cover its borrows and could be decollateralized or withdrawn. See userFreeCollateral.
+// Denoms with no free collateral are omitted.
+func (k Keeper) FreeCollateral(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coins, error) {
+	free := sdk.NewCoins()
+	for _, c := range k.GetBorrowerCollateral(ctx, addr) {
+		f, err := k.userFreeCollateral(ctx, addr, c.Denom)
+		if err != nil {
+			return nil, err
+		}
+		free = free.Add(f)
+	}
+	return free, nil
+}
+
+// RequiredCollateralForBorrow calculates the amount of a base token which must be supplied and collateralized for a borrow
+// to leave at least a fraction (safetyMargin) of the resulting borrow limit unused. If addr is nil, the borrow is
+// treated as a new account's only position. Otherwise the account's existing collateral and borrows are included.
+// Uses the same prices as borrow limit checks, and rounds up. Returns a zero coin if no collateral is needed.
+func (k Keeper) RequiredCollateralForBorrow(ctx sdk.Context, addr sdk.AccAddress, borrow sdk.Coin, collateralDenom string,
+	safetyMargin sdk.Dec,
+) (sdk.Coin, error) {
+	if err := k.validateBorrow(ctx, borrow); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.validateCollateralize(ctx, sdk.NewCoin(types.ToUTokenDenom(collateralDenom), sdk.ZeroInt())); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	borrowed := sdk.NewCoins(borrow)
+	collateral := sdk.NewCoins()
+	if addr != nil {
+		borrowed = k.GetBorrowerBorrows(ctx, addr).Add(borrow)
+		collateral = k.GetBorrowerCollateral(ctx, addr)
+	}
+	borrowedValue, err := k.TotalTokenValue(ctx, borrowed, types.PriceModeHigh)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	borrowLimit, err := k.VisibleBorrowLimit(ctx, collateral)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the resulting borrow limit must be borrowed value / (1 - margin)
+	deficit := borrowedValue.Quo(sdk.OneDec().Sub(safetyMargin)).Sub(borrowLimit)
+	if !deficit.IsPositive() {
+		return sdk.NewCoin(collateralDenom, sdk.ZeroInt()), nil
+	}
+
+	token, err := k.GetTokenSettings(ctx, collateralDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	price, exp, err := k.TokenPrice(ctx, collateralDenom, types.PriceModeLow)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	// amount = (deficit / collateral weight) * 10^exponent / price, rounded up
+	amount := exponent(deficit.Quo(token.CollateralWeight), int32(exp)).Quo(price).Ceil().TruncateInt()
+	return sdk.NewCoin(collateralDenom, amount), nil
+}
+
+// topUpAmount calculates the amount of base token collateral a borrower must supply and collateralize
+// for their health factor to reach a target after borrowing an additional asset. Health factor is
+// liquidation threshold divided by borrowed value, at spot prices. Returns a zero coin if the existing
+// collateral already meets the target, or ErrInsufficientTopUp if the maximum collateral provided cannot.
+func (k Keeper) topUpAmount(ctx sdk.Context, addr sdk.AccAddress, borrow, maxCollateral sdk.Coin, target sdk.Dec,
+) (sdk.Coin, error) {
+	zero := sdk.NewCoin(maxCollateral.Denom, sdk.ZeroInt())
+	borrowedValue, err := k.TotalTokenValue(ctx, k.GetBorrowerBorrows(ctx, addr).Add(borrow), types.PriceModeSpot)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	currentThreshold, err := k.CalculateLiquidationThreshold(ctx, k.GetBorrowerCollateral(ctx, addr))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	deficit := target.Mul(borrowedValue).Sub(currentThreshold)
+	if !deficit.IsPositive() {
+		return zero, nil
+	}
+
+	maxUTokens, err := k.ExchangeToken(ctx, maxCollateral)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	maxThreshold, err := k.CalculateLiquidationThreshold(ctx, sdk.NewCoins(maxUTokens))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if maxThreshold.LT(deficit) {
+		return sdk.Coin{}, types.ErrInsufficientTopUp.Wrapf(
+			"liquidation threshold short by %s, but %s provides only %s", deficit, maxCollateral, maxThreshold,
+		)
+	}
+
+	// liquidation threshold is linear in collateral amount, so scale the maximum collateral down,
+	// rounding up so the target is still reached
+	amount := deficit.Quo(maxThreshold).MulInt(maxCollateral.Amount).Ceil().TruncateInt()
+	return sdk.NewCoin(maxCollateral.Denom, sdk.MinInt(amount, maxCollateral.Amount)), nil
+}
+
+// checkWithdrawRate returns an error if withdrawing a base token would cause the total amount of
+// that token withdrawn during the current block to exceed its MaxWithdrawRatePerBlock, measured as
+// a fraction of the token's total supply at the start of the block. A rate of zero disables the check.
+func (k Keeper) checkWithdrawRate(ctx sdk.Context, token sdk.Coin) error {
+	rate := k.GetParams(ctx).MaxWithdrawRatePerBlock
+	if !rate.IsPositive() {
+		return nil
+	}
+	totalSupply, err := k.GetTotalSupply(ctx, token.Denom)
+	if err != nil {
+		return err
+	}
+	withdrawn := k.getBlockWithdrawals(ctx, token.Denom)
+	limit := rate.MulInt(totalSupply.Amount.Add(withdrawn)).TruncateInt()
+	if withdrawn.Add(token.Amount).GT(limit) {
+		return types.ErrMaxWithdrawRate.Wrapf("%s withdrawn this block, %s requested, limit %s",
+			withdrawn, token, limit)
+	}
+	return nil
+}
+
+// CanWithdraw determines whether an account could withdraw a given amount of uTokens, by performing
+// the withdrawal and all of the checks MsgWithdraw would perform on a cached context whose changes are
+// discarded. Returns nil if the withdrawal would succeed, or the error it would fail with.
+func (k Keeper) CanWithdraw(ctx sdk.Context, addr sdk.AccAddress, uToken sdk.Coin) error {
+	cacheCtx, _ := ctx.CacheContext()
+	received, _, isFromCollateral, err := k.Withdraw(cacheCtx, addr, uToken)
+	if err != nil {
+		return err
+	}
+	if isFromCollateral {
+		if err = k.assertBorrowerHealth(cacheCtx, addr); err != nil {
+			return err
+		}
+	}
+	return k.checkCollateralLiquidity(cacheCtx, received.Denom)
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"sort"
+
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -73,6 +75,15 @@ func (k Keeper) getLiquidationAmounts(
 	if maxRepayValue.LT(repayDenomBorrowedValue) {
 		maxRepayRatio := maxRepayValue.Quo(repayDenomBorrowedValue)
 		maxRepayAfterCloseFactor = maxRepayRatio.MulInt(totalBorrowed.AmountOf(repayDenom)).RoundInt()
+
+		// allow full repayment if the close factor would leave only dust behind
+		remainingValue, err := k.TokenValue(ctx, repayDenomBorrowed.SubAmount(maxRepayAfterCloseFactor), types.PriceModeSpot)
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+		}
+		if remainingValue.LT(params.LiquidationDustThreshold) {
+			maxRepayAfterCloseFactor = repayDenomBorrowed.Amount
+		}
 	}
 
 	// get precise (less rounding at high exponent) price ratio
@@ -111,6 +122,32 @@ func (k Keeper) getLiquidationAmounts(
 	return sdk.NewCoin(repayDenom, repay), sdk.NewCoin(collateralDenom, burn), sdk.NewCoin(rewardDenom, reward), nil
 }
 
+// protocolLiquidationCut returns the portion of a liquidation reward, in either base tokens or uTokens of
+// rewardDenom, which is added to reserves instead of being received by the liquidator. It is the
+// ProtocolLiquidationShare of the reward's liquidation incentive, so the liquidator still receives
+// at least the value it repaid.
+func (k Keeper) protocolLiquidationCut(
+	ctx sdk.Context, rewardDenom string, reward sdk.Coin, directLiquidation bool,
+) (sdk.Coin, error) {
+	params := k.GetParams(ctx)
+	if !params.ProtocolLiquidationShare.IsPositive() {
+		return sdk.NewCoin(reward.Denom, sdk.ZeroInt()), nil
+	}
+	ts, err := k.GetTokenSettings(ctx, rewardDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// use the same incentive as getLiquidationAmounts, including any direct liquidation fee
+	liquidationIncentive := ts.LiquidationIncentive
+	if directLiquidation {
+		liquidationIncentive = liquidationIncentive.Mul(sdk.OneDec().Sub(params.DirectLiquidationFee))
+	}
+	// reward = repaid value * (1 + incentive), so this is the part of the reward above the repaid value
+	incentiveAmount := toDec(reward.Amount).Mul(liquidationIncentive).Quo(sdk.OneDec().Add(liquidationIncentive))
+	return sdk.NewCoin(reward.Denom, incentiveAmount.Mul(params.ProtocolLiquidationShare).TruncateInt()), nil
+}
+
 // ComputeLiquidation takes the conditions preceding a liquidation and outputs the amounts
 // of base token that should be repaid, collateral uToken burned, and reward token allocated
 // as a result of the transaction, after accounting for limiting factors with as little
@@ -257,3 +294,268 @@ func ComputeCloseFactor(
 
 	return closeFactor
 }
+
+// checkLiquidationGuard returns an error if a guard denom is set and its current spot price is above
+// the guard price, or if its price cannot be determined. Liquidators use guard prices to avoid executing
+// liquidations after a price has recovered.
+func (k Keeper) checkLiquidationGuard(ctx sdk.Context, guardDenom string, guardPrice *sdk.Dec) error {
+	if guardDenom == "" {
+		return nil
+	}
+	if guardPrice == nil {
+		return types.ErrInvalidGuardPrice.Wrapf("missing guard price for %s", guardDenom)
+	}
+	price, _, err := k.TokenPrice(ctx, guardDenom, types.PriceModeSpot)
+	if err != nil {
+		return err
+	}
+	if price.GT(*guardPrice) {
+		return types.ErrGuardPriceExceeded.Wrapf("%s price %s > %s", guardDenom, price, guardPrice)
+	}
+	return nil
+}
+
+// FullLiquidationPlan computes a sequence of liquidations which together repay as much of an eligible borrower's
+// debt as the current close factor allows, with uToken rewards. The plan is built greedily: collateral with the
+// highest liquidation incentive is consumed first (ties broken by denom), repaying borrowed denoms in denom order.
+// Each step's repayment is a maximum, which liquidators can submit as MsgLiquidate repayment and reward denom.
+// Liquidator balances are not considered. Returns the plan and the close factor used.
+func (k Keeper) FullLiquidationPlan(ctx sdk.Context, borrowerAddr sdk.AccAddress) ([]types.LiquidationStep,
+	sdk.Dec, error,
+) {
+	collateral := k.GetBorrowerCollateral(ctx, borrowerAddr)
+	borrowed := k.GetBorrowerBorrows(ctx, borrowerAddr)
+
+	borrowedValue, closeFactor, err := k.liquidationCloseFactor(ctx, collateral, borrowed)
+	if err != nil {
+		return nil, sdk.ZeroDec(), err
+	}
+	// remaining USD value which can be repaid
+	remainingValue := borrowedValue.Mul(closeFactor)
+
+	// order reward tokens by liquidation incentive, highest first
+	rewardTokens := []types.Token{}
+	for _, c := range collateral {
+		token, err := k.GetTokenSettings(ctx, types.ToTokenDenom(c.Denom))
+		if err != nil {
+			return nil, sdk.ZeroDec(), err
+		}
+		rewardTokens = append(rewardTokens, token)
+	}
+	sort.SliceStable(rewardTokens, func(i, j int) bool {
+		return rewardTokens[i].LiquidationIncentive.GT(rewardTokens[j].LiquidationIncentive)
+	})
+
+	steps := []types.LiquidationStep{}
+	for _, token := range rewardTokens {
+		uDenom := types.ToUTokenDenom(token.BaseDenom)
+		exchangeRate := k.DeriveExchangeRate(ctx, token.BaseDenom)
+		for _, b := range borrowed {
+			if !remainingValue.IsPositive() {
+				return steps, closeFactor, nil
+			}
+			if collateral.AmountOf(uDenom).IsZero() || b.IsZero() {
+				continue
+			}
+
+			// limit repayment to the remaining value allowed by close factor
+			maxRepay := b.Amount
+			repayValue, err := k.TokenValue(ctx, b, types.PriceModeSpot)
+			if err != nil {
+				if nonOracleError(err) {
+					return nil, sdk.ZeroDec(), err
+				}
+				// borrowed tokens missing prices are skipped
+				continue
+			}
+			if remainingValue.LT(repayValue) {
+				maxRepay = remainingValue.Quo(repayValue).MulInt(b.Amount).TruncateInt()
+			}
+
+			priceRatio, err := k.PriceRatio(ctx, b.Denom, token.BaseDenom, types.PriceModeSpot)
+			if err != nil {
+				return nil, sdk.ZeroDec(), err
+			}
+			repay, burn, _ := ComputeLiquidation(
+				maxRepay,
+				collateral.AmountOf(uDenom),
+				k.AvailableLiquidity(ctx, token.BaseDenom),
+				priceRatio,
+				exchangeRate,
+				token.LiquidationIncentive,
+			)
+			if repay.IsZero() || burn.IsZero() {
+				continue
+			}
+
+			repayCoin := sdk.NewCoin(b.Denom, repay)
+			burnCoin := sdk.NewCoin(uDenom, burn)
+			protocolCut, err := k.protocolLiquidationCut(ctx, token.BaseDenom, burnCoin, false)
+			if err != nil {
+				return nil, sdk.ZeroDec(), err
+			}
+			steps = append(steps, types.LiquidationStep{
+				Repay:       repayCoin,
+				RewardDenom: uDenom,
+				Reward:      burnCoin.Sub(protocolCut),
+			})
+
+			// update remaining amounts for subsequent steps
+			repaidValue := sdk.MinDec(repayValue, repayValue.MulInt(repay).QuoInt(b.Amount))
+			remainingValue = remainingValue.Sub(repaidValue)
+			collateral = collateral.Sub(burnCoin)
+			borrowed = borrowed.Sub(sdk.NewCoin(b.Denom, sdk.MinInt(repay, b.Amount)))
+		}
+	}
+
+	return steps, closeFactor, nil
+}
+
+// liquidationCloseFactor returns the borrowed value and close factor of a borrower with the given collateral
+// and borrows, or ErrLiquidationIneligible if the borrower is healthy. Borrower health uses spot prices,
+// as in getLiquidationAmounts.
+func (k Keeper) liquidationCloseFactor(ctx sdk.Context, collateral, borrowed sdk.Coins) (
+	borrowedValue, closeFactor sdk.Dec, err error,
+) {
+	borrowedValue, err = k.VisibleTokenValue(ctx, borrowed, types.PriceModeSpot)
+	if err != nil {
+		return sdk.ZeroDec(), sdk.ZeroDec(), err
+	}
+	collateralValue, err := k.CalculateCollateralValue(ctx, collateral)
+	if err != nil {
+		return sdk.ZeroDec(), sdk.ZeroDec(), err
+	}
+	liquidationThreshold, err := k.CalculateLiquidationThreshold(ctx, collateral)
+	if err != nil {
+		return sdk.ZeroDec(), sdk.ZeroDec(), err
+	}
+	if borrowedValue.LT(liquidationThreshold) {
+		return sdk.ZeroDec(), sdk.ZeroDec(), types.ErrLiquidationIneligible
+	}
+
+	params := k.GetParams(ctx)
+	closeFactor = ComputeCloseFactor(
+		borrowedValue,
+		collateralValue,
+		liquidationThreshold,
+		params.SmallLiquidationSize,
+		params.MinimumCloseFactor,
+		params.CompleteLiquidationThreshold,
+	)
+	return borrowedValue, closeFactor, nil
+}
+
+// BestLiquidation evaluates a single liquidation with uToken rewards for every combination of an eligible
+// borrower's borrowed and collateral denoms, and returns the one with the highest liquidator profit: the
+// value of the reward received minus the value repaid, at spot prices. Each combination repays as much
+// as MsgLiquidate would allow, as limited by close factor, borrowed amount, collateral and liquidity, but
+// not by liquidator balance. Ties are broken by repay denom, then reward denom. Returns the repayment,
+// the uToken reward after any protocol liquidation share, and the profit. Combinations involving tokens
+// missing prices are skipped, and ErrLiquidationRepayZero is returned if none remain.
+func (k Keeper) BestLiquidation(ctx sdk.Context, borrowerAddr sdk.AccAddress) (
+	repay, reward sdk.Coin, profit sdk.Dec, err error,
+) {
+	collateral := k.GetBorrowerCollateral(ctx, borrowerAddr)
+	borrowed := k.GetBorrowerBorrows(ctx, borrowerAddr)
+
+	borrowedValue, closeFactor, err := k.liquidationCloseFactor(ctx, collateral, borrowed)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.ZeroDec(), err
+	}
+	params := k.GetParams(ctx)
+	// maximum USD value that can be repaid
+	maxRepayValue := borrowedValue.Mul(closeFactor)
+
+	found := false
+	for _, b := range borrowed {
+		repayDenomValue, err := k.TokenValue(ctx, b, types.PriceModeSpot)
+		if err != nil {
+			if nonOracleError(err) {
+				return sdk.Coin{}, sdk.Coin{}, sdk.ZeroDec(), err
+			}
+			// borrowed tokens missing prices are skipped
+			continue
+		}
+		// limit repayment by close factor, allowing full repayment if only dust would be left behind,
+		// as in getLiquidationAmounts
+		maxRepay := b.Amount
+		if maxRepayValue.LT(repayDenomValue) {
+			maxRepay = maxRepayValue.Quo(repayDenomValue).MulInt(b.Amount).RoundInt()
+			remainingValue, err := k.TokenValue(ctx, b.SubAmount(maxRepay), types.PriceModeSpot)
+			if err != nil {
+				return sdk.Coin{}, sdk.Coin{}, sdk.ZeroDec(), err
+			}
+			if remainingValue.LT(params.LiquidationDustThreshold) {
+				maxRepay = b.Amount
+			}
+		}
+
+		for _, c := range collateral {
+			rewardDenom := types.ToTokenDenom(c.Denom)
+			token, err := k.GetTokenSettings(ctx, rewardDenom)
+			if err != nil {
+				return sdk.Coin{}, sdk.Coin{}, sdk.ZeroDec(), err
+			}
+			priceRatio, err := k.PriceRatio(ctx, b.Denom, rewardDenom, types.PriceModeSpot)
+			if err != nil {
+				if nonOracleError(err) {
+					return sdk.Coin{}, sdk.Coin{}, sdk.ZeroDec(), err
+				}
+				continue
+			}
+			repayAmount, burn, _ := ComputeLiquidation(
+				maxRepay,
+				c.Amount,
+				k.AvailableLiquidity(ctx, rewardDenom),
+				priceRatio,
+				k.DeriveExchangeRate(ctx, rewardDenom),
+				token.LiquidationIncentive,
+			)
+			if repayAmount.IsZero() || burn.IsZero() {
+				continue
+			}
+
+			repayCoin := sdk.NewCoin(b.Denom, repayAmount)
+			burnCoin := sdk.NewCoin(c.Denom, burn)
+			protocolCut, err := k.protocolLiquidationCut(ctx, rewardDenom, burnCoin, false)
+			if err != nil {
+				return sdk.Coin{}, sdk.Coin{}, sdk.ZeroDec(), err
+			}
+			rewardCoin := burnCoin.Sub(protocolCut)
+
+			repaidValue, err := k.TokenValue(ctx, repayCoin, types.PriceModeSpot)
+			if err != nil {
+				return sdk.Coin{}, sdk.Coin{}, sdk.ZeroDec(), err
+			}
+			baseReward, err := k.ExchangeUToken(ctx, rewardCoin)
+			if err != nil {
+				return sdk.Coin{}, sdk.Coin{}, sdk.ZeroDec(), err
+			}
+			rewardValue, err := k.TokenValue(ctx, baseReward, types.PriceModeSpot)
+			if err != nil {
+				return sdk.Coin{}, sdk.Coin{}, sdk.ZeroDec(), err
+			}
+
+			if p := rewardValue.Sub(repaidValue); !found || p.GT(profit) {
+				repay, reward, profit, found = repayCoin, rewardCoin, p, true
+			}
+		}
+	}
+
+	if !found {
+		return sdk.Coin{}, sdk.Coin{}, sdk.ZeroDec(), types.ErrLiquidationRepayZero
+	}
+	return repay, reward, profit, nil
+}
+
+// addLiquidationRewardsPaid adds a reward paid to a liquidator to the cumulative liquidation rewards
+// paid in its base token. A uToken reward is counted at its current exchange rate.
+func (k Keeper) addLiquidationRewardsPaid(ctx sdk.Context, reward sdk.Coin) error {
+	if types.HasUTokenPrefix(reward.Denom) {
+		var err error
+		if reward, err = k.ExchangeUToken(ctx, reward); err != nil {
+			return err
+		}
+	}
+	return k.setLiquidationRewardsPaid(ctx, k.GetLiquidationRewardsPaid(ctx, reward.Denom).Add(reward))
+}
@@ -27,3 +27,18 @@ func ApproxExponential(x sdk.Dec) sdk.Dec {
 	sum = sum.Add(next.QuoInt64(6)) // 3!
 	return sum                      // approximated e^x
 }
+
+// ApproxLog1p approximates ln(1+x) for non-negative x using the series
+// ln(1+x) = 2 * (y + y^3/3 + y^5/5 + ...), where y = x / (2+x). Since 0 <= y < 1, the series
+// converges for all non-negative x, and terms are added until they are too small to affect the result.
+func ApproxLog1p(x sdk.Dec) sdk.Dec {
+	y := x.Quo(x.Add(sdk.NewDec(2)))
+	ySquared := y.Mul(y)
+	sum := sdk.ZeroDec()
+	power := y // y^(2n+1)
+	for n := int64(0); power.IsPositive() && n < 1000; n++ {
+		sum = sum.Add(power.QuoInt64(2*n + 1))
+		power = power.Mul(ySquared)
+	}
+	return sum.MulInt64(2)
+}
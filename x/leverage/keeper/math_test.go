@@ -5,6 +5,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"gotest.tools/v3/assert"
+
+	"github.com/umee-network/umee/v5/x/leverage/types"
 )
 
 func TestInterpolate(t *testing.T) {
@@ -40,3 +42,34 @@ func TestInterpolate(t *testing.T) {
 	result = Interpolate(x1, x1, y1, x1, y1)
 	assert.DeepEqual(t, y1, result)
 }
+
+func TestApproxLog1p(t *testing.T) {
+	assert.DeepEqual(t, sdk.ZeroDec(), ApproxLog1p(sdk.ZeroDec()))
+
+	// ln(2) = 0.693147180559945309..., and ln(e) = 1, accurate to within rounding of the last digits
+	tolerance := sdk.MustNewDecFromStr("0.000000000000001")
+	diff := ApproxLog1p(sdk.OneDec()).Sub(sdk.MustNewDecFromStr("0.693147180559945309")).Abs()
+	assert.Assert(t, diff.LT(tolerance), diff)
+	diff = ApproxLog1p(sdk.MustNewDecFromStr("1.718281828459045235")).Sub(sdk.OneDec()).Abs()
+	assert.Assert(t, diff.LT(tolerance), diff)
+}
+
+func TestCompoundInterest(t *testing.T) {
+	apy := sdk.MustNewDecFromStr("0.2")
+	day := sdk.OneDec().QuoInt64(365)
+
+	// compound a constant APY daily for a year under each mode
+	continuous, annual := sdk.OneDec(), sdk.OneDec()
+	for i := 0; i < 365; i++ {
+		continuous = continuous.Mul(compoundInterest(types.CompoundingMode_COMPOUNDING_MODE_CONTINUOUS, apy, day))
+		annual = annual.Mul(compoundInterest(types.CompoundingMode_COMPOUNDING_MODE_ANNUAL, apy, day))
+	}
+
+	// continuous compounding treats APY as a continuously compounded rate, growing by e^0.2 = 1.221402758...
+	tolerance := sdk.MustNewDecFromStr("0.000001")
+	diff := continuous.Sub(sdk.MustNewDecFromStr("1.221402758")).Abs()
+	assert.Assert(t, diff.LT(tolerance), diff)
+	// annual compounding grows by exactly the APY over a year
+	diff = annual.Sub(sdk.MustNewDecFromStr("1.2")).Abs()
+	assert.Assert(t, diff.LT(tolerance), diff)
+}
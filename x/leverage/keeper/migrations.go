@@ -2,6 +2,8 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v5/util/store"
 	"github.com/umee-network/umee/v5/x/leverage/types"
 )
 
@@ -15,6 +17,87 @@ func NewMigrator(keeper *Keeper) Migrator {
 	return Migrator{keeper: keeper}
 }
 
+// Migrate1to2 migrates from version 1 to 2. It sets every parameter which did not exist in version 1 to
+// a value which preserves the previous behavior, adds every address with open borrows to the liquidation
+// watchlist, and sets the borrower and collateral account counters. Lifetime reserves and liquidation
+// rewards paid did not exist in version 1, so they only count from this block onwards, and the current
+// height is recorded so queries can report when counting began.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	newParams := []struct {
+		key   []byte
+		value interface{}
+	}{
+		// interest accrues as it did before
+		{types.KeyCompoundingMode, types.CompoundingMode_COMPOUNDING_MODE_CONTINUOUS},
+		// liquidators continue to receive the full liquidation incentive
+		{types.KeyProtocolLiquidationShare, sdk.ZeroDec()},
+		// account leverage remains unlimited
+		{types.KeyMaxAccountLeverage, sdk.ZeroDec()},
+		// borrow APY continues to use current supply utilization
+		{types.KeyUtilizationSmoothingFactor, sdk.OneDec()},
+		// the price circuit breaker starts disabled
+		{types.KeyCircuitBreakerDeviation, sdk.ZeroDec()},
+		{types.KeyCircuitBreakerCooldownBlocks, uint64(0)},
+		// liquidations remain immediately possible
+		{types.KeyLiquidationGracePeriod, uint64(0)},
+		// the bad debt history starts empty
+		{types.KeyBadDebtHistorySize, types.DefaultParams().BadDebtHistorySize},
+		// assets missing prices are still skipped
+		{types.KeyZeroPricePolicy, types.ZeroPricePolicy_ZERO_PRICE_POLICY_EXCLUDE},
+		// borrowing is still allowed right after changing collateral
+		{types.KeyBorrowCooldownBlocks, uint64(0)},
+		// per-block withdrawals remain unlimited
+		{types.KeyMaxWithdrawRatePerBlock, sdk.ZeroDec()},
+		// borrowing and supplying are not paused
+		{types.KeyBorrowPaused, false},
+		{types.KeySupplyPaused, false},
+		// liquidations remain limited by close factor
+		{types.KeyLiquidationDustThreshold, sdk.ZeroDec()},
+	}
+	for _, p := range newParams {
+		if !m.keeper.paramSpace.Has(ctx, p.key) {
+			m.keeper.paramSpace.Set(ctx, p.key, p.value)
+		}
+	}
+
+	prefix := types.KeyPrefixAdjustedBorrow
+	iterator := func(key, _ []byte) error {
+		m.keeper.watchLiquidation(ctx, types.AddressFromKey(key, prefix))
+		return nil
+	}
+	if err := m.keeper.iterate(ctx, prefix, iterator); err != nil {
+		return err
+	}
+
+	borrowers, err := m.countAddresses(ctx, types.KeyPrefixAdjustedBorrow)
+	if err != nil {
+		return err
+	}
+	collateralAccounts, err := m.countAddresses(ctx, types.KeyPrefixCollateralAmount)
+	if err != nil {
+		return err
+	}
+	kvs := ctx.KVStore(m.keeper.storeKey)
+	store.SetInteger(kvs, types.KeyBorrowerCount, borrowers)
+	store.SetInteger(kvs, types.KeyCollateralAccountCount, collateralAccounts)
+	store.SetInteger(kvs, types.KeyLifetimeReservesHeight, ctx.BlockHeight())
+	store.SetInteger(kvs, types.KeyLiquidationRewardsHeight, ctx.BlockHeight())
+	return nil
+}
+
+// countAddresses counts the unique addresses in keys of the form prefix | lengthPrefixed(addr) | ...
+func (m Migrator) countAddresses(ctx sdk.Context, prefix []byte) (uint64, error) {
+	addrs := map[string]struct{}{}
+	iterator := func(key, _ []byte) error {
+		addrs[types.AddressFromKey(key, prefix).String()] = struct{}{}
+		return nil
+	}
+	if err := m.keeper.iterate(ctx, prefix, iterator); err != nil {
+		return 0, err
+	}
+	return uint64(len(addrs)), nil
+}
+
 // MigrateBNB fixes the BNB base denom for the 4.1 upgrade.
 // Also returns a boolean representing whether the token was changed.
 func (m Migrator) MigrateBNB(ctx sdk.Context) (bool, error) {
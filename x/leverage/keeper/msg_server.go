@@ -69,7 +69,14 @@ func (s msgServer) Withdraw(
 	if err != nil {
 		return nil, err
 	}
-	received, isFromCollateral, err := s.keeper.Withdraw(ctx, supplierAddr, msg.Asset)
+	recipientAddr := supplierAddr
+	if msg.Recipient != "" {
+		recipientAddr, err = sdk.AccAddressFromBech32(msg.Recipient)
+		if err != nil {
+			return nil, err
+		}
+	}
+	received, fee, isFromCollateral, err := s.keeper.WithdrawTo(ctx, supplierAddr, recipientAddr, msg.Asset)
 	if err != nil {
 		return nil, err
 	}
@@ -88,7 +95,7 @@ func (s msgServer) Withdraw(
 		return nil, err
 	}
 
-	s.logWithdrawal(ctx, msg.Supplier, msg.Asset, received, "supplied assets withdrawn")
+	s.logWithdrawal(ctx, msg.Supplier, msg.Recipient, msg.Asset, received, fee, "supplied assets withdrawn")
 	return &types.MsgWithdrawResponse{
 		Received: received,
 	}, nil
@@ -142,7 +149,7 @@ func (s msgServer) MaxWithdraw(
 	uToken.Amount = sdk.MinInt(uToken.Amount, uTokenTotalAvailable)
 
 	// Proceed to withdraw.
-	received, isFromCollateral, err := s.keeper.Withdraw(ctx, supplierAddr, uToken)
+	received, fee, isFromCollateral, err := s.keeper.Withdraw(ctx, supplierAddr, uToken)
 	if err != nil {
 		return nil, err
 	}
@@ -161,24 +168,32 @@ func (s msgServer) MaxWithdraw(
 		return nil, err
 	}
 
-	s.logWithdrawal(ctx, msg.Supplier, uToken, received, "maximum supplied assets withdrawn")
+	s.logWithdrawal(ctx, msg.Supplier, "", uToken, received, fee, "maximum supplied assets withdrawn")
 	return &types.MsgMaxWithdrawResponse{
 		Withdrawn: uToken,
 		Received:  received,
 	}, nil
 }
 
-func (s msgServer) logWithdrawal(ctx sdk.Context, supplier string, redeemed, received sdk.Coin, desc string) {
+// logWithdrawal logs and emits an event for a withdrawal. Recipient is empty if the supplier
+// received the withdrawn base tokens. Fee is the portion of withdrawn base tokens added to reserves.
+func (s msgServer) logWithdrawal(ctx sdk.Context, supplier, recipient string, redeemed, received, fee sdk.Coin,
+	desc string,
+) {
 	s.keeper.Logger(ctx).Debug(
 		desc,
 		"supplier", supplier,
+		"recipient", recipient,
 		"redeemed", redeemed.String(),
 		"received", received.String(),
+		"fee", fee.String(),
 	)
 	sdkutil.Emit(&ctx, &types.EventWithdraw{
-		Supplier: supplier,
-		Utoken:   redeemed,
-		Asset:    received,
+		Supplier:  supplier,
+		Utoken:    redeemed,
+		Asset:     received,
+		Recipient: recipient,
+		Fee:       fee,
 	})
 }
 
@@ -428,7 +443,14 @@ func (s msgServer) Repay(
 	if err != nil {
 		return nil, err
 	}
-	repaid, err := s.keeper.Repay(ctx, borrowerAddr, msg.Asset)
+	recipientAddr := borrowerAddr
+	if msg.Recipient != "" {
+		recipientAddr, err = sdk.AccAddressFromBech32(msg.Recipient)
+		if err != nil {
+			return nil, err
+		}
+	}
+	repaid, err := s.keeper.RepayFor(ctx, borrowerAddr, recipientAddr, msg.Asset)
 	if err != nil {
 		return nil, err
 	}
@@ -436,18 +458,192 @@ func (s msgServer) Repay(
 	s.keeper.Logger(ctx).Debug(
 		"borrowed assets repaid",
 		"borrower", msg.Borrower,
+		"recipient", msg.Recipient,
 		"attempted", msg.Asset.String(),
 		"repaid", repaid.String(),
 	)
 	sdkutil.Emit(&ctx, &types.EventRepay{
-		Borrower: msg.Borrower,
-		Repaid:   repaid,
+		Borrower:  msg.Borrower,
+		Repaid:    repaid,
+		Recipient: msg.Recipient,
 	})
 	return &types.MsgRepayResponse{
 		Repaid: repaid,
 	}, nil
 }
 
+func (s msgServer) RepayWithdraw(
+	goCtx context.Context,
+	msg *types.MsgRepayWithdraw,
+) (*types.MsgRepayWithdrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	borrowerAddr, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return nil, err
+	}
+	repaid, received, fee, err := s.keeper.RepayWithdraw(ctx, borrowerAddr, msg.Repay, msg.Withdraw)
+	if err != nil {
+		return nil, err
+	}
+
+	s.keeper.Logger(ctx).Debug(
+		"borrowed assets repaid",
+		"borrower", msg.Borrower,
+		"attempted", msg.Repay.String(),
+		"repaid", repaid.String(),
+	)
+	sdkutil.Emit(&ctx, &types.EventRepay{
+		Borrower: msg.Borrower,
+		Repaid:   repaid,
+	})
+	s.logWithdrawal(ctx, msg.Borrower, "", msg.Withdraw, received, fee, "supplied assets withdrawn")
+	return &types.MsgRepayWithdrawResponse{
+		Repaid:   repaid,
+		Received: received,
+	}, nil
+}
+
+func (s msgServer) BorrowWithTopUp(
+	goCtx context.Context,
+	msg *types.MsgBorrowWithTopUp,
+) (*types.MsgBorrowWithTopUpResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	borrowerAddr, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return nil, err
+	}
+	supplied, uToken, err := s.keeper.BorrowWithTopUp(ctx, borrowerAddr, msg.Asset, msg.Collateral,
+		msg.TargetHealthFactor)
+	if err != nil {
+		return nil, err
+	}
+
+	if supplied.IsPositive() {
+		s.keeper.Logger(ctx).Debug(
+			"collateral topped up",
+			"borrower", msg.Borrower,
+			"maximum", msg.Collateral.String(),
+			"supplied", supplied.String(),
+			"collateralized", uToken.String(),
+		)
+		sdkutil.Emit(&ctx, &types.EventSupply{
+			Supplier: msg.Borrower,
+			Asset:    supplied,
+			Utoken:   uToken,
+		})
+		sdkutil.Emit(&ctx, &types.EventCollaterize{
+			Borrower: msg.Borrower,
+			Utoken:   uToken,
+		})
+	}
+	s.keeper.Logger(ctx).Debug(
+		"assets borrowed",
+		"borrower", msg.Borrower,
+		"amount", msg.Asset.String(),
+	)
+	sdkutil.Emit(&ctx, &types.EventBorrow{
+		Borrower: msg.Borrower,
+		Asset:    msg.Asset,
+	})
+	return &types.MsgBorrowWithTopUpResponse{
+		Supplied:       supplied,
+		Collateralized: uToken,
+	}, nil
+}
+
+func (s msgServer) LeverageLoop(
+	goCtx context.Context,
+	msg *types.MsgLeverageLoop,
+) (*types.MsgLeverageLoopResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	supplierAddr, err := sdk.AccAddressFromBech32(msg.Supplier)
+	if err != nil {
+		return nil, err
+	}
+	uToken, borrowed, limit, err := s.keeper.LeverageLoop(ctx, supplierAddr, msg.Asset, msg.TargetLeverage)
+	if err != nil {
+		return nil, err
+	}
+	achieved := sdk.NewDecFromInt(msg.Asset.Amount.Add(borrowed.Amount)).QuoInt(msg.Asset.Amount)
+
+	s.keeper.Logger(ctx).Debug(
+		"leverage loop",
+		"supplier", msg.Supplier,
+		"supplied", msg.Asset.Add(borrowed).String(),
+		"borrowed", borrowed.String(),
+		"collateralized", uToken.String(),
+		"achieved_leverage", achieved.String(),
+		"limit", limit.String(),
+	)
+	sdkutil.Emit(&ctx, &types.EventSupply{
+		Supplier: msg.Supplier,
+		Asset:    msg.Asset.Add(borrowed),
+		Utoken:   uToken,
+	})
+	sdkutil.Emit(&ctx, &types.EventCollaterize{
+		Borrower: msg.Supplier,
+		Utoken:   uToken,
+	})
+	if borrowed.IsPositive() {
+		sdkutil.Emit(&ctx, &types.EventBorrow{
+			Borrower: msg.Supplier,
+			Asset:    borrowed,
+		})
+	}
+	return &types.MsgLeverageLoopResponse{
+		Collateralized:   uToken,
+		Borrowed:         borrowed,
+		TargetLeverage:   msg.TargetLeverage,
+		AchievedLeverage: achieved,
+		Limit:            limit,
+	}, nil
+}
+
+func (s msgServer) SwapCollateral(
+	goCtx context.Context,
+	msg *types.MsgSwapCollateral,
+) (*types.MsgSwapCollateralResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	borrowerAddr, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return nil, err
+	}
+	received, fee, uToken, err := s.keeper.SwapCollateral(ctx, borrowerAddr, msg.Withdraw, msg.Collateral)
+	if err != nil {
+		return nil, err
+	}
+
+	s.keeper.Logger(ctx).Debug(
+		"collateral removed",
+		"borrower", msg.Borrower,
+		"amount", msg.Withdraw.String(),
+	)
+	sdkutil.Emit(&ctx, &types.EventDecollaterize{
+		Borrower: msg.Borrower,
+		Utoken:   msg.Withdraw,
+	})
+	s.logWithdrawal(ctx, msg.Borrower, "", msg.Withdraw, received, fee, "collateral swap assets withdrawn")
+	s.keeper.Logger(ctx).Debug(
+		"collateral swap assets supplied",
+		"supplier", msg.Borrower,
+		"supplied", msg.Collateral.String(),
+		"received", uToken.String(),
+	)
+	sdkutil.Emit(&ctx, &types.EventSupply{
+		Supplier: msg.Borrower,
+		Asset:    msg.Collateral,
+		Utoken:   uToken,
+	})
+	sdkutil.Emit(&ctx, &types.EventCollaterize{
+		Borrower: msg.Borrower,
+		Utoken:   uToken,
+	})
+	return &types.MsgSwapCollateralResponse{
+		Received:       received,
+		Collateralized: uToken,
+	}, nil
+}
+
 func (s msgServer) Liquidate(
 	goCtx context.Context,
 	msg *types.MsgLiquidate,
@@ -462,6 +658,9 @@ func (s msgServer) Liquidate(
 	if err != nil {
 		return nil, err
 	}
+	if err := s.keeper.checkLiquidationGuard(ctx, msg.GuardDenom, msg.GuardPrice); err != nil {
+		return nil, err
+	}
 	repaid, liquidated, reward, err := s.keeper.Liquidate(ctx, liquidator, borrower, msg.Repayment, msg.RewardDenom)
 	if err != nil {
 		return nil, err
@@ -504,6 +703,11 @@ func (s msgServer) GovUpdateRegistry(
 		regdSymDenoms[strings.ToUpper(token.SymbolDenom)] = true
 	}
 
+	// proposed uToken denoms cannot collide with any registered or proposed denom
+	if err := types.ValidateUTokenDenoms(registeredTokens, msg.ProposedTokens()); err != nil {
+		return nil, err
+	}
+
 	// update the token settings
 	err := s.keeper.SaveOrUpdateTokenSettingsToRegistry(ctx, msg.UpdateTokens, regdTkDenoms, regdSymDenoms, true)
 	if err != nil {
@@ -524,3 +728,179 @@ func (s msgServer) GovUpdateRegistry(
 
 	return &types.MsgGovUpdateRegistryResponse{}, nil
 }
+
+func (s msgServer) SetStopLoss(
+	goCtx context.Context,
+	msg *types.MsgSetStopLoss,
+) (*types.MsgSetStopLossResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	borrowerAddr, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return nil, err
+	}
+	var executorAddr sdk.AccAddress
+	if msg.Executor != "" {
+		executorAddr, err = sdk.AccAddressFromBech32(msg.Executor)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := s.keeper.SetStopLoss(ctx, borrowerAddr, executorAddr, msg.TargetHealthFactor); err != nil {
+		return nil, err
+	}
+
+	s.keeper.Logger(ctx).Debug(
+		"stop-loss set",
+		"borrower", msg.Borrower,
+		"executor", msg.Executor,
+		"target_health_factor", msg.TargetHealthFactor.String(),
+	)
+	sdkutil.Emit(&ctx, &types.EventSetStopLoss{
+		Borrower:           msg.Borrower,
+		Executor:           msg.Executor,
+		TargetHealthFactor: msg.TargetHealthFactor,
+	})
+	return &types.MsgSetStopLossResponse{}, nil
+}
+
+func (s msgServer) ExecuteStopLoss(
+	goCtx context.Context,
+	msg *types.MsgExecuteStopLoss,
+) (*types.MsgExecuteStopLossResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	executorAddr, err := sdk.AccAddressFromBech32(msg.Executor)
+	if err != nil {
+		return nil, err
+	}
+	borrowerAddr, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return nil, err
+	}
+	received, fee, repaid, err := s.keeper.ExecuteStopLoss(ctx, executorAddr, borrowerAddr, msg.Withdraw, msg.Repay)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logWithdrawal(ctx, msg.Borrower, "", msg.Withdraw, received, fee, "stop-loss supplied assets withdrawn")
+	s.keeper.Logger(ctx).Debug(
+		"stop-loss borrowed assets repaid",
+		"borrower", msg.Borrower,
+		"executor", msg.Executor,
+		"attempted", msg.Repay.String(),
+		"repaid", repaid.String(),
+	)
+	sdkutil.Emit(&ctx, &types.EventRepay{
+		Borrower: msg.Borrower,
+		Repaid:   repaid,
+	})
+	sdkutil.Emit(&ctx, &types.EventExecuteStopLoss{
+		Borrower: msg.Borrower,
+		Executor: msg.Executor,
+	})
+	return &types.MsgExecuteStopLossResponse{
+		Received: received,
+		Repaid:   repaid,
+	}, nil
+}
+
+// FreezeAccount freezes an account, preventing it from supplying, withdrawing, borrowing, or liquidating.
+func (s msgServer) FreezeAccount(
+	goCtx context.Context,
+	msg *types.MsgFreezeAccount,
+) (*types.MsgFreezeAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.keeper.setAccountFrozen(ctx, addr, true); err != nil {
+		return nil, err
+	}
+
+	sdkutil.Emit(&ctx, &types.EventFreezeAccount{
+		Address: msg.Address,
+	})
+	return &types.MsgFreezeAccountResponse{}, nil
+}
+
+// UnfreezeAccount unfreezes a frozen account.
+func (s msgServer) UnfreezeAccount(
+	goCtx context.Context,
+	msg *types.MsgUnfreezeAccount,
+) (*types.MsgUnfreezeAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.keeper.setAccountFrozen(ctx, addr, false); err != nil {
+		return nil, err
+	}
+
+	sdkutil.Emit(&ctx, &types.EventUnfreezeAccount{
+		Address: msg.Address,
+	})
+	return &types.MsgUnfreezeAccountResponse{}, nil
+}
+
+// SetAccountBorrowCap sets or clears the maximum USD value an account can borrow.
+func (s msgServer) SetAccountBorrowCap(
+	goCtx context.Context,
+	msg *types.MsgSetAccountBorrowCap,
+) (*types.MsgSetAccountBorrowCapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.keeper.setAccountBorrowCap(ctx, addr, msg.BorrowCap); err != nil {
+		return nil, err
+	}
+
+	sdkutil.Emit(&ctx, &types.EventSetAccountBorrowCap{
+		Address:   msg.Address,
+		BorrowCap: msg.BorrowCap,
+	})
+	return &types.MsgSetAccountBorrowCapResponse{}, nil
+}
+
+// WithdrawReserves sends reserves to a recipient designated by governance.
+func (s msgServer) WithdrawReserves(
+	goCtx context.Context,
+	msg *types.MsgWithdrawReserves,
+) (*types.MsgWithdrawReservesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	recipientAddr, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+	withdrawn, err := s.keeper.WithdrawReserves(ctx, recipientAddr, msg.Asset)
+	if err != nil {
+		return nil, err
+	}
+
+	s.keeper.Logger(ctx).Debug(
+		"reserves withdrawn",
+		"recipient", recipientAddr.String(),
+		"withdrawn", withdrawn.String(),
+	)
+	return &types.MsgWithdrawReservesResponse{Withdrawn: withdrawn}, nil
+}
+
+// SetOracleSymbol changes the oracle symbol of a registered token.
+func (s msgServer) SetOracleSymbol(
+	goCtx context.Context,
+	msg *types.MsgSetOracleSymbol,
+) (*types.MsgSetOracleSymbolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := s.keeper.SetOracleSymbol(ctx, msg.Denom, msg.SymbolDenom); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetOracleSymbolResponse{}, nil
+}
@@ -5,10 +5,12 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/umee-network/umee/v5/util/coin"
 	"github.com/umee-network/umee/v5/x/leverage/fixtures"
 	"github.com/umee-network/umee/v5/x/leverage/types"
+	oracletypes "github.com/umee-network/umee/v5/x/oracle/types"
 )
 
 func (s *IntegrationTestSuite) TestAddTokensToRegistry() {
@@ -184,6 +186,33 @@ func (s *IntegrationTestSuite) TestUpdateRegistry() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGovUpdateRegistry_DenomCollision() {
+	govAccAddr := s.app.GovKeeper.GetGovernanceAccount(s.ctx).GetAddress().String()
+	update := func(add, update []types.Token) error {
+		_, err := s.msgSrvr.GovUpdateRegistry(s.ctx, &types.MsgGovUpdateRegistry{
+			Authority:    govAccAddr,
+			Title:        "test",
+			Description:  "test",
+			AddTokens:    add,
+			UpdateTokens: update,
+		})
+		return err
+	}
+
+	// a new token whose base denom is the uToken denom of registered UMEE
+	err := update([]types.Token{fixtures.Token("u/uumee", "UUMEE", 6)}, nil)
+	s.Require().ErrorIs(err, types.ErrDenomCollision)
+	s.Require().ErrorContains(err, "base denom u/uumee equals the uToken denom of uumee")
+
+	// a token which is both added and updated in the same proposal
+	err = update([]types.Token{fixtures.Token("unta", "ABCD", 6)}, []types.Token{fixtures.Token("unta", "ABCD", 6)})
+	s.Require().ErrorIs(err, types.ErrDenomCollision)
+	s.Require().ErrorContains(err, "uToken denom u/unta of unta equals the uToken denom of unta")
+
+	// updating a registered token does not collide with its own denoms
+	s.Require().NoError(update(nil, []types.Token{fixtures.Token("uumee", "UMEE", 6)}))
+}
+
 func (s *IntegrationTestSuite) TestMsgSupply() {
 	type testCase struct {
 		msg             string
@@ -506,6 +535,161 @@ func (s *IntegrationTestSuite) TestMsgWithdraw() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestMsgWithdraw_Recipient() {
+	app, ctx, srv, require := s.app, s.ctx, s.msgSrvr, s.Require()
+
+	// create and fund a borrower which supplies and collateralizes 100 UMEE, then borrows 20 UMEE
+	borrower := s.newAccount(coin.New(umeeDenom, 100_000000))
+	s.supply(borrower, coin.New(umeeDenom, 100_000000))
+	s.collateralize(borrower, coin.New("u/"+umeeDenom, 100_000000))
+	s.borrow(borrower, coin.New(umeeDenom, 20_000000))
+	treasury := s.newAccount()
+
+	// the recipient must be a valid address
+	msg := &types.MsgWithdraw{Supplier: borrower.String(), Asset: coin.New("u/"+umeeDenom, 10_000000), Recipient: "x"}
+	require.ErrorContains(msg.ValidateBasic(), "recipient")
+
+	// withdrawing to a recipient takes uTokens from the signer's collateral and sends base tokens to the recipient
+	resp, err := srv.Withdraw(ctx, types.NewMsgWithdrawTo(borrower, treasury, coin.New("u/"+umeeDenom, 10_000000)))
+	require.NoError(err)
+	require.Equal(coin.New(umeeDenom, 10_000000), resp.Received)
+	require.Equal(coin.New("u/"+umeeDenom, 90_000000), app.LeverageKeeper.GetCollateral(ctx, borrower, "u/"+umeeDenom))
+	require.Equal(coin.New(umeeDenom, 10_000000), app.BankKeeper.GetBalance(ctx, treasury, umeeDenom))
+	require.Equal(coin.New(umeeDenom, 20_000000), app.BankKeeper.GetBalance(ctx, borrower, umeeDenom))
+
+	// the borrow limit applies to the signer: 70 UMEE collateral would only allow 17.5 UMEE borrowed
+	_, err = srv.Withdraw(ctx, types.NewMsgWithdrawTo(borrower, treasury, coin.New("u/"+umeeDenom, 20_000000)))
+	require.ErrorIs(err, types.ErrUndercollaterized)
+}
+
+func (s *IntegrationTestSuite) TestMsgRepay_Recipient() {
+	app, ctx, srv, require := s.app, s.ctx, s.msgSrvr, s.Require()
+
+	// create and fund a borrower which supplies and collateralizes 100 UMEE, then borrows 20 UMEE
+	borrower := s.newAccount(coin.New(umeeDenom, 100_000000))
+	s.supply(borrower, coin.New(umeeDenom, 100_000000))
+	s.collateralize(borrower, coin.New("u/"+umeeDenom, 100_000000))
+	s.borrow(borrower, coin.New(umeeDenom, 20_000000))
+	// create a payer with 30 UMEE and no positions
+	payer := s.newAccount(coin.New(umeeDenom, 30_000000))
+
+	// the recipient must be a valid address
+	msg := &types.MsgRepay{Borrower: payer.String(), Asset: coin.New(umeeDenom, 5_000000), Recipient: "x"}
+	require.ErrorContains(msg.ValidateBasic(), "recipient")
+
+	// repaying for a recipient reduces the recipient's borrow using the signer's funds
+	resp, err := srv.Repay(ctx, types.NewMsgRepayFor(payer, borrower, coin.New(umeeDenom, 5_000000)))
+	require.NoError(err)
+	require.Equal(coin.New(umeeDenom, 5_000000), resp.Repaid)
+	require.Equal(coin.New(umeeDenom, 15_000000), app.LeverageKeeper.GetBorrow(ctx, borrower, umeeDenom))
+	require.Equal(coin.New(umeeDenom, 25_000000), app.BankKeeper.GetBalance(ctx, payer, umeeDenom))
+	require.Equal(coin.New(umeeDenom, 20_000000), app.BankKeeper.GetBalance(ctx, borrower, umeeDenom))
+
+	// overpayment is capped at the recipient's remaining borrow
+	resp, err = srv.Repay(ctx, types.NewMsgRepayFor(payer, borrower, coin.New(umeeDenom, 30_000000)))
+	require.NoError(err)
+	require.Equal(coin.New(umeeDenom, 15_000000), resp.Repaid)
+	require.True(app.LeverageKeeper.GetBorrow(ctx, borrower, umeeDenom).IsZero())
+	require.Equal(coin.New(umeeDenom, 10_000000), app.BankKeeper.GetBalance(ctx, payer, umeeDenom))
+	require.Equal(coin.New(umeeDenom, 20_000000), app.BankKeeper.GetBalance(ctx, borrower, umeeDenom))
+}
+
+func (s *IntegrationTestSuite) TestMsgWithdraw_RateLimit() {
+	app, ctx, srv, require := s.app, s.ctx, s.msgSrvr, s.Require()
+
+	// limit withdrawals to 30% of each token's supply per block
+	params := app.LeverageKeeper.GetParams(ctx)
+	params.MaxWithdrawRatePerBlock = sdk.MustNewDecFromStr("0.3")
+	app.LeverageKeeper.SetParams(ctx, params)
+
+	// create and fund a supplier which supplies 100 UMEE
+	supplier := s.newAccount(coin.New(umeeDenom, 100_000000))
+	s.supply(supplier, coin.New(umeeDenom, 100_000000))
+
+	withdraw := func(amount int64) error {
+		_, err := srv.Withdraw(ctx, &types.MsgWithdraw{
+			Supplier: supplier.String(),
+			Asset:    coin.New("u/"+umeeDenom, amount),
+		})
+		return err
+	}
+
+	// 20 UMEE out of 100 is within the limit
+	require.NoError(withdraw(20_000000))
+	// a further 15 UMEE would bring this block's withdrawals to 35 out of 100
+	require.ErrorIs(withdraw(15_000000), types.ErrMaxWithdrawRate)
+	// a further 10 UMEE reaches the limit exactly
+	require.NoError(withdraw(10_000000))
+	require.ErrorIs(withdraw(1), types.ErrMaxWithdrawRate)
+
+	// the limit resets in the next block, when the transient store has been discarded and 21 out of
+	// the remaining 70 UMEE is allowed
+	ctx.TransientStore(app.GetTKey(types.TStoreKey)).Delete(types.KeyBlockWithdrawals(umeeDenom))
+	require.ErrorIs(withdraw(21_000001), types.ErrMaxWithdrawRate)
+	require.NoError(withdraw(21_000000))
+}
+
+func (s *IntegrationTestSuite) TestMsgWithdraw_Fee() {
+	app, ctx, srv, require := s.app, s.ctx, s.msgSrvr, s.Require()
+
+	// ATOM charges a 0.3% withdraw fee
+	atom, err := app.LeverageKeeper.GetTokenSettings(ctx, atomDenom)
+	require.NoError(err)
+	atom.WithdrawFee = sdk.MustNewDecFromStr("0.003")
+	require.NoError(app.LeverageKeeper.SetTokenSettings(ctx, atom))
+
+	// create and fund two suppliers which each supply 100 ATOM
+	supplier := s.newAccount(coin.New(atomDenom, 100_000000))
+	s.supply(supplier, coin.New(atomDenom, 100_000000))
+	other := s.newAccount(coin.New(atomDenom, 100_000000))
+	s.supply(other, coin.New(atomDenom, 100_000000))
+
+	reserves := app.LeverageKeeper.GetReserves(ctx, atomDenom)
+	rate := app.LeverageKeeper.DeriveExchangeRate(ctx, atomDenom)
+
+	// withdrawing 10 ATOM sends 0.03 ATOM to reserves and 9.97 ATOM to the supplier
+	eventCtx := ctx.WithEventManager(sdk.NewEventManager())
+	resp, err := srv.Withdraw(eventCtx, types.NewMsgWithdraw(supplier, coin.New("u/"+atomDenom, 10_000000)))
+	require.NoError(err)
+	require.Equal(coin.New(atomDenom, 9_970000), resp.Received)
+	require.Equal(coin.New(atomDenom, 9_970000), app.BankKeeper.GetBalance(ctx, supplier, atomDenom))
+	require.Equal(reserves.AddAmount(sdk.NewInt(30000)), app.LeverageKeeper.GetReserves(ctx, atomDenom))
+	// the fee is not shared with remaining suppliers
+	require.Equal(rate, app.LeverageKeeper.DeriveExchangeRate(ctx, atomDenom))
+
+	// the fee is included in the withdraw event
+	var event *types.EventWithdraw
+	for _, e := range eventCtx.EventManager().Events() {
+		if msg, err := sdk.ParseTypedEvent(abci.Event(e)); err == nil {
+			if withdrawal, ok := msg.(*types.EventWithdraw); ok {
+				event = withdrawal
+			}
+		}
+	}
+	require.NotNil(event)
+	require.Equal(coin.New(atomDenom, 30000), event.Fee)
+
+	// the fee is rounded down, so 333 ATOM base units pay no fee
+	resp, err = srv.Withdraw(ctx, types.NewMsgWithdraw(supplier, coin.New("u/"+atomDenom, 333)))
+	require.NoError(err)
+	require.Equal(coin.New(atomDenom, 333), resp.Received)
+	require.Equal(reserves.AddAmount(sdk.NewInt(30000)), app.LeverageKeeper.GetReserves(ctx, atomDenom))
+
+	// MsgMaxWithdraw also pays the fee
+	maxResp, err := srv.MaxWithdraw(ctx, types.NewMsgMaxWithdraw(other, atomDenom))
+	require.NoError(err)
+	require.Equal(coin.New("u/"+atomDenom, 100_000000), maxResp.Withdrawn)
+	require.Equal(coin.New(atomDenom, 99_700000), maxResp.Received)
+	require.Equal(reserves.AddAmount(sdk.NewInt(330000)), app.LeverageKeeper.GetReserves(ctx, atomDenom))
+
+	// UMEE charges no fee
+	umeeSupplier := s.newAccount(coin.New(umeeDenom, 100_000000))
+	s.supply(umeeSupplier, coin.New(umeeDenom, 100_000000))
+	resp, err = srv.Withdraw(ctx, types.NewMsgWithdraw(umeeSupplier, coin.New("u/"+umeeDenom, 10_000000)))
+	require.NoError(err)
+	require.Equal(coin.New(umeeDenom, 10_000000), resp.Received)
+}
+
 func (s *IntegrationTestSuite) TestMsgMaxWithdraw() {
 	app, ctx, srv, require := s.app, s.ctx, s.msgSrvr, s.Require()
 
@@ -1387,6 +1571,142 @@ func (s *IntegrationTestSuite) TestMsgBorrow() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestMsgBorrow_NoSelfBorrow() {
+	app, ctx, srv, require := s.app, s.ctx, s.msgSrvr, s.Require()
+
+	// ATOM cannot be borrowed by accounts using it as collateral
+	atom, err := app.LeverageKeeper.GetTokenSettings(ctx, atomDenom)
+	require.NoError(err)
+	atom.NoSelfBorrow = true
+	require.NoError(app.LeverageKeeper.SetTokenSettings(ctx, atom))
+
+	// create and fund a supplier which supplies 100 UMEE and 100 ATOM
+	supplier := s.newAccount(coin.New(umeeDenom, 100_000000), coin.New(atomDenom, 100_000000))
+	s.supply(supplier, coin.New(umeeDenom, 100_000000), coin.New(atomDenom, 100_000000))
+
+	// create a borrower which collateralizes 100 ATOM
+	atomBorrower := s.newAccount(coin.New(atomDenom, 100_000000))
+	s.supply(atomBorrower, coin.New(atomDenom, 100_000000))
+	s.collateralize(atomBorrower, coin.New("u/"+atomDenom, 100_000000))
+
+	// create a borrower which collateralizes 100 UMEE and supplies, but does not collateralize, 10 ATOM
+	umeeBorrower := s.newAccount(coin.New(umeeDenom, 100_000000), coin.New(atomDenom, 10_000000))
+	s.supply(umeeBorrower, coin.New(umeeDenom, 100_000000), coin.New(atomDenom, 10_000000))
+	s.collateralize(umeeBorrower, coin.New("u/"+umeeDenom, 100_000000))
+
+	// borrowing ATOM against ATOM collateral is rejected
+	_, err = srv.Borrow(ctx, types.NewMsgBorrow(atomBorrower, coin.New(atomDenom, 1_000000)))
+	require.ErrorIs(err, types.ErrSelfBorrow)
+
+	// other tokens can still be borrowed against ATOM collateral
+	_, err = srv.Borrow(ctx, types.NewMsgBorrow(atomBorrower, coin.New(umeeDenom, 10_000000)))
+	require.NoError(err)
+
+	// ATOM can be borrowed by an account which supplies it without collateralizing it
+	_, err = srv.Borrow(ctx, types.NewMsgBorrow(umeeBorrower, coin.New(atomDenom, 1_000000)))
+	require.NoError(err)
+	require.Equal(coin.New(atomDenom, 1_000000), app.LeverageKeeper.GetBorrow(ctx, umeeBorrower, atomDenom))
+
+	// UMEE allows self borrowing
+	_, err = srv.Borrow(ctx, types.NewMsgBorrow(umeeBorrower, coin.New(umeeDenom, 1_000000)))
+	require.NoError(err)
+}
+
+func (s *IntegrationTestSuite) TestMsgBorrow_Cooldown() {
+	app, ctx, srv, require := s.app, s.ctx, s.msgSrvr, s.Require()
+
+	// enable a borrow cooldown of 5 blocks
+	params := app.LeverageKeeper.GetParams(ctx)
+	params.BorrowCooldownBlocks = 5
+	app.LeverageKeeper.SetParams(ctx, params)
+
+	// create and fund a supplier which supplies 100 UMEE
+	supplier := s.newAccount(coin.New(umeeDenom, 100_000000))
+	s.supply(supplier, coin.New(umeeDenom, 100_000000))
+
+	// create a borrower which supplies and collateralizes 100 ATOM at the current height
+	borrower := s.newAccount(coin.New(atomDenom, 100_000000))
+	s.supply(borrower, coin.New(atomDenom, 100_000000))
+	s.collateralize(borrower, coin.New("u/"+atomDenom, 100_000000))
+
+	msg := &types.MsgBorrow{
+		Borrower: borrower.String(),
+		Asset:    coin.New(umeeDenom, 1_000000),
+	}
+
+	// borrowing in the same block as the collateral change fails
+	_, err := srv.Borrow(ctx, msg)
+	require.ErrorIs(err, types.ErrBorrowCooldown)
+
+	// borrowing on the last block of the cooldown fails
+	_, err = srv.Borrow(ctx.WithBlockHeight(ctx.BlockHeight()+4), msg)
+	require.ErrorIs(err, types.ErrBorrowCooldown)
+
+	// borrowing after the cooldown elapses succeeds
+	_, err = srv.Borrow(ctx.WithBlockHeight(ctx.BlockHeight()+5), msg)
+	require.NoError(err)
+
+	// collateral changes which were not initiated by the borrower, such as liquidations, do not
+	// restart the cooldown
+	later := ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	require.NoError(s.tk.SetCollateral(later, borrower, coin.New("u/"+atomDenom, 90_000000)))
+	_, err = srv.Borrow(later, msg)
+	require.NoError(err)
+
+	// the last collateral change height is cleared once an account has no collateral
+	kvs := ctx.KVStore(app.GetKey(types.ModuleName))
+	other := s.newAccount(coin.New(atomDenom, 10_000000))
+	s.supply(other, coin.New(atomDenom, 10_000000))
+	s.collateralize(other, coin.New("u/"+atomDenom, 10_000000))
+	require.True(kvs.Has(types.KeyCollateralChange(other)))
+	s.decollateralize(other, coin.New("u/"+atomDenom, 10_000000))
+	require.False(kvs.Has(types.KeyCollateralChange(other)))
+}
+
+func (s *IntegrationTestSuite) TestMsgBorrow_MaxAccountLeverage() {
+	app, ctx, srv, require := s.app, s.ctx, s.msgSrvr, s.Require()
+
+	// create and fund a supplier which supplies 100 UMEE
+	supplier := s.newAccount(coin.New(umeeDenom, 100_000000))
+	s.supply(supplier, coin.New(umeeDenom, 100_000000))
+
+	// create a borrower which supplies and collateralizes 100 UMEE
+	borrower := s.newAccount(coin.New(umeeDenom, 100_000000))
+	s.supply(borrower, coin.New(umeeDenom, 100_000000))
+	s.collateralize(borrower, coin.New("u/"+umeeDenom, 100_000000))
+
+	borrow := func(amount int64) error {
+		_, err := srv.Borrow(ctx, &types.MsgBorrow{
+			Borrower: borrower.String(),
+			Asset:    coin.New(umeeDenom, amount),
+		})
+		return err
+	}
+
+	// with no leverage cap, the borrow limit of 25 UMEE is the only restriction
+	cacheCtx, _ := ctx.CacheContext()
+	_, err := srv.Borrow(cacheCtx, &types.MsgBorrow{
+		Borrower: borrower.String(),
+		Asset:    coin.New(umeeDenom, 25_000000),
+	})
+	require.NoError(err)
+
+	// cap leverage at 1.25, which allows borrowing up to 20% of collateral value
+	params := app.LeverageKeeper.GetParams(ctx)
+	params.MaxAccountLeverage = sdk.MustNewDecFromStr("1.25")
+	app.LeverageKeeper.SetParams(ctx, params)
+
+	// 21 UMEE borrowed against 100 UMEE collateral would be a leverage of 1.266
+	require.ErrorIs(borrow(21_000000), types.ErrMaxAccountLeverage)
+	// 19 UMEE is a leverage of 1.235
+	require.NoError(borrow(19_000000))
+	// a further 1.000001 UMEE would exceed the cap
+	require.ErrorIs(borrow(1_000001), types.ErrMaxAccountLeverage)
+	// a further 1 UMEE reaches it exactly
+	require.NoError(borrow(1_000000))
+	require.Equal(coin.New(umeeDenom, 20_000000), app.LeverageKeeper.GetBorrow(ctx, borrower, umeeDenom))
+}
+
 func (s *IntegrationTestSuite) TestMsgMaxBorrow() {
 	app, ctx, srv, require := s.app, s.ctx, s.msgSrvr, s.Require()
 
@@ -1799,6 +2119,226 @@ func (s *IntegrationTestSuite) TestMsgRepay() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestMsgRepayWithdraw() {
+	app, ctx, srv, require := s.app, s.ctx, s.msgSrvr, s.Require()
+
+	// create and fund a supplier which supplies 100 UMEE
+	supplier := s.newAccount(coin.New(umeeDenom, 100_000000))
+	s.supply(supplier, coin.New(umeeDenom, 100_000000))
+
+	// create a borrower which collateralizes 100 UMEE and borrows 20 UMEE, which it keeps in its wallet
+	borrower := s.newAccount(coin.New(umeeDenom, 100_000000))
+	s.supply(borrower, coin.New(umeeDenom, 100_000000))
+	s.collateralize(borrower, coin.New("u/"+umeeDenom, 100_000000))
+	s.borrow(borrower, coin.New(umeeDenom, 20_000000))
+
+	// withdrawing 40 u/UMEE alone would exceed the borrow limit
+	cacheCtx, _ := ctx.CacheContext()
+	_, err := srv.Withdraw(cacheCtx, types.NewMsgWithdraw(borrower, coin.New("u/"+umeeDenom, 40_000000)))
+	require.ErrorIs(err, types.ErrUndercollaterized)
+
+	// repaying 10 UMEE first frees enough collateral
+	resp, err := srv.RepayWithdraw(ctx, types.NewMsgRepayWithdraw(borrower,
+		coin.New(umeeDenom, 10_000000), coin.New("u/"+umeeDenom, 40_000000)))
+	require.NoError(err)
+	require.Equal(coin.New(umeeDenom, 10_000000), resp.Repaid)
+	require.Equal(coin.New(umeeDenom, 40_000000), resp.Received)
+	require.Equal(coin.New(umeeDenom, 10_000000), app.LeverageKeeper.GetBorrow(ctx, borrower, umeeDenom))
+	require.Equal(coin.New("u/"+umeeDenom, 60_000000), app.LeverageKeeper.GetCollateral(ctx, borrower, "u/"+umeeDenom))
+	require.Equal(coin.New(umeeDenom, 50_000000), app.BankKeeper.GetBalance(ctx, borrower, umeeDenom))
+
+	// if the withdrawal would exceed the borrow limit, the repayment is reverted as well
+	_, err = srv.RepayWithdraw(ctx, types.NewMsgRepayWithdraw(borrower,
+		coin.New(umeeDenom, 5_000000), coin.New("u/"+umeeDenom, 50_000000)))
+	require.ErrorIs(err, types.ErrUndercollaterized)
+	require.Equal(coin.New(umeeDenom, 10_000000), app.LeverageKeeper.GetBorrow(ctx, borrower, umeeDenom))
+	require.Equal(coin.New("u/"+umeeDenom, 60_000000), app.LeverageKeeper.GetCollateral(ctx, borrower, "u/"+umeeDenom))
+	require.Equal(coin.New(umeeDenom, 50_000000), app.BankKeeper.GetBalance(ctx, borrower, umeeDenom))
+
+	// if the withdrawal fails for other reasons, the repayment is also reverted
+	_, err = srv.RepayWithdraw(ctx, types.NewMsgRepayWithdraw(borrower,
+		coin.New(umeeDenom, 5_000000), coin.New("u/"+umeeDenom, 100_000000)))
+	require.ErrorIs(err, types.ErrInsufficientBalance)
+	require.Equal(coin.New(umeeDenom, 10_000000), app.LeverageKeeper.GetBorrow(ctx, borrower, umeeDenom))
+}
+
+func (s *IntegrationTestSuite) TestMsgBorrowWithTopUp() {
+	app, ctx, srv, require := s.app, s.ctx, s.msgSrvr, s.Require()
+
+	// create and fund a supplier which supplies 1000 ATOM
+	supplier := s.newAccount(coin.New(atomDenom, 1000_000000))
+	s.supply(supplier, coin.New(atomDenom, 1000_000000))
+
+	// create a borrower with 1000 UMEE in its wallet and no collateral
+	borrower := s.newAccount(coin.New(umeeDenom, 1000_000000))
+
+	// the provided collateral is not enough to reach the target health factor
+	_, err := srv.BorrowWithTopUp(ctx, types.NewMsgBorrowWithTopUp(borrower,
+		coin.New(atomDenom, 1_000000), coin.New(umeeDenom, 10_000000), sdk.MustNewDecFromStr("1.5")))
+	require.ErrorIs(err, types.ErrInsufficientTopUp)
+
+	// a target which reaches the liquidation threshold but not the borrow limit fails, and nothing is supplied
+	_, err = srv.BorrowWithTopUp(ctx, types.NewMsgBorrowWithTopUp(borrower,
+		coin.New(atomDenom, 1_000000), coin.New(umeeDenom, 1000_000000), sdk.MustNewDecFromStr("1.01")))
+	require.ErrorIs(err, types.ErrUndercollaterized)
+	require.Equal(coin.New(umeeDenom, 1000_000000), app.BankKeeper.GetBalance(ctx, borrower, umeeDenom))
+	require.Equal(coin.Zero("u/"+umeeDenom), app.LeverageKeeper.GetCollateral(ctx, borrower, "u/"+umeeDenom))
+
+	// borrowing 1 ATOM ($39.38) at a target health factor of 1.5 requires a liquidation threshold of $59.07.
+	// UMEE ($4.21) has a liquidation threshold of 0.26, so ceil(59.07 / 1.0946) = 53.964919 UMEE is supplied.
+	resp, err := srv.BorrowWithTopUp(ctx, types.NewMsgBorrowWithTopUp(borrower,
+		coin.New(atomDenom, 1_000000), coin.New(umeeDenom, 1000_000000), sdk.MustNewDecFromStr("1.5")))
+	require.NoError(err)
+	require.Equal(coin.New(umeeDenom, 53_964919), resp.Supplied)
+	require.Equal(coin.New("u/"+umeeDenom, 53_964919), resp.Collateralized)
+	require.Equal(coin.New("u/"+umeeDenom, 53_964919), app.LeverageKeeper.GetCollateral(ctx, borrower, "u/"+umeeDenom))
+	require.Equal(coin.New(atomDenom, 1_000000), app.LeverageKeeper.GetBorrow(ctx, borrower, atomDenom))
+	// the unused portion stays in the wallet
+	require.Equal(coin.New(umeeDenom, 946_035081), app.BankKeeper.GetBalance(ctx, borrower, umeeDenom))
+
+	// the resulting health factor is at least the target
+	collateral := app.LeverageKeeper.GetBorrowerCollateral(ctx, borrower)
+	threshold, err := app.LeverageKeeper.CalculateLiquidationThreshold(ctx, collateral)
+	require.NoError(err)
+	require.True(threshold.GTE(sdk.MustNewDecFromStr("59.07")), threshold.String())
+
+	// a lower target for a further small borrow is already met, so no collateral is supplied
+	resp, err = srv.BorrowWithTopUp(ctx, types.NewMsgBorrowWithTopUp(borrower,
+		coin.New(atomDenom, 100000), coin.New(umeeDenom, 1000_000000), sdk.MustNewDecFromStr("1.2")))
+	require.NoError(err)
+	require.Equal(coin.Zero(umeeDenom), resp.Supplied)
+	require.Equal(coin.Zero("u/"+umeeDenom), resp.Collateralized)
+	require.Equal(coin.New(atomDenom, 1_100000), app.LeverageKeeper.GetBorrow(ctx, borrower, atomDenom))
+	require.Equal(coin.New(umeeDenom, 946_035081), app.BankKeeper.GetBalance(ctx, borrower, umeeDenom))
+}
+
+func (s *IntegrationTestSuite) TestMsgLeverageLoop() {
+	app, ctx, srv, require := s.app, s.ctx, s.msgSrvr, s.Require()
+
+	loop := func(addr sdk.AccAddress, asset sdk.Coin, target string) *types.MsgLeverageLoopResponse {
+		resp, err := srv.LeverageLoop(ctx, types.NewMsgLeverageLoop(addr, asset, sdk.MustNewDecFromStr(target)))
+		require.NoError(err)
+		return resp
+	}
+
+	// a target within the borrow limit is reached in one step
+	looper := s.newAccount(coin.New(umeeDenom, 100_000000))
+	resp := loop(looper, coin.New(umeeDenom, 100_000000), "1.2")
+	require.Equal(coin.New("u/"+umeeDenom, 120_000000), resp.Collateralized)
+	require.Equal(coin.New(umeeDenom, 20_000000), resp.Borrowed)
+	require.Equal(sdk.MustNewDecFromStr("1.2"), resp.AchievedLeverage)
+	require.Equal(types.LoopLimit_LOOP_LIMIT_NONE, resp.Limit)
+	require.Equal(coin.New(umeeDenom, 20_000000), app.LeverageKeeper.GetBorrow(ctx, looper, umeeDenom))
+	require.Equal(coin.New("u/"+umeeDenom, 120_000000), app.LeverageKeeper.GetCollateral(ctx, looper, "u/"+umeeDenom))
+	require.Equal(coin.Zero(umeeDenom), app.BankKeeper.GetBalance(ctx, looper, umeeDenom))
+
+	// a target beyond what collateral weight (0.25) allows borrows 25 + 6.25 + 1.5625 + 0.390625 UMEE,
+	// then stops because the next step of 0.09765625 UMEE is within the dust of 0.1 UMEE
+	looper = s.newAccount(coin.New(umeeDenom, 100_000000))
+	resp = loop(looper, coin.New(umeeDenom, 100_000000), "2")
+	require.Equal(coin.New(umeeDenom, 33_203125), resp.Borrowed)
+	require.Equal(sdk.MustNewDecFromStr("2"), resp.TargetLeverage)
+	require.Equal(sdk.MustNewDecFromStr("1.33203125"), resp.AchievedLeverage)
+	require.Equal(types.LoopLimit_LOOP_LIMIT_COLLATERAL_WEIGHT, resp.Limit)
+
+	// the 0.05 UMEE needed to reach a target of 1.0005 is dust, so nothing is borrowed
+	looper = s.newAccount(coin.New(umeeDenom, 100_000000))
+	resp = loop(looper, coin.New(umeeDenom, 100_000000), "1.0005")
+	require.Equal(coin.New("u/"+umeeDenom, 100_000000), resp.Collateralized)
+	require.Equal(coin.Zero(umeeDenom), resp.Borrowed)
+	require.Equal(sdk.OneDec(), resp.AchievedLeverage)
+	require.Equal(types.LoopLimit_LOOP_LIMIT_DUST, resp.Limit)
+
+	// ATOM can only be borrowed up to 10% of its supply, so liquidity runs out before the target.
+	// Steps of 1 and 0.1 ATOM are lent, and the next step of 0.01 ATOM is dust.
+	atomToken := newToken(atomDenom, "ATOM", 6)
+	atomToken.MaxSupplyUtilization = sdk.MustNewDecFromStr("0.1")
+	require.NoError(app.LeverageKeeper.SetTokenSettings(ctx, atomToken))
+	looper = s.newAccount(coin.New(atomDenom, 10_000000))
+	resp = loop(looper, coin.New(atomDenom, 10_000000), "1.2")
+	require.Equal(coin.New("u/"+atomDenom, 11_100000), resp.Collateralized)
+	require.Equal(coin.New(atomDenom, 1_100000), resp.Borrowed)
+	require.Equal(sdk.MustNewDecFromStr("1.11"), resp.AchievedLeverage)
+	require.Equal(types.LoopLimit_LOOP_LIMIT_LIQUIDITY, resp.Limit)
+	require.Equal(coin.New(atomDenom, 1_100000), app.LeverageKeeper.GetBorrow(ctx, looper, atomDenom))
+
+	// tokens which cannot be borrowed against their own collateral cannot be looped
+	atomToken.NoSelfBorrow = true
+	require.NoError(app.LeverageKeeper.SetTokenSettings(ctx, atomToken))
+	looper = s.newAccount(coin.New(atomDenom, 10_000000))
+	_, err := srv.LeverageLoop(ctx, types.NewMsgLeverageLoop(looper, coin.New(atomDenom, 10_000000),
+		sdk.MustNewDecFromStr("1.1")))
+	require.ErrorIs(err, types.ErrSelfBorrow)
+	require.Equal(coin.New(atomDenom, 10_000000), app.BankKeeper.GetBalance(ctx, looper, atomDenom))
+}
+
+func (s *IntegrationTestSuite) TestMsgSwapCollateral() {
+	app, ctx, srv, require := s.app, s.ctx, s.msgSrvr, s.Require()
+
+	// a supplier provides ATOM liquidity
+	supplier := s.newAccount(coin.New(atomDenom, 100_000000))
+	s.supply(supplier, coin.New(atomDenom, 100_000000))
+
+	// creates a borrower with 100 UMEE collateral (borrow limit 105.25) which borrows 2 ATOM (78.76),
+	// and holds 10 ATOM in its wallet
+	newBorrower := func() sdk.AccAddress {
+		borrower := s.newAccount(coin.New(umeeDenom, 100_000000), coin.New(atomDenom, 8_000000))
+		s.supply(borrower, coin.New(umeeDenom, 100_000000))
+		s.collateralize(borrower, coin.New("u/"+umeeDenom, 100_000000))
+		s.borrow(borrower, coin.New(atomDenom, 2_000000))
+		return borrower
+	}
+	swap := func(borrower sdk.AccAddress, withdraw, collateral sdk.Coin) (*types.MsgSwapCollateralResponse, error) {
+		return srv.SwapCollateral(ctx, types.NewMsgSwapCollateral(borrower, withdraw, collateral))
+	}
+
+	// all UMEE collateral is replaced by 10 ATOM (borrow limit 98.45), although the borrower would be over
+	// its borrow limit between the two steps
+	borrower := newBorrower()
+	resp, err := swap(borrower, coin.New("u/"+umeeDenom, 100_000000), coin.New(atomDenom, 10_000000))
+	require.NoError(err)
+	require.Equal(coin.New(umeeDenom, 100_000000), resp.Received)
+	require.Equal(coin.New("u/"+atomDenom, 10_000000), resp.Collateralized)
+	require.Equal(sdk.NewCoins(coin.New("u/"+atomDenom, 10_000000)),
+		app.LeverageKeeper.GetBorrowerCollateral(ctx, borrower))
+	require.Equal(coin.New(umeeDenom, 100_000000), app.BankKeeper.GetBalance(ctx, borrower, umeeDenom))
+	require.Equal(coin.Zero(atomDenom), app.BankKeeper.GetBalance(ctx, borrower, atomDenom))
+	require.Equal(coin.New(atomDenom, 2_000000), app.LeverageKeeper.GetBorrow(ctx, borrower, atomDenom))
+
+	// a swap into 5 ATOM (borrow limit 49.225) would leave the borrower over its borrow limit,
+	// so neither step takes effect
+	borrower = newBorrower()
+	_, err = swap(borrower, coin.New("u/"+umeeDenom, 100_000000), coin.New(atomDenom, 5_000000))
+	require.ErrorIs(err, types.ErrUndercollaterized)
+	require.Equal(sdk.NewCoins(coin.New("u/"+umeeDenom, 100_000000)),
+		app.LeverageKeeper.GetBorrowerCollateral(ctx, borrower))
+	require.Equal(coin.Zero(umeeDenom), app.BankKeeper.GetBalance(ctx, borrower, umeeDenom))
+	require.Equal(coin.New(atomDenom, 10_000000), app.BankKeeper.GetBalance(ctx, borrower, atomDenom))
+	require.Equal(coin.Zero("u/"+atomDenom), app.BankKeeper.GetBalance(ctx, borrower, "u/"+atomDenom))
+
+	// swapping part of the collateral keeps the borrower within its limit of 52.625 + 49.225
+	resp, err = swap(borrower, coin.New("u/"+umeeDenom, 50_000000), coin.New(atomDenom, 5_000000))
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(coin.New("u/"+atomDenom, 5_000000), coin.New("u/"+umeeDenom, 50_000000)),
+		app.LeverageKeeper.GetBorrowerCollateral(ctx, borrower))
+	_, _, borrowLimit, _, err := app.LeverageKeeper.BorrowUtilization(ctx, borrower)
+	require.NoError(err)
+	require.Equal(sdk.MustNewDecFromStr("101.85"), borrowLimit)
+
+	// uTokens must come from collateral, so wallet uTokens cannot be swapped
+	walletSupplier := s.newAccount(coin.New(umeeDenom, 10_000000), coin.New(atomDenom, 1_000000))
+	s.supply(walletSupplier, coin.New(umeeDenom, 10_000000))
+	_, err = swap(walletSupplier, coin.New("u/"+umeeDenom, 10_000000), coin.New(atomDenom, 1_000000))
+	require.ErrorIs(err, types.ErrInsufficientCollateral)
+	require.Equal(coin.New(atomDenom, 1_000000), app.BankKeeper.GetBalance(ctx, walletSupplier, atomDenom))
+
+	// swapping into the same token is rejected
+	msg := types.NewMsgSwapCollateral(borrower, coin.New("u/"+umeeDenom, 1), coin.New(umeeDenom, 1))
+	require.ErrorContains(msg.ValidateBasic(), "same denom")
+	msg = types.NewMsgSwapCollateral(borrower, coin.New(umeeDenom, 1), coin.New(atomDenom, 1))
+	require.ErrorIs(msg.ValidateBasic(), types.ErrNotUToken)
+}
+
 func (s *IntegrationTestSuite) TestMsgLiquidate() {
 	app, ctx, srv, require := s.app, s.ctx, s.msgSrvr, s.Require()
 
@@ -2031,6 +2571,238 @@ func (s *IntegrationTestSuite) TestMsgLiquidate() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestMsgLiquidate_DustThreshold() {
+	app, ctx, srv, require := s.app, s.ctx, s.msgSrvr, s.Require()
+
+	// create and fund a supplier which supplies plenty of UMEE to the module
+	supplier := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(supplier, coin.New(umeeDenom, 1000_000000))
+
+	// create and fund a liquidator which has 1000 UMEE
+	liquidator := s.newAccount(coin.New(umeeDenom, 1000_000000))
+
+	// create a borrower with 400 UMEE collateral which will have a close factor < 1
+	borrower := s.newAccount(coin.New(umeeDenom, 400_000000))
+	s.supply(borrower, coin.New(umeeDenom, 400_000000))
+	s.collateralize(borrower, coin.New("u/"+umeeDenom, 400_000000))
+	s.forceBorrow(borrower, coin.New(umeeDenom, 106_000000))
+
+	// close factor allows repaying 8.150541 UMEE, which would leave 97.849459 UMEE ($411.946...) borrowed
+	msg := types.NewMsgLiquidate(liquidator, borrower, coin.New(umeeDenom, 200_000000), "u/"+umeeDenom)
+	tcs := []struct {
+		threshold         string
+		expectedRepay     sdk.Coin
+		expectedRemaining sdk.Coin
+	}{
+		{"0", coin.New(umeeDenom, 8_150541), coin.New(umeeDenom, 97_849459)},
+		{"411.94", coin.New(umeeDenom, 8_150541), coin.New(umeeDenom, 97_849459)},
+		{"411.95", coin.New(umeeDenom, 106_000000), coin.Zero(umeeDenom)},
+	}
+
+	for _, tc := range tcs {
+		cacheCtx, _ := ctx.CacheContext()
+		params := app.LeverageKeeper.GetParams(cacheCtx)
+		params.LiquidationDustThreshold = sdk.MustNewDecFromStr(tc.threshold)
+		app.LeverageKeeper.SetParams(cacheCtx, params)
+
+		resp, err := srv.Liquidate(cacheCtx, msg)
+		require.NoError(err, tc.threshold)
+		require.Equal(tc.expectedRepay, resp.Repaid, tc.threshold)
+		require.Equal(tc.expectedRemaining, app.LeverageKeeper.GetBorrow(cacheCtx, borrower, umeeDenom), tc.threshold)
+	}
+}
+
+func (s *IntegrationTestSuite) TestMsgLiquidate_ProtocolShare() {
+	app, ctx, srv, require := s.app, s.ctx, s.msgSrvr, s.Require()
+
+	// create and fund a supplier which supplies plenty of ATOM to the module
+	supplier := s.newAccount(coin.New(atomDenom, 1000_000000))
+	s.supply(supplier, coin.New(atomDenom, 1000_000000))
+
+	// create and fund a liquidator which has 1000 ATOM
+	liquidator := s.newAccount(coin.New(atomDenom, 1000_000000))
+
+	// create a borrower which collateralizes 1000 ATOM and artificially borrows 500 ATOM
+	borrower := s.newAccount(coin.New(atomDenom, 1000_000000))
+	s.supply(borrower, coin.New(atomDenom, 1000_000000))
+	s.collateralize(borrower, coin.New("u/"+atomDenom, 1000_000000))
+	s.forceBorrow(borrower, coin.New(atomDenom, 500_000000))
+
+	// repaying 10 ATOM earns 11 u/ATOM (incentive 0.1) or 10.9 ATOM directly (incentive 0.1 * 0.9),
+	// of which the protocol takes the configured share of the 1 u/ATOM or 0.9 ATOM incentive
+	tcs := []struct {
+		share              string
+		rewardDenom        string
+		expectedLiquidated sdk.Coin
+		expectedReward     sdk.Coin
+		expectedCut        sdk.Coin
+	}{
+		{
+			"0", "u/" + atomDenom,
+			coin.New("u/"+atomDenom, 11_000000), coin.New("u/"+atomDenom, 11_000000), coin.Zero(atomDenom),
+		},
+		{
+			"0.5", "u/" + atomDenom,
+			coin.New("u/"+atomDenom, 11_000000), coin.New("u/"+atomDenom, 10_500000), coin.New(atomDenom, 500000),
+		},
+		{
+			"1", "u/" + atomDenom,
+			coin.New("u/"+atomDenom, 11_000000), coin.New("u/"+atomDenom, 10_000000), coin.New(atomDenom, 1_000000),
+		},
+		{
+			"0", atomDenom,
+			coin.New("u/"+atomDenom, 10_900000), coin.New(atomDenom, 10_900000), coin.Zero(atomDenom),
+		},
+		{
+			"0.5", atomDenom,
+			coin.New("u/"+atomDenom, 10_900000), coin.New(atomDenom, 10_450000), coin.New(atomDenom, 450000),
+		},
+	}
+
+	for _, tc := range tcs {
+		name := tc.share + " " + tc.rewardDenom
+		cacheCtx, _ := ctx.CacheContext()
+		params := app.LeverageKeeper.GetParams(cacheCtx)
+		params.ProtocolLiquidationShare = sdk.MustNewDecFromStr(tc.share)
+		app.LeverageKeeper.SetParams(cacheCtx, params)
+
+		reservesBefore := app.LeverageKeeper.GetReserves(cacheCtx, atomDenom)
+		rateBefore := app.LeverageKeeper.DeriveExchangeRate(cacheCtx, atomDenom)
+		balanceBefore := app.BankKeeper.GetBalance(cacheCtx, liquidator, tc.rewardDenom)
+
+		msg := types.NewMsgLiquidate(liquidator, borrower, coin.New(atomDenom, 10_000000), tc.rewardDenom)
+		resp, err := srv.Liquidate(cacheCtx, msg)
+		require.NoError(err, name)
+		require.Equal(coin.New(atomDenom, 10_000000), resp.Repaid, name)
+		require.Equal(tc.expectedLiquidated, resp.Collateral, name)
+		require.Equal(tc.expectedReward, resp.Reward, name)
+
+		// the liquidator receives the remainder and reserves receive the protocol's share
+		balanceAfter := app.BankKeeper.GetBalance(cacheCtx, liquidator, tc.rewardDenom)
+		if tc.rewardDenom == atomDenom {
+			// the direct reward is received after repaying 10 ATOM
+			balanceAfter = balanceAfter.Add(coin.New(atomDenom, 10_000000))
+		}
+		require.Equal(tc.expectedReward, balanceAfter.Sub(balanceBefore), name)
+		require.Equal(tc.expectedCut, app.LeverageKeeper.GetReserves(cacheCtx, atomDenom).Sub(reservesBefore), name)
+		// moving the uToken cut to reserves does not change the exchange rate
+		require.Equal(rateBefore, app.LeverageKeeper.DeriveExchangeRate(cacheCtx, atomDenom), name)
+	}
+}
+
+func (s *IntegrationTestSuite) TestMsgLiquidate_GuardPrice() {
+	srv, ctx, require := s.msgSrvr, s.ctx, s.Require()
+
+	// create and fund a supplier which supplies plenty of ATOM to the module
+	supplier := s.newAccount(coin.New(atomDenom, 1000_000000))
+	s.supply(supplier, coin.New(atomDenom, 1000_000000))
+
+	// create and fund a liquidator which has 1000 ATOM
+	liquidator := s.newAccount(coin.New(atomDenom, 1000_000000))
+
+	// create a borrower which collateralizes 1000 ATOM and artificially borrows 500 ATOM
+	borrower := s.newAccount(coin.New(atomDenom, 1000_000000))
+	s.supply(borrower, coin.New(atomDenom, 1000_000000))
+	s.collateralize(borrower, coin.New("u/"+atomDenom, 1000_000000))
+	s.forceBorrow(borrower, coin.New(atomDenom, 500_000000))
+
+	msg := types.NewMsgLiquidate(liquidator, borrower, coin.New(atomDenom, 10_000000), "u/"+atomDenom)
+
+	// ATOM price (39.38) has recovered above the guard price, so the liquidation reverts
+	msg.GuardDenom = atomDenom
+	guardPrice := sdk.MustNewDecFromStr("30")
+	msg.GuardPrice = &guardPrice
+	_, err := srv.Liquidate(ctx, msg)
+	require.ErrorIs(err, types.ErrGuardPriceExceeded)
+	require.Equal(sdk.NewCoins(coin.New(atomDenom, 500_000000)), s.app.LeverageKeeper.GetBorrowerBorrows(ctx, borrower))
+
+	// a missing guard price also reverts
+	s.mockOracle.Clear("ATOM")
+	_, err = srv.Liquidate(ctx, msg)
+	require.ErrorIs(err, oracletypes.ErrUnknownDenom)
+	s.mockOracle.Reset()
+
+	// ATOM price is at or below the guard price, so the liquidation executes
+	guardPrice = sdk.MustNewDecFromStr("39.38")
+	resp, err := srv.Liquidate(ctx, msg)
+	require.NoError(err)
+	require.Equal(coin.New(atomDenom, 10_000000), resp.Repaid)
+}
+
+func (s *IntegrationTestSuite) TestMsgLiquidate_GracePeriod() {
+	app, srv, ctx, require := s.app, s.msgSrvr, s.ctx, s.Require()
+
+	// liquidations wait 5 blocks after a borrower is found liquidatable
+	params := app.LeverageKeeper.GetParams(ctx)
+	params.LiquidationGracePeriod = 5
+	app.LeverageKeeper.SetParams(ctx, params)
+
+	// create and fund a supplier which supplies plenty of ATOM to the module
+	supplier := s.newAccount(coin.New(atomDenom, 1000_000000))
+	s.supply(supplier, coin.New(atomDenom, 1000_000000))
+
+	// create and fund a liquidator which has 100 ATOM
+	liquidator := s.newAccount(coin.New(atomDenom, 100_000000))
+
+	// create a borrower which collateralizes 1000 UMEE and borrows 26 ATOM
+	// liquidation threshold is 1000 * 4.21 * 0.26 = 1094.6, borrowed value is 26 * 39.38 = 1023.88
+	borrower := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(borrower, coin.New(umeeDenom, 1000_000000))
+	s.collateralize(borrower, coin.New("u/"+umeeDenom, 1000_000000))
+	s.borrow(borrower, coin.New(atomDenom, 26_000000))
+
+	h := ctx.BlockHeight()
+	atHeight := func(height int64) sdk.Context {
+		c := ctx.WithBlockHeight(height)
+		app.LeverageKeeper.CheckLiquidationWatchlist(c)
+		return c
+	}
+	liquidate := func(c sdk.Context) error {
+		msg := types.NewMsgLiquidate(liquidator, borrower, coin.New(atomDenom, 100000), "u/"+umeeDenom)
+		_, err := srv.Liquidate(c, msg)
+		return err
+	}
+
+	// ATOM price rises to $45, so borrowed value is 26 * 45 = 1170
+	s.mockOracle.symbolExchangeRates["ATOM"] = sdk.MustNewDecFromStr("45")
+	defer s.mockOracle.Reset()
+
+	// a borrower not yet found liquidatable by the watchlist has no grace period
+	require.NoError(liquidate(ctx))
+
+	// the watchlist finds the borrower liquidatable, starting its grace period
+	c := atHeight(h + 1)
+	require.ErrorIs(liquidate(c), types.ErrLiquidationGracePeriod)
+	c = atHeight(h + 5)
+	require.ErrorIs(liquidate(c), types.ErrLiquidationGracePeriod)
+
+	// liquidation is allowed once the grace period has elapsed
+	c = atHeight(h + 6)
+	require.NoError(liquidate(c))
+
+	// the borrower returns to health, clearing its grace period
+	s.mockOracle.Reset()
+	c = atHeight(h + 7)
+	require.ErrorIs(liquidate(c), types.ErrLiquidationIneligible)
+
+	// when the borrower becomes liquidatable again, a new grace period starts
+	s.mockOracle.symbolExchangeRates["ATOM"] = sdk.MustNewDecFromStr("45")
+	c = atHeight(h + 8)
+	require.ErrorIs(liquidate(c), types.ErrLiquidationGracePeriod)
+	c = atHeight(h + 13)
+	require.NoError(liquidate(c))
+
+	// a zero grace period disables the check
+	s.mockOracle.Reset()
+	atHeight(h + 14)
+	s.mockOracle.symbolExchangeRates["ATOM"] = sdk.MustNewDecFromStr("45")
+	c = atHeight(h + 15)
+	require.ErrorIs(liquidate(c), types.ErrLiquidationGracePeriod)
+	params.LiquidationGracePeriod = 0
+	app.LeverageKeeper.SetParams(c, params)
+	require.NoError(liquidate(c))
+}
+
 func (s *IntegrationTestSuite) TestMaxCollateralShare() {
 	app, ctx, srv, require := s.app, s.ctx, s.msgSrvr, s.Require()
 
@@ -2153,3 +2925,394 @@ func (s *IntegrationTestSuite) TestMinCollateralLiquidity_Collateralize() {
 	_, err = srv.Collateralize(ctx, msg)
 	require.ErrorIs(err, types.ErrMinCollateralLiquidity, "collateralize")
 }
+
+func (s *IntegrationTestSuite) TestMsgFreezeAccount() {
+	ctx, srv, require := s.ctx, s.msgSrvr, s.Require()
+	govAccAddr := s.app.GovKeeper.GetGovernanceAccount(s.ctx).GetAddress().String()
+
+	// create and fund a supplier which supplies 100 UMEE
+	supplier := s.newAccount(coin.New(umeeDenom, 100_000000))
+	s.supply(supplier, coin.New(umeeDenom, 100_000000))
+
+	// create an account which supplies and collateralizes 100 ATOM, borrows 10 UMEE,
+	// and keeps 10 ATOM in its wallet
+	addr := s.newAccount(coin.New(atomDenom, 110_000000))
+	s.supply(addr, coin.New(atomDenom, 100_000000))
+	s.collateralize(addr, coin.New("u/"+atomDenom, 100_000000))
+	s.borrow(addr, coin.New(umeeDenom, 10_000000))
+
+	// freeze the account
+	eventCtx := ctx.WithEventManager(sdk.NewEventManager())
+	_, err := srv.FreezeAccount(eventCtx, types.NewMsgFreezeAccount(govAccAddr, "freeze", "freeze account", addr.String()))
+	require.NoError(err)
+	require.Contains(s.typedEvents(eventCtx), &types.EventFreezeAccount{Address: addr.String()})
+
+	summary, err := s.queryClient.AccountSummary(ctx, &types.QueryAccountSummary{Address: addr.String()})
+	require.NoError(err)
+	require.True(summary.Frozen)
+
+	_, err = srv.Supply(ctx, &types.MsgSupply{
+		Supplier: addr.String(),
+		Asset:    coin.New(atomDenom, 1_000000),
+	})
+	require.ErrorIs(err, types.ErrAccountFrozen, "supply")
+
+	_, err = srv.SupplyCollateral(ctx, &types.MsgSupplyCollateral{
+		Supplier: addr.String(),
+		Asset:    coin.New(atomDenom, 1_000000),
+	})
+	require.ErrorIs(err, types.ErrAccountFrozen, "supply collateral")
+
+	_, err = srv.Withdraw(ctx, &types.MsgWithdraw{
+		Supplier: addr.String(),
+		Asset:    coin.New("u/"+atomDenom, 1_000000),
+	})
+	require.ErrorIs(err, types.ErrAccountFrozen, "withdraw")
+
+	_, err = srv.Borrow(ctx, &types.MsgBorrow{
+		Borrower: addr.String(),
+		Asset:    coin.New(umeeDenom, 1_000000),
+	})
+	require.ErrorIs(err, types.ErrAccountFrozen, "borrow")
+
+	_, err = srv.Liquidate(ctx, &types.MsgLiquidate{
+		Liquidator:  addr.String(),
+		Borrower:    supplier.String(),
+		Repayment:   coin.New(atomDenom, 1_000000),
+		RewardDenom: umeeDenom,
+	})
+	require.ErrorIs(err, types.ErrAccountFrozen, "liquidate")
+
+	// frozen accounts can still repay
+	_, err = srv.Repay(ctx, &types.MsgRepay{
+		Borrower: addr.String(),
+		Asset:    coin.New(umeeDenom, 5_000000),
+	})
+	require.NoError(err, "repay")
+
+	// unfreeze the account, after which it can supply again
+	eventCtx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = srv.UnfreezeAccount(
+		eventCtx, types.NewMsgUnfreezeAccount(govAccAddr, "unfreeze", "unfreeze account", addr.String()),
+	)
+	require.NoError(err)
+	require.Contains(s.typedEvents(eventCtx), &types.EventUnfreezeAccount{Address: addr.String()})
+
+	summary, err = s.queryClient.AccountSummary(ctx, &types.QueryAccountSummary{Address: addr.String()})
+	require.NoError(err)
+	require.False(summary.Frozen)
+
+	_, err = srv.Supply(ctx, &types.MsgSupply{
+		Supplier: addr.String(),
+		Asset:    coin.New(atomDenom, 1_000000),
+	})
+	require.NoError(err, "supply after unfreeze")
+}
+
+func (s *IntegrationTestSuite) TestMsgSetAccountBorrowCap() {
+	ctx, srv, require := s.ctx, s.msgSrvr, s.Require()
+	govAccAddr := s.app.GovKeeper.GetGovernanceAccount(s.ctx).GetAddress().String()
+
+	// create and fund a supplier which supplies 100 UMEE
+	supplier := s.newAccount(coin.New(umeeDenom, 100_000000))
+	s.supply(supplier, coin.New(umeeDenom, 100_000000))
+
+	// create an account which supplies and collateralizes 100 ATOM, and borrows 10 UMEE ($42.10)
+	addr := s.newAccount(coin.New(atomDenom, 100_000000))
+	s.supply(addr, coin.New(atomDenom, 100_000000))
+	s.collateralize(addr, coin.New("u/"+atomDenom, 100_000000))
+	s.borrow(addr, coin.New(umeeDenom, 10_000000))
+
+	// cap the account's borrows at $100
+	eventCtx := ctx.WithEventManager(sdk.NewEventManager())
+	_, err := srv.SetAccountBorrowCap(eventCtx, types.NewMsgSetAccountBorrowCap(
+		govAccAddr, "cap", "cap account borrows", addr.String(), sdk.NewDec(100),
+	))
+	require.NoError(err)
+	require.Contains(s.typedEvents(eventCtx), &types.EventSetAccountBorrowCap{
+		Address:   addr.String(),
+		BorrowCap: sdk.NewDec(100),
+	})
+
+	capResp, err := s.queryClient.AccountBorrowCap(ctx, &types.QueryAccountBorrowCap{Address: addr.String()})
+	require.NoError(err)
+	require.Equal(sdk.NewDec(100), capResp.BorrowCap)
+
+	// borrowing 20 more UMEE ($84.20) would exceed the cap, despite being within the borrow limit
+	_, err = srv.Borrow(ctx, &types.MsgBorrow{
+		Borrower: addr.String(),
+		Asset:    coin.New(umeeDenom, 20_000000),
+	})
+	require.ErrorIs(err, types.ErrAccountBorrowCap)
+
+	// max borrow is limited to the remaining $57.90 under the cap
+	maxResp, err := s.queryClient.MaxBorrow(ctx, &types.QueryMaxBorrow{Address: addr.String(), Denom: umeeDenom})
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(coin.New(umeeDenom, 13_752969)), maxResp.Tokens)
+
+	_, err = srv.MaxBorrow(ctx, &types.MsgMaxBorrow{Borrower: addr.String(), Denom: umeeDenom})
+	require.NoError(err)
+
+	// the account is now at its cap
+	maxResp, err = s.queryClient.MaxBorrow(ctx, &types.QueryMaxBorrow{Address: addr.String(), Denom: umeeDenom})
+	require.NoError(err)
+	require.True(maxResp.Tokens.IsZero())
+
+	// a zero cap clears the account's borrow cap, after which it can borrow again
+	_, err = srv.SetAccountBorrowCap(ctx, types.NewMsgSetAccountBorrowCap(
+		govAccAddr, "uncap", "clear account borrow cap", addr.String(), sdk.ZeroDec(),
+	))
+	require.NoError(err)
+
+	capResp, err = s.queryClient.AccountBorrowCap(ctx, &types.QueryAccountBorrowCap{Address: addr.String()})
+	require.NoError(err)
+	require.Equal(sdk.ZeroDec(), capResp.BorrowCap)
+
+	_, err = srv.Borrow(ctx, &types.MsgBorrow{
+		Borrower: addr.String(),
+		Asset:    coin.New(umeeDenom, 20_000000),
+	})
+	require.NoError(err)
+}
+
+func (s *IntegrationTestSuite) TestMsgBorrowPaused() {
+	app, ctx, srv, require := s.app, s.ctx, s.msgSrvr, s.Require()
+
+	// create a supplier, and a borrower which collateralizes 100 ATOM and borrows 10 UMEE
+	supplier := s.newAccount(coin.New(umeeDenom, 100_000000))
+	s.supply(supplier, coin.New(umeeDenom, 100_000000))
+	borrower := s.newAccount(coin.New(atomDenom, 100_000000))
+	s.supply(borrower, coin.New(atomDenom, 100_000000))
+	s.collateralize(borrower, coin.New("u/"+atomDenom, 100_000000))
+	s.borrow(borrower, coin.New(umeeDenom, 10_000000))
+
+	// pause borrowing and supplying
+	params := app.LeverageKeeper.GetParams(ctx)
+	params.BorrowPaused = true
+	params.SupplyPaused = true
+	app.LeverageKeeper.SetParams(ctx, params)
+
+	summary, err := s.queryClient.AccountSummary(ctx, &types.QueryAccountSummary{Address: borrower.String()})
+	require.NoError(err)
+	require.True(summary.BorrowPaused)
+	require.True(summary.SupplyPaused)
+
+	borrowMsg := &types.MsgBorrow{
+		Borrower: borrower.String(),
+		Asset:    coin.New(umeeDenom, 1_000000),
+	}
+	_, err = srv.Borrow(ctx, borrowMsg)
+	require.ErrorIs(err, types.ErrBorrowPaused)
+	_, err = srv.MaxBorrow(ctx, &types.MsgMaxBorrow{
+		Borrower: borrower.String(),
+		Denom:    umeeDenom,
+	})
+	require.ErrorIs(err, types.ErrBorrowPaused)
+	_, err = srv.Supply(ctx, &types.MsgSupply{
+		Supplier: supplier.String(),
+		Asset:    coin.New(umeeDenom, 1),
+	})
+	require.ErrorIs(err, types.ErrSupplyPaused)
+
+	// repaying and withdrawing still work
+	_, err = srv.Repay(ctx, &types.MsgRepay{
+		Borrower: borrower.String(),
+		Asset:    coin.New(umeeDenom, 5_000000),
+	})
+	require.NoError(err)
+	_, err = srv.Withdraw(ctx, &types.MsgWithdraw{
+		Supplier: supplier.String(),
+		Asset:    coin.New("u/"+umeeDenom, 10_000000),
+	})
+	require.NoError(err)
+
+	// unpausing restores borrowing
+	params.BorrowPaused = false
+	app.LeverageKeeper.SetParams(ctx, params)
+	_, err = srv.Borrow(ctx, borrowMsg)
+	require.NoError(err)
+}
+
+func (s *IntegrationTestSuite) TestMsgWithdrawReserves() {
+	app, ctx, srv, require := s.app, s.ctx, s.msgSrvr, s.Require()
+	govAccAddr := s.app.GovKeeper.GetGovernanceAccount(s.ctx).GetAddress().String()
+
+	// create a supplier so the module account has some uumee, and reserve 50 UMEE of it
+	supplier := s.newAccount(coin.New(umeeDenom, 200_000000))
+	s.supply(supplier, coin.New(umeeDenom, 200_000000))
+	s.setReserves(coin.New(umeeDenom, 50_000000))
+
+	recipient := s.newAccount()
+	withdrawReserves := func(asset sdk.Coin) (*types.MsgWithdrawReservesResponse, error) {
+		msg := types.NewMsgWithdrawReserves(govAccAddr, "reserves", "withdraw reserves", recipient.String(), asset)
+		return srv.WithdrawReserves(ctx, msg)
+	}
+
+	// withdraw 20 UMEE of reserves
+	resp, err := withdrawReserves(coin.New(umeeDenom, 20_000000))
+	require.NoError(err)
+	require.Equal(coin.New(umeeDenom, 20_000000), resp.Withdrawn)
+	require.Equal(coin.New(umeeDenom, 30_000000), app.LeverageKeeper.GetReserves(ctx, umeeDenom))
+	require.Equal(coin.New(umeeDenom, 20_000000), app.BankKeeper.GetBalance(ctx, recipient, umeeDenom))
+
+	// requesting more than the remaining reserves fails
+	_, err = withdrawReserves(coin.New(umeeDenom, 40_000000))
+	require.ErrorIs(err, types.ErrInsufficientReserves)
+	require.Equal(coin.New(umeeDenom, 30_000000), app.LeverageKeeper.GetReserves(ctx, umeeDenom))
+
+	// uTokens cannot be withdrawn from reserves
+	_, err = withdrawReserves(coin.New("u/"+umeeDenom, 1_000000))
+	require.ErrorIs(err, types.ErrUToken)
+
+	// a zero amount withdraws all remaining reserves
+	resp, err = withdrawReserves(coin.Zero(umeeDenom))
+	require.NoError(err)
+	require.Equal(coin.New(umeeDenom, 30_000000), resp.Withdrawn)
+	require.Equal(coin.Zero(umeeDenom), app.LeverageKeeper.GetReserves(ctx, umeeDenom))
+	require.Equal(coin.New(umeeDenom, 50_000000), app.BankKeeper.GetBalance(ctx, recipient, umeeDenom))
+
+	// no reserves remain to withdraw
+	_, err = withdrawReserves(coin.Zero(umeeDenom))
+	require.ErrorIs(err, types.ErrInsufficientReserves)
+}
+
+func (s *IntegrationTestSuite) TestMsgSetOracleSymbol() {
+	app, ctx, srv, require := s.app, s.ctx, s.msgSrvr, s.Require()
+	govAccAddr := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress().String()
+
+	// create an account which supplies and collateralizes 10 ATOM
+	addr := s.newAccount(coin.New(atomDenom, 10_000000))
+	s.supply(addr, coin.New(atomDenom, 10_000000))
+	s.collateralize(addr, coin.New("u/"+atomDenom, 10_000000))
+
+	setOracleSymbol := func(denom, symbol string) error {
+		msg := types.NewMsgSetOracleSymbol(govAccAddr, "symbol", "set oracle symbol", denom, symbol)
+		_, err := srv.SetOracleSymbol(ctx, msg)
+		return err
+	}
+	collateralValue := func() sdk.Dec {
+		resp, err := s.queryClient.AccountSummary(ctx.Context(), &types.QueryAccountSummary{Address: addr.String()})
+		require.NoError(err)
+		return resp.CollateralValue
+	}
+
+	// 10 ATOM at $39.38
+	require.Equal(sdk.MustNewDecFromStr("393.8"), collateralValue())
+
+	// the oracle reports prices for a rebranded symbol
+	s.mockOracle.symbolExchangeRates["NATOM"] = sdk.MustNewDecFromStr("50")
+	s.mockOracle.historicExchangeRates["NATOM"] = sdk.MustNewDecFromStr("50")
+
+	eventCtx := ctx.WithEventManager(sdk.NewEventManager())
+	msg := types.NewMsgSetOracleSymbol(govAccAddr, "symbol", "set oracle symbol", atomDenom, "NATOM")
+	_, err := srv.SetOracleSymbol(eventCtx, msg)
+	require.NoError(err)
+	token, err := app.LeverageKeeper.GetTokenSettings(ctx, atomDenom)
+	require.NoError(err)
+	require.Equal("NATOM", token.SymbolDenom)
+	require.Contains(s.typedEvents(eventCtx), &types.EventSetOracleSymbol{
+		Denom:          atomDenom,
+		OldSymbolDenom: "ATOM",
+		NewSymbolDenom: "NATOM",
+	})
+
+	// valuation picks up the new symbol's price
+	require.Equal(sdk.MustNewDecFromStr("500"), collateralValue())
+	price, _, err := app.LeverageKeeper.TokenPrice(ctx, atomDenom, types.PriceModeSpot)
+	require.NoError(err)
+	require.Equal(sdk.MustNewDecFromStr("50"), price)
+
+	// symbols of other registered tokens cannot be reused, regardless of case
+	err = setOracleSymbol(atomDenom, "umee")
+	require.ErrorIs(err, types.ErrDuplicateToken)
+
+	// the token must be registered
+	err = setOracleSymbol("uabcd", "ABCD")
+	require.ErrorIs(err, types.ErrNotRegisteredToken)
+}
+
+func (s *IntegrationTestSuite) TestMsgStopLoss() {
+	app, ctx, srv, require := s.app, s.ctx, s.msgSrvr, s.Require()
+
+	// a supplier provides UMEE liquidity
+	supplier := s.newAccount(coin.New(umeeDenom, 1000_000000))
+	s.supply(supplier, coin.New(umeeDenom, 1000_000000))
+
+	// a borrower collateralizes 100 ATOM ($3938) and borrows 200 UMEE ($842)
+	// health factor is (3938 * 0.26) / 842 = 1.216
+	borrower := s.newAccount(coin.New(atomDenom, 100_000000))
+	s.supply(borrower, coin.New(atomDenom, 100_000000))
+	s.collateralize(borrower, coin.New("u/"+atomDenom, 100_000000))
+	s.borrow(borrower, coin.New(umeeDenom, 200_000000))
+	executor := s.newAccount()
+	other := s.newAccount()
+
+	execute := func(executorAddr sdk.AccAddress, withdraw, repay sdk.Coin) (*types.MsgExecuteStopLossResponse, error) {
+		return srv.ExecuteStopLoss(ctx, types.NewMsgExecuteStopLoss(executorAddr, borrower, withdraw, repay))
+	}
+	withdraw := coin.New("u/"+atomDenom, 10_000000)
+	repay := coin.New(umeeDenom, 50_000000)
+
+	// no stop-loss has been set
+	_, err := execute(executor, withdraw, repay)
+	require.ErrorIs(err, types.ErrNoStopLoss)
+
+	// the borrower allows the executor to act below a health factor of 1.1
+	_, err = srv.SetStopLoss(ctx, types.NewMsgSetStopLoss(borrower, executor, sdk.MustNewDecFromStr("1.1")))
+	require.NoError(err)
+	resp, err := s.queryClient.StopLoss(ctx.Context(), &types.QueryStopLoss{Address: borrower.String()})
+	require.NoError(err)
+	require.NotNil(resp.StopLoss)
+	require.Equal(executor.String(), resp.StopLoss.Executor)
+	require.False(resp.Triggered)
+
+	// the stop-loss is not yet triggered
+	_, err = execute(executor, withdraw, repay)
+	require.ErrorIs(err, types.ErrStopLossNotTriggered)
+
+	// ATOM price drops to $35, so health factor is (3500 * 0.26) / 842 = 1.081
+	s.mockOracle.symbolExchangeRates["ATOM"] = sdk.MustNewDecFromStr("35")
+	defer s.mockOracle.Reset()
+	resp, err = s.queryClient.StopLoss(ctx.Context(), &types.QueryStopLoss{Address: borrower.String()})
+	require.NoError(err)
+	require.True(resp.Triggered)
+
+	// only the named executor may execute the stop-loss
+	_, err = execute(other, withdraw, repay)
+	require.ErrorIs(err, types.ErrStopLossExecutor)
+
+	// withdrawing collateral without repaying enough would lower the health factor
+	_, err = execute(executor, withdraw, coin.New(umeeDenom, 1_000000))
+	require.ErrorIs(err, types.ErrStopLossUnhealthy)
+
+	// the executor withdraws 10 ATOM of collateral and repays 50 UMEE
+	// health factor becomes (3150 * 0.26) / 631.5 = 1.297
+	execResp, err := execute(executor, withdraw, repay)
+	require.NoError(err)
+	require.Equal(coin.New(atomDenom, 10_000000), execResp.Received)
+	require.Equal(repay, execResp.Repaid)
+	require.Equal(
+		sdk.NewCoins(coin.New("u/"+atomDenom, 90_000000)),
+		app.LeverageKeeper.GetBorrowerCollateral(ctx, borrower),
+	)
+	require.Equal(
+		sdk.NewCoins(coin.New(umeeDenom, 150_000000)),
+		app.LeverageKeeper.GetBorrowerBorrows(ctx, borrower),
+	)
+	// withdrawn and unspent assets remain with the borrower
+	require.Equal(
+		sdk.NewCoins(coin.New(atomDenom, 10_000000), coin.New(umeeDenom, 150_000000)),
+		app.BankKeeper.GetAllBalances(ctx, borrower),
+	)
+	require.True(app.BankKeeper.GetAllBalances(ctx, executor).IsZero())
+
+	// a zero target removes the stop-loss
+	_, err = srv.SetStopLoss(ctx, types.NewMsgSetStopLoss(borrower, nil, sdk.ZeroDec()))
+	require.NoError(err)
+	resp, err = s.queryClient.StopLoss(ctx.Context(), &types.QueryStopLoss{Address: borrower.String()})
+	require.NoError(err)
+	require.Nil(resp.StopLoss)
+	require.False(resp.Triggered)
+	_, err = execute(executor, withdraw, repay)
+	require.ErrorIs(err, types.ErrNoStopLoss)
+}
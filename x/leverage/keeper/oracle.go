@@ -120,9 +120,10 @@ func (k Keeper) TotalTokenValue(ctx sdk.Context, coins sdk.Coins, mode types.Pri
 }
 
 // VisibleTokenValue functions like TotalTokenValue, but interprets missing oracle prices
-// as zero value instead of returning an error.
+// as zero value instead of returning an error, unless ZeroPricePolicy is ERROR.
 func (k Keeper) VisibleTokenValue(ctx sdk.Context, coins sdk.Coins, mode types.PriceMode) (sdk.Dec, error) {
 	total := sdk.ZeroDec()
+	policy := k.GetParams(ctx).ZeroPricePolicy
 
 	accepted := k.filterAcceptedCoins(ctx, coins)
 
@@ -131,7 +132,7 @@ func (k Keeper) VisibleTokenValue(ctx sdk.Context, coins sdk.Coins, mode types.P
 		if err == nil {
 			total = total.Add(v)
 		}
-		if nonOracleError(err) {
+		if err = visiblePriceError(err, policy); err != nil {
 			return sdk.ZeroDec(), err
 		}
 	}
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/umee-network/umee/v5/util/coin"
 	"github.com/umee-network/umee/v5/x/leverage/types"
 	oracletypes "github.com/umee-network/umee/v5/x/oracle/types"
@@ -418,3 +420,66 @@ func (s *IntegrationTestSuite) TestCollateralPartialPriceOutage() {
 
 	s.mockOracle.Reset()
 }
+
+// TestZeroPricePolicy tests borrow limit, liquidation threshold, and borrow behavior
+// under each ZeroPricePolicy when a collateral token has unknown price
+func (s *IntegrationTestSuite) TestZeroPricePolicy() {
+	app, ctx, require := s.app, s.ctx, s.Require()
+
+	// create a supplier to supply and collateralize 100 UMEE and 10 ATOM
+	supplier := s.newAccount(coin.New(umeeDenom, 100_000000), coin.New(atomDenom, 10_000000))
+	s.supply(supplier, coin.New(umeeDenom, 100_000000), coin.New(atomDenom, 10_000000))
+	s.collateralize(supplier, coin.New("u/"+umeeDenom, 100_000000), coin.New("u/"+atomDenom, 10_000000))
+
+	collateral := app.LeverageKeeper.GetBorrowerCollateral(ctx, supplier)
+	umeeCollateral := sdk.NewCoins(coin.New("u/"+umeeDenom, 100_000000))
+	umeeBorrowLimit, err := app.LeverageKeeper.CalculateBorrowLimit(ctx, umeeCollateral)
+	require.NoError(err)
+	umeeLiquidationThreshold, err := app.LeverageKeeper.CalculateLiquidationThreshold(ctx, umeeCollateral)
+	require.NoError(err)
+
+	// Create an ATOM price outage
+	s.mockOracle.Clear("ATOM")
+
+	setPolicy := func(policy types.ZeroPricePolicy) {
+		params := app.LeverageKeeper.GetParams(ctx)
+		params.ZeroPricePolicy = policy
+		app.LeverageKeeper.SetParams(ctx, params)
+	}
+
+	// EXCLUDE: visible borrow limit skips ATOM, while calculations requiring all prices fail
+	setPolicy(types.ZeroPricePolicy_ZERO_PRICE_POLICY_EXCLUDE)
+	limit, err := app.LeverageKeeper.VisibleBorrowLimit(ctx, collateral)
+	require.NoError(err)
+	require.Equal(umeeBorrowLimit, limit)
+	_, err = app.LeverageKeeper.CalculateBorrowLimit(ctx, collateral)
+	require.ErrorIs(err, oracletypes.ErrUnknownDenom)
+	_, err = app.LeverageKeeper.CalculateLiquidationThreshold(ctx, collateral)
+	require.ErrorIs(err, oracletypes.ErrUnknownDenom)
+
+	// ERROR: visible borrow limit also fails
+	setPolicy(types.ZeroPricePolicy_ZERO_PRICE_POLICY_ERROR)
+	_, err = app.LeverageKeeper.VisibleBorrowLimit(ctx, collateral)
+	require.ErrorIs(err, oracletypes.ErrUnknownDenom)
+	_, err = app.LeverageKeeper.CalculateBorrowLimit(ctx, collateral)
+	require.ErrorIs(err, oracletypes.ErrUnknownDenom)
+	_, err = app.LeverageKeeper.CalculateLiquidationThreshold(ctx, collateral)
+	require.ErrorIs(err, oracletypes.ErrUnknownDenom)
+
+	// TREAT_AS_UNBORROWABLE: ATOM counts as zero collateral everywhere, and cannot be borrowed
+	setPolicy(types.ZeroPricePolicy_ZERO_PRICE_POLICY_TREAT_AS_UNBORROWABLE)
+	limit, err = app.LeverageKeeper.VisibleBorrowLimit(ctx, collateral)
+	require.NoError(err)
+	require.Equal(umeeBorrowLimit, limit)
+	limit, err = app.LeverageKeeper.CalculateBorrowLimit(ctx, collateral)
+	require.NoError(err)
+	require.Equal(umeeBorrowLimit, limit)
+	threshold, err := app.LeverageKeeper.CalculateLiquidationThreshold(ctx, collateral)
+	require.NoError(err)
+	require.Equal(umeeLiquidationThreshold, threshold)
+
+	err = app.LeverageKeeper.Borrow(ctx, supplier, coin.New(atomDenom, 1))
+	require.ErrorIs(err, types.ErrUnborrowablePrice)
+	err = app.LeverageKeeper.Borrow(ctx, supplier, coin.New(umeeDenom, 1))
+	require.NoError(err)
+}
@@ -7,6 +7,15 @@ import (
 	"github.com/umee-network/umee/v5/x/leverage/types"
 )
 
+// addReserves increases the reserved amount of a base token by newly generated reserves,
+// and adds them to the token's lifetime reserves.
+func (k Keeper) addReserves(ctx sdk.Context, token sdk.Coin) error {
+	if err := k.setReserves(ctx, k.GetReserves(ctx, token.Denom).Add(token)); err != nil {
+		return err
+	}
+	return k.setLifetimeReserves(ctx, k.GetLifetimeReserves(ctx, token.Denom).Add(token))
+}
+
 // clearBlacklistedCollateral decollateralizes any blacklisted uTokens
 // from a borrower's collateral. It is used during liquidations and before
 // repaying bad debts with reserves to make any subsequent checks for
@@ -37,7 +46,8 @@ func (k Keeper) clearBlacklistedCollateral(ctx sdk.Context, borrowerAddr sdk.Acc
 }
 
 // checkBadDebt detects if a borrower has zero non-blacklisted collateral,
-// and marks any remaining borrowed tokens as bad debt.
+// and marks any remaining borrowed tokens as bad debt. Newly marked debts
+// are added to the bad debt history.
 func (k Keeper) checkBadDebt(ctx sdk.Context, borrowerAddr sdk.AccAddress) error {
 	// clear blacklisted collateral while checking for any remaining (valid) collateral
 	hasCollateral, err := k.clearBlacklistedCollateral(ctx, borrowerAddr)
@@ -48,6 +58,11 @@ func (k Keeper) checkBadDebt(ctx sdk.Context, borrowerAddr sdk.AccAddress) error
 	// mark bad debt if collateral is completely exhausted
 	if !hasCollateral {
 		for _, coin := range k.GetBorrowerBorrows(ctx, borrowerAddr) {
+			if !k.hasBadDebtAddress(ctx, borrowerAddr, coin.Denom) {
+				if err := k.appendBadDebtRecord(ctx, borrowerAddr, coin); err != nil {
+					return err
+				}
+			}
 			// set a bad debt flag for each borrowed denom
 			if err := k.setBadDebtAddress(ctx, borrowerAddr, coin.Denom, true); err != nil {
 				return err
@@ -114,3 +129,37 @@ func (k Keeper) RepayBadDebt(ctx sdk.Context, borrowerAddr sdk.AccAddress, denom
 	// True is returned on full repayment
 	return newBorrowed.IsZero(), nil
 }
+
+// WithdrawReserves sends reserves of a base token to a recipient and reduces the reserved amount.
+// A zero asset amount withdraws all reserves of its denom. Returns the amount withdrawn.
+func (k Keeper) WithdrawReserves(ctx sdk.Context, recipientAddr sdk.AccAddress, asset sdk.Coin) (sdk.Coin, error) {
+	if err := validateBaseToken(asset); err != nil {
+		return sdk.Coin{}, err
+	}
+	reserved := k.GetReserves(ctx, asset.Denom)
+	if asset.IsZero() {
+		asset = reserved
+	}
+	if asset.IsZero() || reserved.IsLT(asset) {
+		return sdk.Coin{}, types.ErrInsufficientReserves.Wrapf("requested %s, reserved %s", asset, reserved)
+	}
+	// reserves are held in the module account, but may have been lent out
+	if k.ModuleBalance(ctx, asset.Denom).IsLT(asset) {
+		return sdk.Coin{}, types.ErrLendingPoolInsufficient.Wrap(asset.String())
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx, types.ModuleName, recipientAddr, sdk.NewCoins(asset),
+	); err != nil {
+		return sdk.Coin{}, err
+	}
+	newReserved := reserved.Sub(asset)
+	if err := k.setReserves(ctx, newReserved); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	sdkutil.Emit(&ctx, &types.EventWithdrawReserves{
+		Recipient: recipientAddr.String(), Asset: asset, Reserves: newReserved,
+	})
+	return asset, nil
+}
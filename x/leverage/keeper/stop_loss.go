@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v5/x/leverage/types"
+)
+
+// SetStopLoss records a stop-loss allowing an executor to withdraw a borrower's supplied assets and repay
+// its borrows once its health factor falls to a target, replacing any existing stop-loss. A zero target
+// removes the borrower's stop-loss instead.
+func (k Keeper) SetStopLoss(ctx sdk.Context, borrowerAddr, executorAddr sdk.AccAddress, target sdk.Dec) error {
+	if target.IsZero() {
+		k.deleteStopLoss(ctx, borrowerAddr)
+		return nil
+	}
+	return k.setStopLoss(ctx, types.NewStopLoss(borrowerAddr.String(), executorAddr.String(), target))
+}
+
+// ExecuteStopLoss withdraws uTokens from a borrower's wallet or collateral, then repays the borrower's
+// borrows from its wallet, which includes the base tokens just withdrawn. The executor must be the one
+// named by the borrower's stop-loss, and the borrower's health factor must be at or below its target.
+// Borrow limit is not checked, as an account at its stop-loss target may already be over its borrow limit.
+// Instead, the health factor must increase or all borrows must be repaid. All steps succeed or fail together,
+// and no assets leave the borrower's account except the repayment and any withdraw fee. Returns the base
+// tokens received from the withdrawal, the withdraw fee, and the amount repaid.
+func (k Keeper) ExecuteStopLoss(ctx sdk.Context, executorAddr, borrowerAddr sdk.AccAddress, uToken, payment sdk.Coin,
+) (sdk.Coin, sdk.Coin, sdk.Coin, error) {
+	stopLoss, ok := k.GetStopLoss(ctx, borrowerAddr)
+	if !ok {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, types.ErrNoStopLoss.Wrap(borrowerAddr.String())
+	}
+	if stopLoss.Executor != executorAddr.String() {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, types.ErrStopLossExecutor.Wrapf(
+			"%s is not %s", executorAddr, stopLoss.Executor,
+		)
+	}
+	before, hasBorrows, err := k.healthFactor(ctx, borrowerAddr)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+	if !hasBorrows {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, types.ErrStopLossNotTriggered.Wrap("account has no borrows")
+	}
+	if before.GT(stopLoss.TargetHealthFactor) {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, types.ErrStopLossNotTriggered.Wrapf(
+			"health factor %s, target %s", before, stopLoss.TargetHealthFactor,
+		)
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+
+	received, fee, _, err := k.Withdraw(cacheCtx, borrowerAddr, uToken)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+	repaid, err := k.Repay(cacheCtx, borrowerAddr, payment)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	after, hasBorrows, err := k.healthFactor(cacheCtx, borrowerAddr)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+	if hasBorrows && after.LTE(before) {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, types.ErrStopLossUnhealthy.Wrapf("from %s to %s", before, after)
+	}
+	// Ensure MinCollateralLiquidity is still satisfied after the transaction
+	if err = k.checkCollateralLiquidity(cacheCtx, received.Denom); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	write()
+	return received, fee, repaid, nil
+}
+
+// healthFactor returns an account's liquidation threshold divided by its borrowed value, at spot prices.
+// Returns false instead if the account has no borrowed value. Missing prices cause an error.
+func (k Keeper) healthFactor(ctx sdk.Context, addr sdk.AccAddress) (sdk.Dec, bool, error) {
+	borrowedValue, err := k.TotalTokenValue(ctx, k.GetBorrowerBorrows(ctx, addr), types.PriceModeSpot)
+	if err != nil {
+		return sdk.ZeroDec(), false, err
+	}
+	if !borrowedValue.IsPositive() {
+		return sdk.ZeroDec(), false, nil
+	}
+	threshold, err := k.CalculateLiquidationThreshold(ctx, k.GetBorrowerCollateral(ctx, addr))
+	if err != nil {
+		return sdk.ZeroDec(), false, err
+	}
+	return threshold.Quo(borrowedValue), true, nil
+}
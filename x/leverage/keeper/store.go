@@ -1,11 +1,14 @@
 package keeper
 
 import (
+	"bytes"
+
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	gogotypes "github.com/gogo/protobuf/types"
 
 	"github.com/umee-network/umee/v5/util"
+	"github.com/umee-network/umee/v5/util/store"
 	"github.com/umee-network/umee/v5/x/leverage/types"
 )
 
@@ -81,6 +84,57 @@ func (k Keeper) setStoredInt(ctx sdk.Context, key []byte, val sdkmath.Int, desc
 	return nil
 }
 
+// hasAnyKey returns true if any key with a given prefix is present in the store.
+func (k Keeper) hasAnyKey(ctx sdk.Context, prefix []byte) bool {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iter.Close()
+	return iter.Valid()
+}
+
+// hasOtherKey returns true if any key with a given prefix, other than a given key, is present in the store.
+func (k Keeper) hasOtherKey(ctx sdk.Context, prefix, key []byte) bool {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if !bytes.Equal(iter.Key(), key) {
+			return true
+		}
+	}
+	return false
+}
+
+// accountCounted returns whether an account was and is counted by an account counter, given whether one
+// of its amounts, stored at a key under the account's prefix, was and is zero. An account is counted while
+// any of its amounts are nonzero, so its other amounts are only checked when this one crosses zero.
+func (k Keeper) accountCounted(ctx sdk.Context, prefix, key []byte, wasZero, isZero bool) (wasCounted, isCounted bool) {
+	if wasZero == isZero {
+		// the account's count is unchanged
+		return !wasZero, !isZero
+	}
+	hasOthers := k.hasOtherKey(ctx, prefix, key)
+	return !wasZero || hasOthers, !isZero || hasOthers
+}
+
+// getAccountCount returns the number of accounts tracked by the counter at a given key.
+func (k Keeper) getAccountCount(ctx sdk.Context, key []byte) uint64 {
+	return store.GetInteger[uint64](ctx.KVStore(k.storeKey), key)
+}
+
+// updateAccountCount increments or decrements the account counter at a given key if an
+// account has started or stopped being counted.
+func (k Keeper) updateAccountCount(ctx sdk.Context, key []byte, wasCounted, isCounted bool) {
+	count := k.getAccountCount(ctx, key)
+	switch {
+	case isCounted && !wasCounted:
+		count++
+	case wasCounted && !isCounted:
+		count--
+	default:
+		return
+	}
+	store.SetInteger(ctx.KVStore(k.storeKey), key, count)
+}
+
 // getAdjustedBorrow gets the adjusted amount borrowed by an address in a given denom.
 // Returned value is non-negative.
 func (k Keeper) getAdjustedBorrow(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Dec {
@@ -108,7 +162,8 @@ func (k Keeper) setAdjustedBorrow(ctx sdk.Context, addr sdk.AccAddress, adjusted
 	}
 
 	// Determine the increase or decrease in total borrowed. A decrease is negative.
-	delta := adjustedBorrow.Amount.Sub(k.getAdjustedBorrow(ctx, addr, adjustedBorrow.Denom))
+	oldAmount := k.getAdjustedBorrow(ctx, addr, adjustedBorrow.Denom)
+	delta := adjustedBorrow.Amount.Sub(oldAmount)
 
 	// Update total adjusted borrow
 	key := types.KeyAdjustedTotalBorrow(adjustedBorrow.Denom)
@@ -118,9 +173,19 @@ func (k Keeper) setAdjustedBorrow(ctx sdk.Context, addr sdk.AccAddress, adjusted
 		return err
 	}
 
+	k.clearCachedExchangeRate(ctx, adjustedBorrow.Denom)
+
 	// Set new adjusted borrow
 	key = types.KeyAdjustedBorrow(addr, adjustedBorrow.Denom)
-	return k.setStoredDec(ctx, key, adjustedBorrow.Amount, sdk.ZeroDec(), "adjusted borrow")
+	if err := k.setStoredDec(ctx, key, adjustedBorrow.Amount, sdk.ZeroDec(), "adjusted borrow"); err != nil {
+		return err
+	}
+	hadBorrows, hasBorrows := k.accountCounted(
+		ctx, types.KeyAdjustedBorrowNoDenom(addr), key, oldAmount.IsZero(), adjustedBorrow.Amount.IsZero(),
+	)
+	k.updateAccountCount(ctx, types.KeyBorrowerCount, hadBorrows, hasBorrows)
+	k.watchLiquidation(ctx, addr)
+	return nil
 }
 
 // GetCollateral returns an sdk.Coin representing how much of a given denom the
@@ -141,8 +206,106 @@ func (k Keeper) setCollateral(ctx sdk.Context, borrowerAddr sdk.AccAddress, coll
 	if borrowerAddr.Empty() {
 		return types.ErrEmptyAddress
 	}
+	oldAmount := k.GetCollateral(ctx, borrowerAddr, collateral.Denom).Amount
 	key := types.KeyCollateralAmount(borrowerAddr, collateral.Denom)
-	return k.setStoredInt(ctx, key, collateral.Amount, "collateral")
+	if err := k.setStoredInt(ctx, key, collateral.Amount, "collateral"); err != nil {
+		return err
+	}
+	hadCollateral, hasCollateral := k.accountCounted(
+		ctx, types.KeyCollateralAmountNoDenom(borrowerAddr), key, oldAmount.IsZero(), collateral.Amount.IsZero(),
+	)
+	k.updateAccountCount(ctx, types.KeyCollateralAccountCount, hadCollateral, hasCollateral)
+	if hadCollateral && !hasCollateral {
+		// an account without collateral has no collateral change to cool down from
+		ctx.KVStore(k.storeKey).Delete(types.KeyCollateralChange(borrowerAddr))
+	}
+	k.watchLiquidation(ctx, borrowerAddr)
+	return nil
+}
+
+// getCollateralChangeHeight returns the block height at which an address last changed its collateral.
+// Returns 0 if the address has never changed its collateral.
+func (k Keeper) getCollateralChangeHeight(ctx sdk.Context, addr sdk.AccAddress) int64 {
+	return store.GetInteger[int64](ctx.KVStore(k.storeKey), types.KeyCollateralChange(addr))
+}
+
+// setCollateralChangeHeight records the current block height as the last height at which
+// an address changed its collateral. Called after user-initiated collateral changes, and does
+// nothing if the address has no collateral remaining.
+func (k Keeper) setCollateralChangeHeight(ctx sdk.Context, addr sdk.AccAddress) {
+	if k.hasAnyKey(ctx, types.KeyCollateralAmountNoDenom(addr)) {
+		store.SetInteger(ctx.KVStore(k.storeKey), types.KeyCollateralChange(addr), ctx.BlockHeight())
+	}
+}
+
+// getBlockWithdrawals returns the amount of a base token withdrawn during the current block.
+func (k Keeper) getBlockWithdrawals(ctx sdk.Context, denom string) sdkmath.Int {
+	withdrawn := sdk.ZeroInt()
+	if bz := ctx.TransientStore(k.tStoreKey).Get(types.KeyBlockWithdrawals(denom)); bz != nil {
+		util.Panic(withdrawn.Unmarshal(bz))
+	}
+	return withdrawn
+}
+
+// setBlockWithdrawals sets the amount of a base token withdrawn during the current block. Withdrawals
+// are counted in the transient store, so they are discarded at the end of the block instead of being
+// committed.
+func (k Keeper) setBlockWithdrawals(ctx sdk.Context, withdrawn sdk.Coin) error {
+	bz, err := withdrawn.Amount.Marshal()
+	if err != nil {
+		return err
+	}
+	ctx.TransientStore(k.tStoreKey).Set(types.KeyBlockWithdrawals(withdrawn.Denom), bz)
+	return nil
+}
+
+// getCachedExchangeRate returns the uToken exchange rate of a base token cached during the current block,
+// and false if none is cached.
+func (k Keeper) getCachedExchangeRate(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	bz := ctx.TransientStore(k.tStoreKey).Get(types.KeyExchangeRateCache(denom))
+	if bz == nil {
+		return sdk.Dec{}, false
+	}
+	rate := sdk.ZeroDec()
+	util.Panic(rate.Unmarshal(bz))
+	return rate, true
+}
+
+// setCachedExchangeRate caches the uToken exchange rate of a base token for the current block. The cache
+// is kept in the transient store, so it is discarded at the end of the block instead of being committed.
+func (k Keeper) setCachedExchangeRate(ctx sdk.Context, denom string, rate sdk.Dec) error {
+	bz, err := rate.Marshal()
+	if err != nil {
+		return err
+	}
+	ctx.TransientStore(k.tStoreKey).Set(types.KeyExchangeRateCache(denom), bz)
+	return nil
+}
+
+// clearCachedExchangeRate removes the cached uToken exchange rate of a base token. It is called whenever
+// a quantity used to derive the exchange rate is set.
+func (k Keeper) clearCachedExchangeRate(ctx sdk.Context, denom string) {
+	ctx.TransientStore(k.tStoreKey).Delete(types.KeyExchangeRateCache(denom))
+}
+
+// getBorrowAPYSample returns the borrow APY sample stored in a token's ring buffer slot,
+// or an empty sample if none is stored.
+func (k Keeper) getBorrowAPYSample(ctx sdk.Context, denom string, slot uint64) types.BorrowAPYSample {
+	sample := types.BorrowAPYSample{ApySeconds: sdk.ZeroDec()}
+	if bz := ctx.KVStore(k.storeKey).Get(types.KeyBorrowAPYSample(denom, slot)); bz != nil {
+		k.cdc.MustUnmarshal(bz, &sample)
+	}
+	return sample
+}
+
+// setBorrowAPYSample stores a borrow APY sample in a token's ring buffer slot.
+func (k Keeper) setBorrowAPYSample(ctx sdk.Context, denom string, slot uint64, sample types.BorrowAPYSample) error {
+	bz, err := k.cdc.Marshal(&sample)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.KeyBorrowAPYSample(denom, slot), bz)
+	return nil
 }
 
 // GetReserves gets the reserved amount of a specified token.
@@ -159,10 +322,35 @@ func (k Keeper) setReserves(ctx sdk.Context, reserves sdk.Coin) error {
 		return err
 	}
 
+	k.clearCachedExchangeRate(ctx, reserves.Denom)
 	key := types.KeyReserveAmount(reserves.Denom)
 	return k.setStoredInt(ctx, key, reserves.Amount, "reserves")
 }
 
+// GetLifetimeReserves gets the cumulative amount of reserves generated by a specified token.
+// Unlike reserves, it is never reduced by withdrawals or bad debt repayment.
+func (k Keeper) GetLifetimeReserves(ctx sdk.Context, denom string) sdk.Coin {
+	key := types.KeyLifetimeReserves(denom)
+	amount := k.getStoredInt(ctx, key, "lifetime reserves")
+	return sdk.NewCoin(denom, amount)
+}
+
+// setLifetimeReserves sets the cumulative amount of reserves generated by a specified token.
+func (k Keeper) setLifetimeReserves(ctx sdk.Context, reserves sdk.Coin) error {
+	if err := validateBaseToken(reserves); err != nil {
+		return err
+	}
+
+	key := types.KeyLifetimeReserves(reserves.Denom)
+	return k.setStoredInt(ctx, key, reserves.Amount, "lifetime reserves")
+}
+
+// GetLifetimeReservesHeight returns the block height from which lifetime reserves have been counted.
+// Zero means they have been counted since genesis.
+func (k Keeper) GetLifetimeReservesHeight(ctx sdk.Context) int64 {
+	return store.GetInteger[int64](ctx.KVStore(k.storeKey), types.KeyLifetimeReservesHeight)
+}
+
 // getLastInterestTime returns unix timestamp (in seconds) when the last interest was accrued.
 // Returns 0 if the value if the value is absent.
 func (k Keeper) getLastInterestTime(ctx sdk.Context) int64 {
@@ -200,6 +388,17 @@ func (k *Keeper) setLastInterestTime(ctx sdk.Context, interestTime int64) error
 	return nil
 }
 
+// getLastInterestHeight returns the block height at which interest was last accrued.
+// Returns 0 if the value is absent, such as before the first accrual after genesis.
+func (k Keeper) getLastInterestHeight(ctx sdk.Context) int64 {
+	return store.GetInteger[int64](ctx.KVStore(k.storeKey), types.KeyLastInterestHeight)
+}
+
+// setLastInterestHeight records the current block height as the last height at which interest was accrued.
+func (k Keeper) setLastInterestHeight(ctx sdk.Context) {
+	store.SetInteger(ctx.KVStore(k.storeKey), types.KeyLastInterestHeight, ctx.BlockHeight())
+}
+
 // setBadDebtAddress sets or deletes an address in a denom's list of addresses with unpaid bad debt.
 func (k Keeper) setBadDebtAddress(ctx sdk.Context, addr sdk.AccAddress, denom string, hasDebt bool) error {
 	if err := types.ValidateBaseDenom(denom); err != nil {
@@ -220,6 +419,166 @@ func (k Keeper) setBadDebtAddress(ctx sdk.Context, addr sdk.AccAddress, denom st
 	return nil
 }
 
+// hasBadDebtAddress returns true if an address is marked as having unpaid bad debt in a denom.
+func (k Keeper) hasBadDebtAddress(ctx sdk.Context, addr sdk.AccAddress, denom string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.KeyBadDebt(denom, addr))
+}
+
+// appendBadDebtRecord adds a record of an address's borrowed amount being marked as bad debt to the
+// bad debt history at the current height, then removes the oldest records beyond BadDebtHistorySize.
+func (k Keeper) appendBadDebtRecord(ctx sdk.Context, addr sdk.AccAddress, borrowed sdk.Coin) error {
+	kvs := ctx.KVStore(k.storeKey)
+	size := k.GetParams(ctx).BadDebtHistorySize
+	next := store.GetInteger[uint64](kvs, types.KeyBadDebtHistorySequence)
+
+	if size > 0 {
+		record := types.BadDebtRecord{
+			Height:  ctx.BlockHeight(),
+			Address: addr.String(),
+			Denom:   borrowed.Denom,
+			Amount:  borrowed.Amount,
+		}
+		bz, err := k.cdc.Marshal(&record)
+		if err != nil {
+			return err
+		}
+		kvs.Set(types.KeyBadDebtHistory(next), bz)
+		next++
+		store.SetInteger(kvs, types.KeyBadDebtHistorySequence, next)
+	}
+
+	// records are keyed by big-endian sequence number, so iteration visits the oldest first
+	iter := sdk.KVStorePrefixIterator(kvs, types.KeyPrefixBadDebtHistory)
+	defer iter.Close()
+
+	keys := [][]byte{}
+	for ; iter.Valid(); iter.Next() {
+		sequence := sdk.BigEndianToUint64(iter.Key()[len(types.KeyPrefixBadDebtHistory):])
+		if sequence+size >= next {
+			break
+		}
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		kvs.Delete(key)
+	}
+	return nil
+}
+
+// IsAccountFrozen returns true if an address has been frozen by governance.
+func (k Keeper) IsAccountFrozen(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.KeyFrozenAccount(addr))
+}
+
+// setAccountFrozen freezes or unfreezes an address.
+func (k Keeper) setAccountFrozen(ctx sdk.Context, addr sdk.AccAddress, frozen bool) error {
+	if addr.Empty() {
+		return types.ErrEmptyAddress
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyFrozenAccount(addr)
+
+	if frozen {
+		store.Set(key, []byte{0x01})
+	} else {
+		store.Delete(key)
+	}
+	return nil
+}
+
+// watchLiquidation adds an address to the liquidation watchlist as a healthy account, so it is checked
+// for liquidation eligibility at the next EndBlock. Addresses already on the watchlist are unchanged,
+// so an account which was already reported as liquidatable is not reported again.
+func (k Keeper) watchLiquidation(ctx sdk.Context, addr sdk.AccAddress) {
+	kvs := ctx.KVStore(k.storeKey)
+	key := types.KeyLiquidationWatch(addr)
+	if !kvs.Has(key) {
+		kvs.Set(key, []byte{0x00})
+	}
+}
+
+// setLiquidationWatch updates whether an address on the liquidation watchlist was last seen as
+// liquidatable (0x01) or healthy (0x00). The current height is recorded as the address's underwater
+// height if it is newly liquidatable, and its underwater height is cleared if it is healthy. Nothing is
+// written if the address's watch state is unchanged.
+func (k Keeper) setLiquidationWatch(ctx sdk.Context, addr sdk.AccAddress, liquidatable bool) {
+	kvs := ctx.KVStore(k.storeKey)
+	val := []byte{0x00}
+	if liquidatable {
+		val = []byte{0x01}
+		if k.getUnderwaterHeight(ctx, addr) == 0 {
+			store.SetInteger(kvs, types.KeyUnderwaterHeight(addr), ctx.BlockHeight())
+		}
+	} else if kvs.Has(types.KeyUnderwaterHeight(addr)) {
+		kvs.Delete(types.KeyUnderwaterHeight(addr))
+	}
+	key := types.KeyLiquidationWatch(addr)
+	if !bytes.Equal(kvs.Get(key), val) {
+		kvs.Set(key, val)
+	}
+}
+
+// unwatchLiquidation removes an address from the liquidation watchlist, clearing its underwater height.
+func (k Keeper) unwatchLiquidation(ctx sdk.Context, addr sdk.AccAddress) {
+	kvs := ctx.KVStore(k.storeKey)
+	kvs.Delete(types.KeyLiquidationWatch(addr))
+	kvs.Delete(types.KeyUnderwaterHeight(addr))
+}
+
+// getUnderwaterHeight returns the height at which the liquidation watchlist first found an address
+// eligible for liquidation, or zero if it has not been found liquidatable since it was last healthy.
+func (k Keeper) getUnderwaterHeight(ctx sdk.Context, addr sdk.AccAddress) int64 {
+	return store.GetInteger[int64](ctx.KVStore(k.storeKey), types.KeyUnderwaterHeight(addr))
+}
+
+// GetAccountBorrowCap returns the maximum USD value an address may have borrowed, as set by governance,
+// or zero if the address has no borrow cap.
+func (k Keeper) GetAccountBorrowCap(ctx sdk.Context, addr sdk.AccAddress) sdk.Dec {
+	return k.getStoredDec(ctx, types.KeyAccountBorrowCap(addr), sdk.ZeroDec(), "account borrow cap")
+}
+
+// setAccountBorrowCap sets the borrow cap of an address. A zero cap clears any stored value.
+func (k Keeper) setAccountBorrowCap(ctx sdk.Context, addr sdk.AccAddress, borrowCap sdk.Dec) error {
+	if addr.Empty() {
+		return types.ErrEmptyAddress
+	}
+	return k.setStoredDec(ctx, types.KeyAccountBorrowCap(addr), borrowCap, sdk.ZeroDec(), "account borrow cap")
+}
+
+// GetStopLoss returns the stop-loss recorded by an address, and false if none is set.
+func (k Keeper) GetStopLoss(ctx sdk.Context, addr sdk.AccAddress) (types.StopLoss, bool) {
+	var stopLoss types.StopLoss
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyStopLoss(addr))
+	if bz == nil {
+		return stopLoss, false
+	}
+	k.cdc.MustUnmarshal(bz, &stopLoss)
+	return stopLoss, true
+}
+
+// setStopLoss records a stop-loss for its borrower address, replacing any existing one.
+func (k Keeper) setStopLoss(ctx sdk.Context, stopLoss types.StopLoss) error {
+	if err := stopLoss.Validate(); err != nil {
+		return err
+	}
+	addr, err := sdk.AccAddressFromBech32(stopLoss.Borrower)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&stopLoss)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.KeyStopLoss(addr), bz)
+	return nil
+}
+
+// deleteStopLoss removes the stop-loss recorded by an address, if any.
+func (k Keeper) deleteStopLoss(ctx sdk.Context, addr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyStopLoss(addr))
+}
+
 // getInterestScalar gets the interest scalar for a given base token
 // denom. Returns 1.0 if no value is stored.
 func (k Keeper) getInterestScalar(ctx sdk.Context, denom string) sdk.Dec {
@@ -232,10 +591,69 @@ func (k Keeper) setInterestScalar(ctx sdk.Context, denom string, scalar sdk.Dec)
 	if err := types.ValidateBaseDenom(denom); err != nil {
 		return err
 	}
+	k.clearCachedExchangeRate(ctx, denom)
 	key := types.KeyInterestScalar(denom)
 	return k.setStoredDec(ctx, key, scalar, sdk.OneDec(), "interest scalar")
 }
 
+// getSmoothedUtilization returns the smoothed supply utilization of a base token denom, as of its last
+// interest accrual. Returns zero if the value is absent.
+func (k Keeper) getSmoothedUtilization(ctx sdk.Context, denom string) sdk.Dec {
+	key := types.KeySmoothedUtilization(denom)
+	return k.getStoredDec(ctx, key, sdk.ZeroDec(), "smoothed utilization")
+}
+
+// setSmoothedUtilization sets the smoothed supply utilization of a base token denom.
+func (k Keeper) setSmoothedUtilization(ctx sdk.Context, denom string, utilization sdk.Dec) error {
+	if err := types.ValidateBaseDenom(denom); err != nil {
+		return err
+	}
+	key := types.KeySmoothedUtilization(denom)
+	return k.setStoredDec(ctx, key, utilization, sdk.ZeroDec(), "smoothed utilization")
+}
+
+// GetLiquidationRewardsPaid gets the cumulative amount of a base token paid to liquidators as
+// liquidation rewards, with uToken rewards counted at their exchange rate when paid.
+func (k Keeper) GetLiquidationRewardsPaid(ctx sdk.Context, denom string) sdk.Coin {
+	key := types.KeyLiquidationRewardsPaid(denom)
+	amount := k.getStoredInt(ctx, key, "liquidation rewards paid")
+	return sdk.NewCoin(denom, amount)
+}
+
+// setLiquidationRewardsPaid sets the cumulative amount of a base token paid to liquidators as
+// liquidation rewards.
+func (k Keeper) setLiquidationRewardsPaid(ctx sdk.Context, rewards sdk.Coin) error {
+	if err := validateBaseToken(rewards); err != nil {
+		return err
+	}
+
+	key := types.KeyLiquidationRewardsPaid(rewards.Denom)
+	return k.setStoredInt(ctx, key, rewards.Amount, "liquidation rewards paid")
+}
+
+// GetLiquidationRewardsHeight returns the block height from which liquidation rewards paid have been
+// counted. Zero means they have been counted since genesis.
+func (k Keeper) GetLiquidationRewardsHeight(ctx sdk.Context) int64 {
+	return store.GetInteger[int64](ctx.KVStore(k.storeKey), types.KeyLiquidationRewardsHeight)
+}
+
+// getCircuitBreakerExpiry returns the first block height at which a base token is no longer paused
+// by its price circuit breaker. Returns 0 if the circuit breaker is not set.
+func (k Keeper) getCircuitBreakerExpiry(ctx sdk.Context, denom string) int64 {
+	return store.GetInteger[int64](ctx.KVStore(k.storeKey), types.KeyCircuitBreaker(denom))
+}
+
+// setCircuitBreakerExpiry sets the first block height at which a base token is no longer paused
+// by its price circuit breaker. Zero clears the circuit breaker.
+func (k Keeper) setCircuitBreakerExpiry(ctx sdk.Context, denom string, height int64) {
+	key := types.KeyCircuitBreaker(denom)
+	if height == 0 {
+		ctx.KVStore(k.storeKey).Delete(key)
+		return
+	}
+	store.SetInteger(ctx.KVStore(k.storeKey), key, height)
+}
+
 // GetUTokenSupply gets the total supply of a specified utoken, as tracked by
 // module state. On invalid asset or non-uToken, the supply is zero.
 func (k Keeper) GetUTokenSupply(ctx sdk.Context, denom string) sdk.Coin {
@@ -249,6 +667,7 @@ func (k Keeper) setUTokenSupply(ctx sdk.Context, uToken sdk.Coin) error {
 	if err := validateUToken(uToken); err != nil {
 		return err
 	}
+	k.clearCachedExchangeRate(ctx, types.ToTokenDenom(uToken.Denom))
 	key := types.KeyUTokenSupply(uToken.Denom)
 	return k.setStoredInt(ctx, key, uToken.Amount, "uToken supply")
 }
@@ -9,7 +9,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
@@ -62,6 +64,7 @@ func (s *IntegrationTestSuite) SetupTest() {
 	k, tk := keeper.NewTestKeeper(
 		app.AppCodec(),
 		app.GetKey(types.ModuleName),
+		app.GetTKey(types.TStoreKey),
 		app.GetSubspace(types.ModuleName),
 		app.BankKeeper,
 		s.mockOracle,
@@ -237,6 +240,17 @@ func (s *IntegrationTestSuite) setReserves(coins ...sdk.Coin) {
 	}
 }
 
+// typedEvents returns the typed events emitted to a context's event manager, skipping untyped events.
+func (s *IntegrationTestSuite) typedEvents(ctx sdk.Context) []proto.Message {
+	events := []proto.Message{}
+	for _, e := range ctx.EventManager().Events() {
+		if msg, err := sdk.ParseTypedEvent(abci.Event(e)); err == nil {
+			events = append(events, msg)
+		}
+	}
+	return events
+}
+
 // checkInvariants is used during other tests to quickly test all invariants,
 // including the inefficient ones we do not run in production
 func (s *IntegrationTestSuite) checkInvariants(msg string) {
@@ -41,6 +41,28 @@ func (k Keeper) GetAllSupplied(ctx sdk.Context, supplierAddr sdk.AccAddress) (sd
 	return k.ExchangeUTokens(ctx, collateral.Add(uTokens...))
 }
 
+// fullExitShortfalls returns the amount of each base token by which the module's available liquidity
+// falls short of what an account would receive if it repaid all of its borrows, then withdrew all of its
+// supplied tokens, including collateral. Repayments add to available liquidity before withdrawals. The
+// account's own wallet balances, needed to repay, are not considered. Returns empty coins if there are
+// no shortfalls.
+func (k Keeper) fullExitShortfalls(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coins, error) {
+	supplied, err := k.GetAllSupplied(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	borrowed := k.GetBorrowerBorrows(ctx, addr)
+
+	shortfalls := sdk.NewCoins()
+	for _, coin := range supplied {
+		liquidity := k.AvailableLiquidity(ctx, coin.Denom).Add(borrowed.AmountOf(coin.Denom))
+		if coin.Amount.GT(liquidity) {
+			shortfalls = shortfalls.Add(coin.SubAmount(liquidity))
+		}
+	}
+	return shortfalls, nil
+}
+
 // GetTotalSupply returns the total supplied by all suppliers in a given denom,
 // including any interest accrued.
 func (k Keeper) GetTotalSupply(ctx sdk.Context, denom string) (sdk.Coin, error) {
@@ -1,9 +1,15 @@
 package keeper
 
 import (
+	"crypto/sha256"
+	"errors"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v5/util"
+	"github.com/umee-network/umee/v5/util/sdkutil"
+	"github.com/umee-network/umee/v5/util/store"
 	"github.com/umee-network/umee/v5/x/leverage/types"
 )
 
@@ -29,9 +35,10 @@ func (k Keeper) CleanTokenRegistry(ctx sdk.Context) error {
 // deleteTokenSettings deletes a Token in the x/leverage module's KVStore.
 // it should only be called by CleanTokenRegistry.
 func (k Keeper) deleteTokenSettings(ctx sdk.Context, token types.Token) error {
-	store := ctx.KVStore(k.storeKey)
+	kvs := ctx.KVStore(k.storeKey)
 	tokenKey := types.KeyRegisteredToken(token.BaseDenom)
-	store.Delete(tokenKey)
+	kvs.Delete(tokenKey)
+	k.setRegistryUpdateHeight(ctx)
 	// call token hooks on deleted (not just blacklisted) token
 	k.afterRegisteredTokenRemoved(ctx, token)
 	return nil
@@ -43,7 +50,7 @@ func (k Keeper) SetTokenSettings(ctx sdk.Context, token types.Token) error {
 		return err
 	}
 
-	store := ctx.KVStore(k.storeKey)
+	kvs := ctx.KVStore(k.storeKey)
 	tokenKey := types.KeyRegisteredToken(token.BaseDenom)
 
 	bz, err := k.cdc.Marshal(&token)
@@ -52,17 +59,18 @@ func (k Keeper) SetTokenSettings(ctx sdk.Context, token types.Token) error {
 	}
 
 	k.afterTokenRegistered(ctx, token)
-	store.Set(tokenKey, bz)
+	kvs.Set(tokenKey, bz)
+	k.setRegistryUpdateHeight(ctx)
 	return nil
 }
 
 // GetTokenSettings gets a token from the x/leverage module's KVStore.
 func (k Keeper) GetTokenSettings(ctx sdk.Context, denom string) (types.Token, error) {
-	store := ctx.KVStore(k.storeKey)
+	kvs := ctx.KVStore(k.storeKey)
 	tokenKey := types.KeyRegisteredToken(denom)
 
 	token := types.Token{}
-	bz := store.Get(tokenKey)
+	bz := kvs.Get(tokenKey)
 	if len(bz) == 0 {
 		return token, types.ErrNotRegisteredToken.Wrap(denom)
 	}
@@ -71,6 +79,20 @@ func (k Keeper) GetTokenSettings(ctx sdk.Context, denom string) (types.Token, er
 	return token, err
 }
 
+// marketToken gets a registered token given either its base denom or its uToken denom.
+// Queries about a single market use it, so clients may identify the market by either.
+func (k Keeper) marketToken(ctx sdk.Context, denom string) (types.Token, error) {
+	baseDenom := denom
+	if types.HasUTokenPrefix(denom) {
+		baseDenom = types.ToTokenDenom(denom)
+	}
+	token, err := k.GetTokenSettings(ctx, baseDenom)
+	if errors.Is(err, types.ErrNotRegisteredToken) {
+		return token, types.ErrNotRegisteredToken.Wrapf("%s is neither a registered token nor its uToken", denom)
+	}
+	return token, err
+}
+
 // SaveOrUpdateTokenSettingsToRegistry adds new tokens or updates the new tokens settings to registry.
 // It requires maps of the currently registered base and symbol denoms, so it can prevent duplicates of either.
 func (k Keeper) SaveOrUpdateTokenSettingsToRegistry(
@@ -106,3 +128,57 @@ func (k Keeper) SaveOrUpdateTokenSettingsToRegistry(
 
 	return nil
 }
+
+// SetOracleSymbol changes the symbol denom used to look up a registered token's oracle price.
+// The new symbol cannot already belong to another registered token.
+func (k Keeper) SetOracleSymbol(ctx sdk.Context, denom, symbolDenom string) error {
+	token, err := k.GetTokenSettings(ctx, denom)
+	if err != nil {
+		return err
+	}
+	for _, t := range k.GetAllRegisteredTokens(ctx) {
+		if t.BaseDenom != denom && strings.EqualFold(t.SymbolDenom, symbolDenom) {
+			return types.ErrDuplicateToken.Wrapf("symbol denom %s is already registered", symbolDenom)
+		}
+	}
+
+	oldSymbolDenom := token.SymbolDenom
+	token.SymbolDenom = symbolDenom
+	if err := k.SetTokenSettings(ctx, token); err != nil {
+		return err
+	}
+
+	sdkutil.Emit(&ctx, &types.EventSetOracleSymbol{
+		Denom:          denom,
+		OldSymbolDenom: oldSymbolDenom,
+		NewSymbolDenom: symbolDenom,
+	})
+	return nil
+}
+
+// setRegistryUpdateHeight records the current block height as the last height at which
+// the token registry was modified.
+func (k Keeper) setRegistryUpdateHeight(ctx sdk.Context) {
+	store.SetInteger(ctx.KVStore(k.storeKey), types.KeyRegistryUpdateHeight, ctx.BlockHeight())
+}
+
+// GetRegistryUpdateHeight returns the last block height at which the token registry was modified.
+func (k Keeper) GetRegistryUpdateHeight(ctx sdk.Context) int64 {
+	return store.GetInteger[int64](ctx.KVStore(k.storeKey), types.KeyRegistryUpdateHeight)
+}
+
+// RegistryHash computes a SHA-256 hash over the serialized token registry. Tokens are
+// hashed in store key order, which is sorted by base denom, so the hash is deterministic.
+// Each serialized token is length-prefixed so that token boundaries are unambiguous.
+func (k Keeper) RegistryHash(ctx sdk.Context) []byte {
+	h := sha256.New()
+	iterator := func(_, val []byte) error {
+		if _, err := h.Write(sdk.Uint64ToBigEndian(uint64(len(val)))); err != nil {
+			return err
+		}
+		_, err := h.Write(val)
+		return err
+	}
+	util.Panic(k.iterate(ctx, types.KeyPrefixRegisteredToken, iterator))
+	return h.Sum(nil)
+}
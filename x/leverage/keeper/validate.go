@@ -89,3 +89,56 @@ func validateUToken(coin sdk.Coin) error {
 	}
 	return nil
 }
+
+// validateNotFrozen returns an error if an address has been frozen by governance.
+func (k Keeper) validateNotFrozen(ctx sdk.Context, addr sdk.AccAddress) error {
+	if k.IsAccountFrozen(ctx, addr) {
+		return types.ErrAccountFrozen.Wrap(addr.String())
+	}
+	return nil
+}
+
+// validateNotSelfBorrow returns an error if a token with NoSelfBorrow is being borrowed by an
+// address which has that token's uToken collateralized.
+func (k Keeper) validateNotSelfBorrow(ctx sdk.Context, addr sdk.AccAddress, denom string) error {
+	token, err := k.GetTokenSettings(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if token.NoSelfBorrow && k.GetCollateral(ctx, addr, types.ToUTokenDenom(denom)).IsPositive() {
+		return types.ErrSelfBorrow.Wrap(denom)
+	}
+	return nil
+}
+
+// validateLiquidationGracePeriod returns an error if a borrower was found eligible for liquidation by
+// the liquidation watchlist fewer than LiquidationGracePeriod blocks ago. Borrowers which have not been
+// found liquidatable are not protected by the grace period.
+func (k Keeper) validateLiquidationGracePeriod(ctx sdk.Context, borrowerAddr sdk.AccAddress) error {
+	gracePeriod := k.GetParams(ctx).LiquidationGracePeriod
+	since := k.getUnderwaterHeight(ctx, borrowerAddr)
+	if gracePeriod == 0 || since == 0 {
+		return nil
+	}
+	if end := since + int64(gracePeriod); ctx.BlockHeight() < end {
+		return types.ErrLiquidationGracePeriod.Wrapf("%s until height %d", borrowerAddr, end)
+	}
+	return nil
+}
+
+// validateAccountBorrowCap returns an error if an address has a borrow cap set by governance, and
+// the value of a set of borrowed tokens, using the higher of spot or historic prices, would exceed it.
+func (k Keeper) validateAccountBorrowCap(ctx sdk.Context, addr sdk.AccAddress, borrowed sdk.Coins) error {
+	borrowCap := k.GetAccountBorrowCap(ctx, addr)
+	if !borrowCap.IsPositive() {
+		return nil
+	}
+	value, err := k.TotalTokenValue(ctx, borrowed, types.PriceModeHigh)
+	if err != nil {
+		return err
+	}
+	if value.GT(borrowCap) {
+		return types.ErrAccountBorrowCap.Wrapf("borrowed: %s, cap: %s", value, borrowCap)
+	}
+	return nil
+}
@@ -92,6 +92,9 @@ func RandomizedGenState(simState *module.SimulationState) {
 		[]types.BadDebt{},
 		[]types.InterestScalar{},
 		sdk.Coins{},
+		[]string{},
+		[]types.StopLoss{},
+		[]types.AccountBorrowCap{},
 	)
 
 	bz, err := json.MarshalIndent(&leverageGenesis.Params, "", " ")
@@ -38,6 +38,17 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSupplyCollateral{}, "umee/leverage/MsgSupplyCollateral", nil)
 	cdc.RegisterConcrete(&MsgMaxWithdraw{}, "umee/leverage/MsgMaxWithdraw", nil)
 	cdc.RegisterConcrete(&MsgMaxBorrow{}, "umee/leverage/MsgMaxBorrow", nil)
+	cdc.RegisterConcrete(&MsgRepayWithdraw{}, "umee/leverage/MsgRepayWithdraw", nil)
+	cdc.RegisterConcrete(&MsgFreezeAccount{}, "umee/leverage/MsgFreezeAccount", nil)
+	cdc.RegisterConcrete(&MsgUnfreezeAccount{}, "umee/leverage/MsgUnfreezeAccount", nil)
+	cdc.RegisterConcrete(&MsgWithdrawReserves{}, "umee/leverage/MsgWithdrawReserves", nil)
+	cdc.RegisterConcrete(&MsgBorrowWithTopUp{}, "umee/leverage/MsgBorrowWithTopUp", nil)
+	cdc.RegisterConcrete(&MsgSetOracleSymbol{}, "umee/leverage/MsgSetOracleSymbol", nil)
+	cdc.RegisterConcrete(&MsgSetStopLoss{}, "umee/leverage/MsgSetStopLoss", nil)
+	cdc.RegisterConcrete(&MsgExecuteStopLoss{}, "umee/leverage/MsgExecuteStopLoss", nil)
+	cdc.RegisterConcrete(&MsgLeverageLoop{}, "umee/leverage/MsgLeverageLoop", nil)
+	cdc.RegisterConcrete(&MsgSwapCollateral{}, "umee/leverage/MsgSwapCollateral", nil)
+	cdc.RegisterConcrete(&MsgSetAccountBorrowCap{}, "umee/leverage/MsgSetAccountBorrowCap", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -54,6 +65,17 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgSupplyCollateral{},
 		&MsgMaxWithdraw{},
 		&MsgMaxBorrow{},
+		&MsgRepayWithdraw{},
+		&MsgFreezeAccount{},
+		&MsgUnfreezeAccount{},
+		&MsgWithdrawReserves{},
+		&MsgBorrowWithTopUp{},
+		&MsgSetOracleSymbol{},
+		&MsgSetStopLoss{},
+		&MsgExecuteStopLoss{},
+		&MsgLeverageLoop{},
+		&MsgSwapCollateral{},
+		&MsgSetAccountBorrowCap{},
 	)
 
 	registry.RegisterImplementations(
@@ -1,3 +1,20 @@
 package types
 
 const SecondsPerYear = 31536000
+
+const (
+	// BorrowAPYSampleSeconds is the length of the sampling interval used to track trailing
+	// average borrow APY.
+	BorrowAPYSampleSeconds = 3600
+	// BorrowAPYSampleCount is the number of sampling intervals stored per token, which bounds
+	// the maximum lookback of the AverageBorrowAPY query to one week.
+	BorrowAPYSampleCount = 168
+)
+
+const (
+	// LeverageLoopMaxIterations bounds the number of borrow steps performed by a single MsgLeverageLoop.
+	LeverageLoopMaxIterations = 10
+	// LeverageLoopDustDivisor defines dust for MsgLeverageLoop: borrow steps no larger than the
+	// initial asset amount divided by this value are not performed.
+	LeverageLoopDustDivisor = 1000
+)
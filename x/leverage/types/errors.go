@@ -13,6 +13,8 @@ var (
 	ErrGetAmount        = errors.Register(ModuleName, 102, "retrieved invalid amount")
 	ErrSetAmount        = errors.Register(ModuleName, 103, "cannot set invalid amount")
 	ErrInvalidPriceMode = errors.Register(ModuleName, 104, "invalid price mode")
+	ErrInvalidLookback  = errors.Register(ModuleName, 105, "invalid lookback")
+	ErrInvalidInterval  = errors.Register(ModuleName, 106, "invalid series interval")
 
 	// 2XX = Token Registry
 	ErrNotRegisteredToken   = errors.Register(ModuleName, 200, "not a registered Token")
@@ -27,12 +29,22 @@ var (
 	)
 	ErrDuplicateToken          = errors.Register(ModuleName, 207, "duplicate token")
 	ErrEmptyAddAndUpdateTokens = errors.Register(ModuleName, 208, "empty add and update tokens")
+	ErrDenomCollision          = errors.Register(ModuleName, 209, "token denom collision")
 
 	// 3XX = User Positions
 	ErrInsufficientBalance    = errors.Register(ModuleName, 300, "insufficient balance")
 	ErrInsufficientCollateral = errors.Register(ModuleName, 301, "insufficient collateral")
 	ErrLiquidationRepayZero   = errors.Register(ModuleName, 303, "liquidation would repay zero tokens")
 	ErrBondedCollateral       = errors.Register(ModuleName, 304, "collateral is bonded to incentive module")
+	ErrBorrowCooldown         = errors.Register(ModuleName, 305, "cannot borrow during cooldown after collateral change")
+	ErrAccountFrozen          = errors.Register(ModuleName, 306, "account is frozen")
+	ErrInsufficientTopUp      = errors.Register(ModuleName, 307, "collateral top-up cannot reach target health factor")
+	ErrSelfBorrow             = errors.Register(ModuleName, 308, "cannot borrow a token which is collateralized by the borrower")
+	ErrMaxAccountLeverage     = errors.Register(ModuleName, 309, "borrow would exceed MaxAccountLeverage")
+	ErrNoStopLoss             = errors.Register(ModuleName, 310, "account has no stop-loss")
+	ErrStopLossExecutor       = errors.Register(ModuleName, 311, "signer is not the stop-loss executor")
+	ErrLiquidationGracePeriod = errors.Register(ModuleName, 312, "borrower is in its liquidation grace period")
+	ErrAccountBorrowCap       = errors.Register(ModuleName, 313, "borrow would exceed the account's borrow cap")
 
 	// 4XX = Price Sensitive
 	ErrBadValue              = errors.Register(ModuleName, 400, "bad USD value")
@@ -40,6 +52,11 @@ var (
 	ErrUndercollaterized     = errors.Register(ModuleName, 402, "borrow positions are undercollaterized")
 	ErrLiquidationIneligible = errors.Register(ModuleName, 403, "borrower not eligible for liquidation")
 	ErrNoHistoricMedians     = errors.Register(ModuleName, 405, "insufficient historic medians available")
+	ErrUnborrowablePrice     = errors.Register(ModuleName, 406, "token with zero or missing price cannot be borrowed")
+	ErrInvalidGuardPrice     = errors.Register(ModuleName, 407, "invalid liquidation guard price")
+	ErrGuardPriceExceeded    = errors.Register(ModuleName, 408, "oracle price is above liquidation guard price")
+	ErrStopLossNotTriggered  = errors.Register(ModuleName, 409, "health factor is above stop-loss target")
+	ErrStopLossUnhealthy     = errors.Register(ModuleName, 410, "stop-loss execution must increase health factor")
 
 	// 5XX = Market Conditions
 	ErrLendingPoolInsufficient = errors.Register(ModuleName, 500, "lending pool insufficient")
@@ -47,6 +64,8 @@ var (
 	ErrMinCollateralLiquidity  = errors.Register(ModuleName, 502, "market would fall below MinCollateralLiquidity")
 	ErrMaxCollateralShare      = errors.Register(ModuleName, 503, "market would exceed MaxCollateralShare")
 	ErrMaxSupply               = errors.Register(ModuleName, 504, "market would exceed MaxSupply")
+	ErrMaxWithdrawRate         = errors.Register(ModuleName, 505, "market would exceed MaxWithdrawRatePerBlock")
+	ErrInsufficientReserves    = errors.Register(ModuleName, 506, "insufficient reserves")
 
 	// 6XX = Internal Failsafes
 	ErrInvalidUtilization      = errors.Register(ModuleName, 600, "invalid token utilization")
@@ -60,4 +79,7 @@ var (
 
 	// 7XX = Disabled Functionality
 	ErrNotLiquidatorNode = errors.Register(ModuleName, 700, "node has disabled liquidator queries")
+	ErrBorrowPaused      = errors.Register(ModuleName, 701, "borrowing is paused")
+	ErrSupplyPaused      = errors.Register(ModuleName, 702, "supplying is paused")
+	ErrCircuitBreaker    = errors.Register(ModuleName, 703, "token is paused by price circuit breaker")
 )
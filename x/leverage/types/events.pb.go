@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -74,8 +75,12 @@ type EventWithdraw struct {
 	Supplier string `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier,omitempty"`
 	// uToken sent to the module in exchange for the underlying asset.
 	Utoken types.Coin `protobuf:"bytes,2,opt,name=utoken,proto3" json:"utoken"`
-	// Liquidity received by the supplier.
+	// Liquidity received by the supplier, or by the recipient if one is set.
 	Asset types.Coin `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset"`
+	// Recipient bech32 address, if the liquidity was sent to an account other than the supplier.
+	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// Withdraw fee added to reserves instead of being received.
+	Fee types.Coin `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee"`
 }
 
 func (m *EventWithdraw) Reset()         { *m = EventWithdraw{} }
@@ -240,6 +245,8 @@ type EventRepay struct {
 	Borrower string `protobuf:"bytes,1,opt,name=borrower,proto3" json:"borrower,omitempty"`
 	// Asset repaid
 	Repaid types.Coin `protobuf:"bytes,2,opt,name=repaid,proto3" json:"repaid"`
+	// Recipient bech32 address, if the repaid borrow belonged to an account other than the borrower.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
 }
 
 func (m *EventRepay) Reset()         { *m = EventRepay{} }
@@ -487,6 +494,386 @@ func (m *EventFundOracle) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventFundOracle proto.InternalMessageInfo
 
+// EventWithdrawReserves is emitted when reserves are withdrawn by governance.
+type EventWithdrawReserves struct {
+	// Recipient bech32 address.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// Reserves withdrawn
+	Asset types.Coin `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset"`
+	// Reserves remaining
+	Reserves types.Coin `protobuf:"bytes,3,opt,name=reserves,proto3" json:"reserves"`
+}
+
+func (m *EventWithdrawReserves) Reset()         { *m = EventWithdrawReserves{} }
+func (m *EventWithdrawReserves) String() string { return proto.CompactTextString(m) }
+func (*EventWithdrawReserves) ProtoMessage()    {}
+func (*EventWithdrawReserves) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf62b4902d7471c, []int{11}
+}
+func (m *EventWithdrawReserves) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventWithdrawReserves) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventWithdrawReserves.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventWithdrawReserves) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventWithdrawReserves.Merge(m, src)
+}
+func (m *EventWithdrawReserves) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventWithdrawReserves) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventWithdrawReserves.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventWithdrawReserves proto.InternalMessageInfo
+
+// EventSetOracleSymbol is emitted when governance changes the oracle symbol of a registered token.
+type EventSetOracleSymbol struct {
+	// Base denom of the token.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Symbol denom before the change.
+	OldSymbolDenom string `protobuf:"bytes,2,opt,name=old_symbol_denom,json=oldSymbolDenom,proto3" json:"old_symbol_denom,omitempty"`
+	// Symbol denom after the change.
+	NewSymbolDenom string `protobuf:"bytes,3,opt,name=new_symbol_denom,json=newSymbolDenom,proto3" json:"new_symbol_denom,omitempty"`
+}
+
+func (m *EventSetOracleSymbol) Reset()         { *m = EventSetOracleSymbol{} }
+func (m *EventSetOracleSymbol) String() string { return proto.CompactTextString(m) }
+func (*EventSetOracleSymbol) ProtoMessage()    {}
+func (*EventSetOracleSymbol) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf62b4902d7471c, []int{12}
+}
+func (m *EventSetOracleSymbol) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetOracleSymbol) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetOracleSymbol.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSetOracleSymbol) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetOracleSymbol.Merge(m, src)
+}
+func (m *EventSetOracleSymbol) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetOracleSymbol) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetOracleSymbol.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetOracleSymbol proto.InternalMessageInfo
+
+// EventBecameLiquidatable is emitted in EndBlock when a borrower on the liquidation watchlist
+// becomes eligible for liquidation.
+type EventBecameLiquidatable struct {
+	// Borrower bech32 address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// USD value by which the borrower's debt exceeds their liquidation threshold.
+	Shortfall github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=shortfall,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shortfall"`
+}
+
+func (m *EventBecameLiquidatable) Reset()         { *m = EventBecameLiquidatable{} }
+func (m *EventBecameLiquidatable) String() string { return proto.CompactTextString(m) }
+func (*EventBecameLiquidatable) ProtoMessage()    {}
+func (*EventBecameLiquidatable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf62b4902d7471c, []int{13}
+}
+func (m *EventBecameLiquidatable) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBecameLiquidatable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBecameLiquidatable.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBecameLiquidatable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBecameLiquidatable.Merge(m, src)
+}
+func (m *EventBecameLiquidatable) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBecameLiquidatable) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBecameLiquidatable.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBecameLiquidatable proto.InternalMessageInfo
+
+// EventCircuitBreakerTriggered is emitted in BeginBlock when the spot price of a token deviates from
+// its trailing median price by more than CircuitBreakerDeviation, pausing borrowing and liquidations
+// involving the token.
+type EventCircuitBreakerTriggered struct {
+	// Base denom of the token.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Spot price of the token.
+	SpotPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=spot_price,json=spotPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"spot_price"`
+	// Historic price of the token: the median of its last HistoricMedians median prices.
+	HistoricPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=historic_price,json=historicPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"historic_price"`
+	// Expiry height is the first block height at which the token is no longer paused,
+	// unless the circuit breaker is triggered again.
+	ExpiryHeight int64 `protobuf:"varint,4,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *EventCircuitBreakerTriggered) Reset()         { *m = EventCircuitBreakerTriggered{} }
+func (m *EventCircuitBreakerTriggered) String() string { return proto.CompactTextString(m) }
+func (*EventCircuitBreakerTriggered) ProtoMessage()    {}
+func (*EventCircuitBreakerTriggered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf62b4902d7471c, []int{14}
+}
+func (m *EventCircuitBreakerTriggered) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCircuitBreakerTriggered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCircuitBreakerTriggered.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCircuitBreakerTriggered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCircuitBreakerTriggered.Merge(m, src)
+}
+func (m *EventCircuitBreakerTriggered) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCircuitBreakerTriggered) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCircuitBreakerTriggered.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCircuitBreakerTriggered proto.InternalMessageInfo
+
+// EventSetStopLoss is emitted when a borrower records or removes its stop-loss.
+type EventSetStopLoss struct {
+	// Borrower bech32 address.
+	Borrower string `protobuf:"bytes,1,opt,name=borrower,proto3" json:"borrower,omitempty"`
+	// Executor bech32 address. Empty if the stop-loss was removed.
+	Executor string `protobuf:"bytes,2,opt,name=executor,proto3" json:"executor,omitempty"`
+	// Target health factor. Zero if the stop-loss was removed.
+	TargetHealthFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=target_health_factor,json=targetHealthFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_health_factor"`
+}
+
+func (m *EventSetStopLoss) Reset()         { *m = EventSetStopLoss{} }
+func (m *EventSetStopLoss) String() string { return proto.CompactTextString(m) }
+func (*EventSetStopLoss) ProtoMessage()    {}
+func (*EventSetStopLoss) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf62b4902d7471c, []int{15}
+}
+func (m *EventSetStopLoss) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetStopLoss) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetStopLoss.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSetStopLoss) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetStopLoss.Merge(m, src)
+}
+func (m *EventSetStopLoss) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetStopLoss) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetStopLoss.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetStopLoss proto.InternalMessageInfo
+
+// EventExecuteStopLoss is emitted when an executor withdraws and repays on behalf of a borrower
+// using its stop-loss. The withdrawal and repayment emit their own events.
+type EventExecuteStopLoss struct {
+	// Borrower bech32 address.
+	Borrower string `protobuf:"bytes,1,opt,name=borrower,proto3" json:"borrower,omitempty"`
+	// Executor bech32 address.
+	Executor string `protobuf:"bytes,2,opt,name=executor,proto3" json:"executor,omitempty"`
+}
+
+func (m *EventExecuteStopLoss) Reset()         { *m = EventExecuteStopLoss{} }
+func (m *EventExecuteStopLoss) String() string { return proto.CompactTextString(m) }
+func (*EventExecuteStopLoss) ProtoMessage()    {}
+func (*EventExecuteStopLoss) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf62b4902d7471c, []int{16}
+}
+func (m *EventExecuteStopLoss) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventExecuteStopLoss) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventExecuteStopLoss.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventExecuteStopLoss) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventExecuteStopLoss.Merge(m, src)
+}
+func (m *EventExecuteStopLoss) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventExecuteStopLoss) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventExecuteStopLoss.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventExecuteStopLoss proto.InternalMessageInfo
+
+// EventFreezeAccount is emitted when governance freezes an account.
+type EventFreezeAccount struct {
+	// Account bech32 address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *EventFreezeAccount) Reset()         { *m = EventFreezeAccount{} }
+func (m *EventFreezeAccount) String() string { return proto.CompactTextString(m) }
+func (*EventFreezeAccount) ProtoMessage()    {}
+func (*EventFreezeAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf62b4902d7471c, []int{17}
+}
+func (m *EventFreezeAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFreezeAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFreezeAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFreezeAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFreezeAccount.Merge(m, src)
+}
+func (m *EventFreezeAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFreezeAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFreezeAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFreezeAccount proto.InternalMessageInfo
+
+// EventUnfreezeAccount is emitted when governance unfreezes an account.
+type EventUnfreezeAccount struct {
+	// Account bech32 address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *EventUnfreezeAccount) Reset()         { *m = EventUnfreezeAccount{} }
+func (m *EventUnfreezeAccount) String() string { return proto.CompactTextString(m) }
+func (*EventUnfreezeAccount) ProtoMessage()    {}
+func (*EventUnfreezeAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf62b4902d7471c, []int{18}
+}
+func (m *EventUnfreezeAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUnfreezeAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUnfreezeAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUnfreezeAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUnfreezeAccount.Merge(m, src)
+}
+func (m *EventUnfreezeAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUnfreezeAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUnfreezeAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUnfreezeAccount proto.InternalMessageInfo
+
+// EventSetAccountBorrowCap is emitted when governance sets or clears an account's borrow cap.
+type EventSetAccountBorrowCap struct {
+	// Account bech32 address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Maximum USD value the account can borrow. Zero if the cap was cleared.
+	BorrowCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=borrow_cap,json=borrowCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"borrow_cap"`
+}
+
+func (m *EventSetAccountBorrowCap) Reset()         { *m = EventSetAccountBorrowCap{} }
+func (m *EventSetAccountBorrowCap) String() string { return proto.CompactTextString(m) }
+func (*EventSetAccountBorrowCap) ProtoMessage()    {}
+func (*EventSetAccountBorrowCap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf62b4902d7471c, []int{19}
+}
+func (m *EventSetAccountBorrowCap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetAccountBorrowCap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetAccountBorrowCap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSetAccountBorrowCap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetAccountBorrowCap.Merge(m, src)
+}
+func (m *EventSetAccountBorrowCap) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetAccountBorrowCap) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetAccountBorrowCap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetAccountBorrowCap proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*EventSupply)(nil), "umee.leverage.v1.EventSupply")
 	proto.RegisterType((*EventWithdraw)(nil), "umee.leverage.v1.EventWithdraw")
@@ -499,53 +886,86 @@ func init() {
 	proto.RegisterType((*EventRepayBadDebt)(nil), "umee.leverage.v1.EventRepayBadDebt")
 	proto.RegisterType((*EventReservesExhausted)(nil), "umee.leverage.v1.EventReservesExhausted")
 	proto.RegisterType((*EventFundOracle)(nil), "umee.leverage.v1.EventFundOracle")
+	proto.RegisterType((*EventWithdrawReserves)(nil), "umee.leverage.v1.EventWithdrawReserves")
+	proto.RegisterType((*EventSetOracleSymbol)(nil), "umee.leverage.v1.EventSetOracleSymbol")
+	proto.RegisterType((*EventBecameLiquidatable)(nil), "umee.leverage.v1.EventBecameLiquidatable")
+	proto.RegisterType((*EventCircuitBreakerTriggered)(nil), "umee.leverage.v1.EventCircuitBreakerTriggered")
+	proto.RegisterType((*EventSetStopLoss)(nil), "umee.leverage.v1.EventSetStopLoss")
+	proto.RegisterType((*EventExecuteStopLoss)(nil), "umee.leverage.v1.EventExecuteStopLoss")
+	proto.RegisterType((*EventFreezeAccount)(nil), "umee.leverage.v1.EventFreezeAccount")
+	proto.RegisterType((*EventUnfreezeAccount)(nil), "umee.leverage.v1.EventUnfreezeAccount")
+	proto.RegisterType((*EventSetAccountBorrowCap)(nil), "umee.leverage.v1.EventSetAccountBorrowCap")
 }
 
 func init() { proto.RegisterFile("umee/leverage/v1/events.proto", fileDescriptor_aaf62b4902d7471c) }
 
 var fileDescriptor_aaf62b4902d7471c = []byte{
-	// 647 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x95, 0xcb, 0x6e, 0xd4, 0x3c,
-	0x14, 0xc7, 0xc7, 0x33, 0xf3, 0x55, 0xad, 0xfb, 0xf5, 0x42, 0x54, 0xa1, 0xb4, 0x82, 0x50, 0xb2,
-	0xea, 0xa6, 0x09, 0x05, 0x2a, 0x90, 0x58, 0xa0, 0x4e, 0x2f, 0x82, 0x0a, 0x81, 0x34, 0x5d, 0x20,
-	0xb1, 0x19, 0x39, 0xf1, 0x51, 0xc6, 0x6a, 0x12, 0x07, 0xdb, 0x49, 0x2f, 0x6c, 0x40, 0xbc, 0x00,
-	0x6f, 0xc0, 0x43, 0x00, 0x0f, 0xc0, 0xae, 0xcb, 0x8a, 0x15, 0x0b, 0x84, 0xa0, 0x7d, 0x11, 0x14,
-	0x27, 0x9d, 0x0c, 0x2b, 0xd2, 0x2c, 0x60, 0x17, 0x1f, 0xff, 0xff, 0xe7, 0xfc, 0x8e, 0x7d, 0x22,
-	0xe3, 0xeb, 0x69, 0x04, 0xe0, 0x86, 0x90, 0x81, 0x20, 0x01, 0xb8, 0xd9, 0x9a, 0x0b, 0x19, 0xc4,
-	0x4a, 0x3a, 0x89, 0xe0, 0x8a, 0x1b, 0xf3, 0xf9, 0xb6, 0x73, 0xb1, 0xed, 0x64, 0x6b, 0x4b, 0x96,
-	0xcf, 0x65, 0xc4, 0xa5, 0xeb, 0x11, 0x99, 0xcb, 0x3d, 0x50, 0x64, 0xcd, 0xf5, 0x39, 0x8b, 0x0b,
-	0xc7, 0xd2, 0x62, 0xb1, 0x3f, 0xd0, 0x2b, 0xb7, 0x58, 0x94, 0x5b, 0x0b, 0x01, 0x0f, 0x78, 0x11,
-	0xcf, 0xbf, 0x8a, 0xa8, 0xfd, 0x01, 0xe1, 0xe9, 0xed, 0xbc, 0xe6, 0x5e, 0x9a, 0x24, 0xe1, 0x91,
-	0x71, 0x17, 0x4f, 0xca, 0xfc, 0x8b, 0x81, 0x30, 0xd1, 0x32, 0x5a, 0x99, 0xea, 0x99, 0x5f, 0x3e,
-	0xae, 0x2e, 0x94, 0x99, 0x36, 0x28, 0x15, 0x20, 0xe5, 0x9e, 0x12, 0x2c, 0x0e, 0xfa, 0x23, 0xa5,
-	0xb1, 0x8e, 0xff, 0x23, 0x52, 0x82, 0x32, 0xdb, 0xcb, 0x68, 0x65, 0xfa, 0xf6, 0xa2, 0x53, 0xea,
-	0x73, 0x4c, 0xa7, 0xc4, 0x74, 0x36, 0x39, 0x8b, 0x7b, 0xdd, 0x93, 0xef, 0x37, 0x5a, 0xfd, 0x42,
-	0x6d, 0xdc, 0xc3, 0x13, 0xa9, 0xe2, 0xfb, 0x10, 0x9b, 0x9d, 0x7a, 0xbe, 0x52, 0x6e, 0x7f, 0x42,
-	0x78, 0x46, 0x53, 0x3f, 0x67, 0x6a, 0x48, 0x05, 0x39, 0x68, 0xc8, 0x5d, 0x01, 0xb4, 0x2f, 0x05,
-	0x50, 0x35, 0xdc, 0xb9, 0x4c, 0xc3, 0xf6, 0x1b, 0x84, 0xe7, 0x35, 0xf7, 0x26, 0x0f, 0x43, 0xa2,
-	0x40, 0xb0, 0x63, 0xc8, 0xd1, 0x3d, 0x2e, 0x04, 0x3f, 0xa8, 0x83, 0x7e, 0xa1, 0x6c, 0x8c, 0x6e,
-	0xbf, 0x45, 0xd8, 0xd0, 0x0c, 0x5b, 0xe0, 0xff, 0x3b, 0x8a, 0xe3, 0x72, 0xec, 0x7a, 0x3a, 0x53,
-	0xc3, 0xea, 0xcd, 0xc6, 0xce, 0x7e, 0x85, 0xb1, 0xae, 0xdd, 0x87, 0x84, 0x1c, 0x35, 0x6f, 0x5c,
-	0x40, 0x42, 0x18, 0xad, 0xdd, 0x78, 0x21, 0xb7, 0x3f, 0x23, 0x3c, 0xab, 0xab, 0x3f, 0x61, 0x2f,
-	0x53, 0x46, 0x89, 0x02, 0xe3, 0x3e, 0xc6, 0x61, 0xb9, 0xe0, 0x7f, 0x66, 0x18, 0xd3, 0xfe, 0xc6,
-	0xde, 0xae, 0xcd, 0xfe, 0xb0, 0xaa, 0x07, 0xb4, 0xee, 0x04, 0x8f, 0x59, 0xec, 0x6f, 0x08, 0x2f,
-	0xe8, 0x1e, 0x1e, 0xc7, 0x0a, 0x04, 0x48, 0xb5, 0xe1, 0xfb, 0x22, 0x25, 0xa1, 0x71, 0x13, 0xff,
-	0xef, 0x85, 0xdc, 0xdf, 0x1f, 0x0c, 0x81, 0x05, 0x43, 0xa5, 0x7b, 0xe9, 0xf6, 0xa7, 0x75, 0xec,
-	0x91, 0x0e, 0x19, 0xd7, 0xf0, 0x94, 0x62, 0x11, 0x48, 0x45, 0xa2, 0x44, 0x33, 0x77, 0xfb, 0x55,
-	0xc0, 0xd8, 0xc1, 0xb3, 0x8a, 0x2b, 0x12, 0x0e, 0x58, 0x99, 0xd9, 0xec, 0x2c, 0x77, 0xea, 0xe0,
-	0xcd, 0x68, 0xdb, 0x05, 0x8f, 0xf1, 0x00, 0x4f, 0x0a, 0x90, 0x20, 0x32, 0xa0, 0x66, 0xb7, 0x5e,
-	0x86, 0x91, 0xc1, 0x7e, 0x8d, 0xf0, 0x95, 0x6a, 0x40, 0x7a, 0x84, 0x6e, 0x81, 0xa7, 0xfe, 0xee,
-	0x88, 0xbe, 0x6f, 0xe3, 0xab, 0x25, 0x82, 0x86, 0x92, 0xdb, 0x87, 0x43, 0x92, 0x4a, 0x05, 0xb4,
-	0x21, 0xc7, 0x2e, 0x9e, 0xe7, 0xa9, 0x92, 0x8a, 0xc4, 0x94, 0xc5, 0xc1, 0x80, 0x82, 0x57, 0x1b,
-	0x69, 0x6e, 0xcc, 0xa8, 0x4f, 0x62, 0x07, 0xcf, 0x46, 0x9c, 0xa6, 0x21, 0x0c, 0x3c, 0x12, 0x92,
-	0xd8, 0x87, 0xba, 0x33, 0x34, 0x53, 0xd8, 0x7a, 0x85, 0x6b, 0xec, 0x92, 0xa4, 0xd9, 0xad, 0x97,
-	0x61, 0x64, 0xb0, 0x77, 0xf1, 0x9c, 0x3e, 0xa0, 0x9d, 0x34, 0xa6, 0xcf, 0x04, 0xf1, 0x43, 0xc8,
-	0xff, 0x49, 0x7d, 0x7a, 0xd2, 0x44, 0xf5, 0xae, 0xbc, 0x94, 0xf7, 0x9e, 0x9e, 0xfc, 0xb4, 0x5a,
-	0x27, 0x67, 0x16, 0x3a, 0x3d, 0xb3, 0xd0, 0x8f, 0x33, 0x0b, 0xbd, 0x3b, 0xb7, 0x5a, 0xa7, 0xe7,
-	0x56, 0xeb, 0xeb, 0xb9, 0xd5, 0x7a, 0x71, 0x2b, 0x60, 0x6a, 0x98, 0x7a, 0x8e, 0xcf, 0x23, 0x37,
-	0x7f, 0x90, 0x57, 0x63, 0x50, 0x07, 0x5c, 0xec, 0xeb, 0x85, 0x9b, 0xad, 0xbb, 0x87, 0xd5, 0x0b,
-	0xae, 0x8e, 0x12, 0x90, 0xde, 0x84, 0x7e, 0x5b, 0xef, 0xfc, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x1a,
-	0xcd, 0xfc, 0x5d, 0xdf, 0x07, 0x00, 0x00,
+	// 1033 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6e, 0xe4, 0x44,
+	0x17, 0x8d, 0xe3, 0xce, 0x7c, 0xc9, 0xcd, 0x24, 0x93, 0xcf, 0x0a, 0xe0, 0x19, 0x0d, 0x3d, 0xc1,
+	0x48, 0x28, 0x9b, 0x74, 0x93, 0x61, 0x06, 0x90, 0x58, 0xa0, 0x74, 0x7e, 0x14, 0xa2, 0x0c, 0xa0,
+	0x6e, 0x46, 0x48, 0x6c, 0x4c, 0xb9, 0x7c, 0xe3, 0x2e, 0xc5, 0xed, 0x32, 0x55, 0xe5, 0xce, 0xcf,
+	0x6a, 0x80, 0x17, 0x60, 0x8f, 0x10, 0xbc, 0x03, 0xbc, 0x00, 0x62, 0x93, 0xe5, 0x88, 0x15, 0x42,
+	0x68, 0x34, 0x24, 0x0f, 0x02, 0x72, 0x95, 0x9d, 0xee, 0x5e, 0xa0, 0x38, 0x46, 0x62, 0x56, 0xdd,
+	0x55, 0x3e, 0xf7, 0xd4, 0xb9, 0xc7, 0x75, 0xeb, 0x96, 0xe1, 0xd5, 0x6c, 0x80, 0xd8, 0x8e, 0x71,
+	0x88, 0x82, 0x44, 0xd8, 0x1e, 0xae, 0xb7, 0x71, 0x88, 0x89, 0x92, 0xad, 0x54, 0x70, 0xc5, 0x9d,
+	0xa5, 0xfc, 0x71, 0xab, 0x7c, 0xdc, 0x1a, 0xae, 0xdf, 0x69, 0x52, 0x2e, 0x07, 0x5c, 0xb6, 0x03,
+	0x22, 0x73, 0x78, 0x80, 0x8a, 0xac, 0xb7, 0x29, 0x67, 0x89, 0x89, 0xb8, 0x73, 0xdb, 0x3c, 0xf7,
+	0xf5, 0xa8, 0x6d, 0x06, 0xc5, 0xa3, 0xe5, 0x88, 0x47, 0xdc, 0xcc, 0xe7, 0xff, 0xcc, 0xac, 0xf7,
+	0xa3, 0x05, 0xf3, 0xdb, 0xf9, 0x9a, 0xbd, 0x2c, 0x4d, 0xe3, 0x13, 0xe7, 0x01, 0xcc, 0xca, 0xfc,
+	0x1f, 0x43, 0xe1, 0x5a, 0x2b, 0xd6, 0xea, 0x5c, 0xc7, 0xfd, 0xf5, 0xa7, 0xb5, 0xe5, 0x82, 0x69,
+	0x23, 0x0c, 0x05, 0x4a, 0xd9, 0x53, 0x82, 0x25, 0x51, 0xf7, 0x12, 0xe9, 0x3c, 0x84, 0x19, 0x22,
+	0x25, 0x2a, 0x77, 0x7a, 0xc5, 0x5a, 0x9d, 0xbf, 0x7f, 0xbb, 0x55, 0xe0, 0x73, 0x99, 0xad, 0x42,
+	0x66, 0x6b, 0x93, 0xb3, 0xa4, 0xd3, 0x38, 0x7b, 0x76, 0x6f, 0xaa, 0x6b, 0xd0, 0xce, 0x3b, 0x70,
+	0x23, 0x53, 0xfc, 0x10, 0x13, 0xd7, 0xae, 0x16, 0x57, 0xc0, 0xbd, 0x1f, 0xa6, 0x61, 0x41, 0xab,
+	0xfe, 0x94, 0xa9, 0x7e, 0x28, 0xc8, 0x51, 0x4d, 0xdd, 0x23, 0x01, 0xd3, 0xd7, 0x12, 0x30, 0x4a,
+	0xd8, 0xbe, 0x56, 0xc2, 0x6f, 0xc3, 0x9c, 0x40, 0xca, 0x52, 0x86, 0x89, 0x72, 0x1b, 0x57, 0xc8,
+	0x1c, 0x41, 0x9d, 0x75, 0xb0, 0x0f, 0x10, 0xdd, 0x99, 0x6a, 0x8b, 0xe5, 0x58, 0xef, 0x4b, 0x0b,
+	0x96, 0xb4, 0x45, 0x9b, 0x3c, 0x8e, 0x89, 0x42, 0xc1, 0x4e, 0x31, 0x77, 0x29, 0xe0, 0x42, 0xf0,
+	0xa3, 0x2a, 0x2e, 0x95, 0xc8, 0xda, 0x2e, 0x79, 0x5f, 0x5b, 0xe0, 0x68, 0x0d, 0x5b, 0x48, 0x5f,
+	0x9c, 0x8a, 0xd3, 0x62, 0x87, 0x77, 0x34, 0x53, 0xcd, 0xd5, 0xeb, 0xed, 0xf0, 0xbc, 0xbc, 0x40,
+	0x2f, 0xde, 0xc5, 0x94, 0x9c, 0xd4, 0xcf, 0x5c, 0x60, 0x4a, 0x58, 0x58, 0x39, 0x73, 0x03, 0x9f,
+	0xdc, 0x6e, 0x76, 0xe5, 0xed, 0xe6, 0xfd, 0x6c, 0xc1, 0xa2, 0x56, 0xbd, 0xcf, 0xbe, 0xc8, 0x58,
+	0x48, 0x14, 0x3a, 0xef, 0x02, 0xc4, 0xc5, 0x80, 0x5f, 0xad, 0x7d, 0x0c, 0x3b, 0x91, 0xf3, 0x74,
+	0xe5, 0x9c, 0xdf, 0x1f, 0xad, 0x87, 0x61, 0xd5, 0x2a, 0x1b, 0x0b, 0xf1, 0xfe, 0xb0, 0x60, 0x59,
+	0xe7, 0xf0, 0x41, 0xa2, 0x50, 0xa0, 0x54, 0x1b, 0x94, 0x8a, 0x8c, 0xc4, 0xce, 0x6b, 0x70, 0x33,
+	0x88, 0x39, 0x3d, 0xf4, 0xfb, 0xc8, 0xa2, 0xbe, 0xd2, 0xb9, 0x34, 0xba, 0xf3, 0x7a, 0x6e, 0x57,
+	0x4f, 0x39, 0x77, 0x61, 0x4e, 0xb1, 0x01, 0x4a, 0x45, 0x06, 0xa9, 0xd6, 0xdc, 0xe8, 0x8e, 0x26,
+	0x9c, 0x1d, 0x58, 0x54, 0x5c, 0x91, 0xd8, 0x67, 0x05, 0xb3, 0x6b, 0xaf, 0xd8, 0x55, 0xe4, 0x2d,
+	0xe8, 0xb0, 0x52, 0x8f, 0xf3, 0x1e, 0xcc, 0x0a, 0x94, 0x28, 0x86, 0x18, 0xba, 0x8d, 0x6a, 0x0c,
+	0x97, 0x01, 0xde, 0x13, 0x0b, 0xfe, 0x3f, 0xda, 0x58, 0x1d, 0x12, 0x6e, 0x61, 0xa0, 0xfe, 0xdb,
+	0xbd, 0xfd, 0xfd, 0x34, 0xbc, 0x5c, 0x48, 0xd0, 0xa2, 0xe4, 0xf6, 0x71, 0x9f, 0x64, 0x52, 0x61,
+	0x58, 0x53, 0xc7, 0x1e, 0x2c, 0xf1, 0x4c, 0x49, 0x45, 0x92, 0x90, 0x25, 0x91, 0x1f, 0x62, 0x50,
+	0x59, 0xd2, 0xad, 0xb1, 0x40, 0xed, 0xc4, 0x0e, 0x2c, 0x0e, 0x78, 0x98, 0xc5, 0xe8, 0x07, 0x24,
+	0x26, 0x09, 0xc5, 0xaa, 0x7b, 0x68, 0xc1, 0x84, 0x75, 0x4c, 0xd4, 0xd8, 0x4b, 0x92, 0x6e, 0xa3,
+	0x1a, 0xc3, 0x65, 0x80, 0xb7, 0x07, 0xb7, 0xb4, 0x41, 0x3b, 0x59, 0x12, 0x7e, 0x24, 0x08, 0x8d,
+	0x31, 0xaf, 0x65, 0xed, 0x9e, 0x74, 0xad, 0x6a, 0xaf, 0xbc, 0x80, 0x7b, 0xbf, 0x58, 0xf0, 0xd2,
+	0x44, 0xcb, 0x2b, 0x5d, 0x9f, 0xac, 0x72, 0xab, 0x7a, 0x53, 0xa9, 0xd9, 0xb4, 0xc7, 0x1d, 0xb1,
+	0xaf, 0xeb, 0xc8, 0x93, 0xb2, 0x2a, 0x7b, 0xa8, 0x8c, 0x23, 0xbd, 0x93, 0x41, 0xc0, 0x63, 0x67,
+	0x19, 0x66, 0x42, 0x4c, 0xf8, 0xc0, 0x24, 0xd0, 0x35, 0x03, 0x67, 0x15, 0x96, 0x78, 0x1c, 0xfa,
+	0x52, 0x63, 0x7c, 0x03, 0xd0, 0x67, 0x48, 0x77, 0x91, 0xc7, 0xa1, 0x09, 0xdd, 0x2a, 0x91, 0x09,
+	0x1e, 0x4d, 0x22, 0x6d, 0x83, 0x4c, 0xf0, 0x68, 0x0c, 0xe9, 0x7d, 0x6b, 0xc1, 0x2b, 0xa6, 0x1f,
+	0x20, 0x25, 0x03, 0x2c, 0x8f, 0x38, 0x12, 0xc4, 0xe8, 0xdc, 0x87, 0xff, 0x11, 0x63, 0xd7, 0x95,
+	0x46, 0x96, 0x40, 0x67, 0x1f, 0xe6, 0x64, 0x9f, 0x0b, 0x75, 0x40, 0xe2, 0xb8, 0x38, 0xe0, 0x5a,
+	0x79, 0xd6, 0xbf, 0x3f, 0xbb, 0xf7, 0x46, 0xc4, 0x54, 0x3f, 0x0b, 0x5a, 0x94, 0x0f, 0x8a, 0xbb,
+	0x58, 0xf1, 0xb3, 0x26, 0xc3, 0xc3, 0xb6, 0x3a, 0x49, 0x51, 0xb6, 0xb6, 0x90, 0x76, 0x47, 0x04,
+	0xde, 0x5f, 0x16, 0xdc, 0x35, 0x6d, 0x9b, 0x09, 0x9a, 0x31, 0xd5, 0x11, 0x48, 0x0e, 0x51, 0x7c,
+	0x22, 0x58, 0x14, 0xa1, 0xc0, 0xf0, 0x1f, 0x8c, 0x7a, 0x04, 0x20, 0x53, 0xae, 0xfc, 0x54, 0x30,
+	0x8a, 0xb5, 0x55, 0xa4, 0x5c, 0x7d, 0x9c, 0x13, 0x38, 0x8f, 0x61, 0xb1, 0xcf, 0xa4, 0xe2, 0x82,
+	0xd1, 0x82, 0xd2, 0xae, 0x45, 0xb9, 0x50, 0xb2, 0x18, 0xda, 0xd7, 0x61, 0x01, 0x8f, 0x53, 0x26,
+	0x4e, 0xca, 0xb3, 0x37, 0xaf, 0x28, 0xbb, 0x7b, 0xd3, 0x4c, 0x9a, 0xc3, 0xd7, 0x7b, 0x5e, 0x5e,
+	0x5c, 0x7a, 0xa8, 0x7a, 0x8a, 0xa7, 0xfb, 0x5c, 0xca, 0x9a, 0x07, 0xca, 0x03, 0x98, 0xc5, 0x63,
+	0xa4, 0x59, 0xde, 0xb2, 0xae, 0x6c, 0x3d, 0x25, 0xd2, 0xf9, 0x1c, 0x96, 0x15, 0x11, 0x11, 0x2a,
+	0xbf, 0x8f, 0x24, 0x56, 0x7d, 0xff, 0x80, 0xd0, 0x9c, 0xa1, 0x9e, 0x05, 0x8e, 0xe1, 0xda, 0xd5,
+	0x54, 0x3b, 0x9a, 0xc9, 0xfb, 0xaa, 0xac, 0x82, 0x6d, 0xbd, 0x26, 0xbe, 0x88, 0x34, 0xbd, 0xdd,
+	0xe2, 0x6e, 0xb6, 0x23, 0x10, 0x4f, 0x71, 0x83, 0x52, 0x9e, 0x25, 0xaa, 0x4e, 0x05, 0x78, 0x7b,
+	0x45, 0x36, 0x8f, 0x93, 0x83, 0x7f, 0xcd, 0xf5, 0x9d, 0x05, 0x6e, 0xf9, 0xf6, 0x0b, 0x1e, 0x73,
+	0x6f, 0xdb, 0x24, 0x69, 0xad, 0xf2, 0x7c, 0x04, 0x60, 0x8c, 0xf2, 0x29, 0x49, 0xeb, 0x56, 0x46,
+	0x50, 0x4a, 0xe8, 0x7c, 0x78, 0xf6, 0x67, 0x73, 0xea, 0xec, 0xbc, 0x69, 0x3d, 0x3d, 0x6f, 0x5a,
+	0xcf, 0xcf, 0x9b, 0xd6, 0x37, 0x17, 0xcd, 0xa9, 0xa7, 0x17, 0xcd, 0xa9, 0xdf, 0x2e, 0x9a, 0x53,
+	0x9f, 0xbd, 0x39, 0x46, 0x98, 0x7f, 0xbb, 0xad, 0x25, 0xa8, 0x8e, 0xb8, 0x38, 0xd4, 0x83, 0xf6,
+	0xf0, 0x61, 0xfb, 0x78, 0xf4, 0xb1, 0xa7, 0xe9, 0x83, 0x1b, 0xfa, 0x33, 0xec, 0xad, 0xbf, 0x07,
+	0x00, 0x5e, 0x10, 0xaa, 0x57, 0x0a, 0x0e, 0x00, 0x00,
 }
 
 func (m *EventSupply) Marshal() (dAtA []byte, err error) {
@@ -618,6 +1038,23 @@ func (m *EventWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
 	{
 		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -788,6 +1225,13 @@ func (m *EventRepay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.Repaid.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1053,213 +1497,1746 @@ func (m *EventFundOracle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventWithdrawReserves) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventSupply) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Supplier)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.Asset.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = m.Utoken.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+
+func (m *EventWithdrawReserves) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventWithdraw) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventWithdrawReserves) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Supplier)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.Utoken.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = m.Asset.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	{
+		size, err := m.Reserves.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventCollaterize) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSetOracleSymbol) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetOracleSymbol) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetOracleSymbol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Borrower)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.NewSymbolDenom) > 0 {
+		i -= len(m.NewSymbolDenom)
+		copy(dAtA[i:], m.NewSymbolDenom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewSymbolDenom)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.Utoken.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	if len(m.OldSymbolDenom) > 0 {
+		i -= len(m.OldSymbolDenom)
+		copy(dAtA[i:], m.OldSymbolDenom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OldSymbolDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventDecollaterize) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventBecameLiquidatable) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBecameLiquidatable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBecameLiquidatable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Borrower)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.Shortfall.Size()
+		i -= size
+		if _, err := m.Shortfall.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = m.Utoken.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventBorrow) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventCircuitBreakerTriggered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCircuitBreakerTriggered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCircuitBreakerTriggered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Borrower)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = m.Asset.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	{
+		size := m.HistoricPrice.Size()
+		i -= size
+		if _, err := m.HistoricPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SpotPrice.Size()
+		i -= size
+		if _, err := m.SpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRepay) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSetStopLoss) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetStopLoss) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetStopLoss) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Borrower)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.TargetHealthFactor.Size()
+		i -= size
+		if _, err := m.TargetHealthFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = m.Repaid.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventLiquidate) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventExecuteStopLoss) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventExecuteStopLoss) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventExecuteStopLoss) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Liquidator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Borrower)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Liquidated.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventInterestAccrual) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventFreezeAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFreezeAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFreezeAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvents(uint64(m.BlockHeight))
-	}
-	if m.Timestamp != 0 {
-		n += 1 + sovEvents(uint64(m.Timestamp))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.TotalInterest) > 0 {
-		for _, e := range m.TotalInterest {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUnfreezeAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Reserved) > 0 {
-		for _, e := range m.Reserved {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *EventUnfreezeAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUnfreezeAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSetAccountBorrowCap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetAccountBorrowCap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetAccountBorrowCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BorrowCap.Size()
+		i -= size
+		if _, err := m.BorrowCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventSupply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Supplier)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Asset.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Utoken.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Supplier)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Utoken.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Asset.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventCollaterize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Utoken.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventDecollaterize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Utoken.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventBorrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Asset.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventRepay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Repaid.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventLiquidate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Liquidator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Liquidated.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventInterestAccrual) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovEvents(uint64(m.Timestamp))
+	}
+	if len(m.TotalInterest) > 0 {
+		for _, e := range m.TotalInterest {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Reserved) > 0 {
+		for _, e := range m.Reserved {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventRepayBadDebt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Asset.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventReservesExhausted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.OutstandingDebt.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.ModuleBalance.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Reserves.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventFundOracle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Assets) > 0 {
+		for _, e := range m.Assets {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventWithdrawReserves) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Asset.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Reserves.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSetOracleSymbol) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OldSymbolDenom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewSymbolDenom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventBecameLiquidatable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Shortfall.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventCircuitBreakerTriggered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.SpotPrice.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.HistoricPrice.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *EventSetStopLoss) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.TargetHealthFactor.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventExecuteStopLoss) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFreezeAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUnfreezeAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSetAccountBorrowCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BorrowCap.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventSupply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSupply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSupply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Supplier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Utoken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Utoken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventWithdraw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Supplier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Utoken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Utoken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCollaterize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCollaterize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCollaterize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Utoken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Utoken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDecollaterize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDecollaterize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDecollaterize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Utoken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Utoken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBorrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBorrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBorrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRepay) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRepay: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRepay: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repaid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Repaid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventLiquidate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventLiquidate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventLiquidate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liquidator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *EventRepayBadDebt) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Borrower)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.Asset.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
-}
-
-func (m *EventReservesExhausted) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Borrower)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.OutstandingDebt.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = m.ModuleBalance.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = m.Reserves.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
-}
 
-func (m *EventFundOracle) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Assets) > 0 {
-		for _, e := range m.Assets {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventSupply) Unmarshal(dAtA []byte) error {
+func (m *EventInterestAccrual) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1282,17 +3259,55 @@ func (m *EventSupply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSupply: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventInterestAccrual: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSupply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventInterestAccrual: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalInterest", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1302,29 +3317,115 @@ func (m *EventSupply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Supplier = string(dAtA[iNdEx:postIndex])
+			m.TotalInterest = append(m.TotalInterest, types.Coin{})
+			if err := m.TotalInterest[len(m.TotalInterest)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserved", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reserved = append(m.Reserved, types.Coin{})
+			if err := m.Reserved[len(m.Reserved)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRepayBadDebt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRepayBadDebt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRepayBadDebt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1334,28 +3435,27 @@ func (m *EventSupply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Borrower = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Utoken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1382,7 +3482,7 @@ func (m *EventSupply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Utoken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1407,7 +3507,7 @@ func (m *EventSupply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
+func (m *EventReservesExhausted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1430,15 +3530,15 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventReservesExhausted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventReservesExhausted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1466,11 +3566,11 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Supplier = string(dAtA[iNdEx:postIndex])
+			m.Borrower = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Utoken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingDebt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1497,13 +3597,13 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Utoken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OutstandingDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleBalance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1530,7 +3630,40 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ModuleBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reserves.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1555,7 +3688,7 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCollaterize) Unmarshal(dAtA []byte) error {
+func (m *EventFundOracle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1578,47 +3711,15 @@ func (m *EventCollaterize) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCollaterize: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventFundOracle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCollaterize: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventFundOracle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Borrower = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Utoken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1645,7 +3746,8 @@ func (m *EventCollaterize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Utoken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Assets = append(m.Assets, types.Coin{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1670,7 +3772,7 @@ func (m *EventCollaterize) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDecollaterize) Unmarshal(dAtA []byte) error {
+func (m *EventWithdrawReserves) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1693,15 +3795,15 @@ func (m *EventDecollaterize) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDecollaterize: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventWithdrawReserves: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDecollaterize: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventWithdrawReserves: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1729,11 +3831,11 @@ func (m *EventDecollaterize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Borrower = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Utoken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1760,7 +3862,40 @@ func (m *EventDecollaterize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Utoken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reserves.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1785,7 +3920,7 @@ func (m *EventDecollaterize) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBorrow) Unmarshal(dAtA []byte) error {
+func (m *EventSetOracleSymbol) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1808,15 +3943,15 @@ func (m *EventBorrow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBorrow: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetOracleSymbol: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBorrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetOracleSymbol: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1844,13 +3979,13 @@ func (m *EventBorrow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Borrower = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldSymbolDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1860,24 +3995,55 @@ func (m *EventBorrow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.OldSymbolDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSymbolDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.NewSymbolDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1900,7 +4066,7 @@ func (m *EventBorrow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRepay) Unmarshal(dAtA []byte) error {
+func (m *EventBecameLiquidatable) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1923,15 +4089,15 @@ func (m *EventRepay) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRepay: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBecameLiquidatable: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRepay: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBecameLiquidatable: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1959,13 +4125,13 @@ func (m *EventRepay) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Borrower = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repaid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shortfall", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1975,22 +4141,23 @@ func (m *EventRepay) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Repaid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shortfall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2015,7 +4182,7 @@ func (m *EventRepay) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventLiquidate) Unmarshal(dAtA []byte) error {
+func (m *EventCircuitBreakerTriggered) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2038,15 +4205,15 @@ func (m *EventLiquidate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventLiquidate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCircuitBreakerTriggered: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventLiquidate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCircuitBreakerTriggered: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2074,11 +4241,11 @@ func (m *EventLiquidate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Liquidator = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2106,13 +4273,15 @@ func (m *EventLiquidate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Borrower = string(dAtA[iNdEx:postIndex])
+			if err := m.SpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2122,25 +4291,45 @@ func (m *EventLiquidate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Liquidated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HistoricPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2162,7 +4351,7 @@ func (m *EventLiquidate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventInterestAccrual) Unmarshal(dAtA []byte) error {
+func (m *EventSetStopLoss) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2185,17 +4374,17 @@ func (m *EventInterestAccrual) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventInterestAccrual: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetStopLoss: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventInterestAccrual: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetStopLoss: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2205,35 +4394,29 @@ func (m *EventInterestAccrual) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.Timestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timestamp |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalInterest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2243,31 +4426,29 @@ func (m *EventInterestAccrual) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalInterest = append(m.TotalInterest, types.Coin{})
-			if err := m.TotalInterest[len(m.TotalInterest)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Executor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reserved", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetHealthFactor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2277,23 +4458,23 @@ func (m *EventInterestAccrual) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reserved = append(m.Reserved, types.Coin{})
-			if err := m.Reserved[len(m.Reserved)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TargetHealthFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2318,7 +4499,7 @@ func (m *EventInterestAccrual) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRepayBadDebt) Unmarshal(dAtA []byte) error {
+func (m *EventExecuteStopLoss) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2341,10 +4522,10 @@ func (m *EventRepayBadDebt) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRepayBadDebt: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventExecuteStopLoss: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRepayBadDebt: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventExecuteStopLoss: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2381,9 +4562,9 @@ func (m *EventRepayBadDebt) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2393,24 +4574,23 @@ func (m *EventRepayBadDebt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Executor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2433,7 +4613,7 @@ func (m *EventRepayBadDebt) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventReservesExhausted) Unmarshal(dAtA []byte) error {
+func (m *EventFreezeAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2456,15 +4636,15 @@ func (m *EventReservesExhausted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventReservesExhausted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventFreezeAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventReservesExhausted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventFreezeAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2492,79 +4672,63 @@ func (m *EventReservesExhausted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Borrower = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingDebt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OutstandingDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleBalance", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUnfreezeAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ModuleBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUnfreezeAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUnfreezeAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reserves", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2574,24 +4738,23 @@ func (m *EventReservesExhausted) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Reserves.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2614,7 +4777,7 @@ func (m *EventReservesExhausted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventFundOracle) Unmarshal(dAtA []byte) error {
+func (m *EventSetAccountBorrowCap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2637,17 +4800,17 @@ func (m *EventFundOracle) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventFundOracle: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetAccountBorrowCap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventFundOracle: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetAccountBorrowCap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2657,23 +4820,55 @@ func (m *EventFundOracle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Assets = append(m.Assets, types.Coin{})
-			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BorrowCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
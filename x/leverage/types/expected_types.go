@@ -1,8 +1,11 @@
 package types
 
 import (
+	"context"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // AccountKeeper defines the expected account keeper used for leverage simulations (noalias)
@@ -27,6 +30,9 @@ type BankKeeper interface {
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	DenomOwners(
+		goCtx context.Context, req *banktypes.QueryDenomOwnersRequest,
+	) (*banktypes.QueryDenomOwnersResponse, error)
 }
 
 // OracleKeeper defines the expected x/oracle keeper interface.
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,6 +19,9 @@ func NewGenesisState(
 	badDebts []BadDebt,
 	interestScalars []InterestScalar,
 	uTokenSupply sdk.Coins,
+	frozenAccounts []string,
+	stopLosses []StopLoss,
+	borrowCaps []AccountBorrowCap,
 ) *GenesisState {
 	return &GenesisState{
 		Params:           params,
@@ -29,6 +33,9 @@ func NewGenesisState(
 		BadDebts:         badDebts,
 		InterestScalars:  interestScalars,
 		UtokenSupply:     uTokenSupply,
+		FrozenAccounts:   frozenAccounts,
+		StopLosses:       stopLosses,
+		BorrowCaps:       borrowCaps,
 	}
 }
 
@@ -48,6 +55,9 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if err := ValidateUTokenDenoms(nil, gs.Registry); err != nil {
+		return err
+	}
 	for _, token := range gs.Registry {
 		if err := token.Validate(); err != nil {
 			return err
@@ -98,6 +108,27 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for _, addr := range gs.FrozenAccounts {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return err
+		}
+	}
+
+	for _, stopLoss := range gs.StopLosses {
+		if err := stopLoss.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, borrowCap := range gs.BorrowCaps {
+		if _, err := sdk.AccAddressFromBech32(borrowCap.Address); err != nil {
+			return err
+		}
+		if borrowCap.BorrowCap.IsNil() || !borrowCap.BorrowCap.IsPositive() {
+			return fmt.Errorf("borrow cap must be positive: %s", borrowCap.BorrowCap)
+		}
+	}
+
 	return gs.UtokenSupply.Validate()
 }
 
@@ -36,6 +36,9 @@ type GenesisState struct {
 	BadDebts         []BadDebt                                `protobuf:"bytes,7,rep,name=bad_debts,json=badDebts,proto3" json:"bad_debts"`
 	InterestScalars  []InterestScalar                         `protobuf:"bytes,8,rep,name=interest_scalars,json=interestScalars,proto3" json:"interest_scalars"`
 	UtokenSupply     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=utoken_supply,json=utokenSupply,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"utoken_supply"`
+	FrozenAccounts   []string                                 `protobuf:"bytes,10,rep,name=frozen_accounts,json=frozenAccounts,proto3" json:"frozen_accounts,omitempty"`
+	StopLosses       []StopLoss                               `protobuf:"bytes,11,rep,name=stop_losses,json=stopLosses,proto3" json:"stop_losses"`
+	BorrowCaps       []AccountBorrowCap                       `protobuf:"bytes,12,rep,name=borrow_caps,json=borrowCaps,proto3" json:"borrow_caps"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -230,56 +233,105 @@ func (m *InterestScalar) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_InterestScalar proto.InternalMessageInfo
 
+// AccountBorrowCap is a maximum borrowed value set by governance for an account, used in the leverage
+// module's genesis state.
+type AccountBorrowCap struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Borrow cap is the maximum USD value the account may have borrowed, using the higher of spot or
+	// historic prices as in borrow limit checks.
+	BorrowCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=borrow_cap,json=borrowCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"borrow_cap"`
+}
+
+func (m *AccountBorrowCap) Reset()         { *m = AccountBorrowCap{} }
+func (m *AccountBorrowCap) String() string { return proto.CompactTextString(m) }
+func (*AccountBorrowCap) ProtoMessage()    {}
+func (*AccountBorrowCap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a51f71666aa8f549, []int{5}
+}
+func (m *AccountBorrowCap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountBorrowCap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountBorrowCap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountBorrowCap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountBorrowCap.Merge(m, src)
+}
+func (m *AccountBorrowCap) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountBorrowCap) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountBorrowCap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountBorrowCap proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "umee.leverage.v1.GenesisState")
 	proto.RegisterType((*AdjustedBorrow)(nil), "umee.leverage.v1.AdjustedBorrow")
 	proto.RegisterType((*Collateral)(nil), "umee.leverage.v1.Collateral")
 	proto.RegisterType((*BadDebt)(nil), "umee.leverage.v1.BadDebt")
 	proto.RegisterType((*InterestScalar)(nil), "umee.leverage.v1.InterestScalar")
+	proto.RegisterType((*AccountBorrowCap)(nil), "umee.leverage.v1.AccountBorrowCap")
 }
 
 func init() { proto.RegisterFile("umee/leverage/v1/genesis.proto", fileDescriptor_a51f71666aa8f549) }
 
 var fileDescriptor_a51f71666aa8f549 = []byte{
-	// 601 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0x8e, 0xfb, 0x27, 0x6d, 0xb6, 0xfd, 0xf5, 0x57, 0xad, 0x2a, 0xb1, 0x54, 0x95, 0x13, 0xe5,
-	0x80, 0x72, 0xa0, 0x76, 0x5b, 0x04, 0xa8, 0x88, 0x0b, 0x6e, 0x05, 0xe2, 0x82, 0x20, 0xed, 0x89,
-	0x4b, 0xb4, 0xb6, 0x07, 0x63, 0x6a, 0x7b, 0xad, 0x9d, 0x75, 0x4a, 0xdf, 0x82, 0xe7, 0xe0, 0xc8,
-	0x53, 0xf4, 0xd8, 0x23, 0xe2, 0x50, 0xa0, 0x7d, 0x11, 0xe4, 0xdd, 0x4d, 0xda, 0x34, 0x50, 0x71,
-	0xe0, 0x64, 0xcf, 0xce, 0xf7, 0x7d, 0x33, 0x3b, 0xdf, 0x68, 0x89, 0x5b, 0xe5, 0x00, 0x7e, 0x06,
-	0x43, 0x90, 0x3c, 0x01, 0x7f, 0xb8, 0xed, 0x27, 0x50, 0x00, 0xa6, 0xe8, 0x95, 0x52, 0x28, 0x41,
-	0x57, 0xeb, 0xbc, 0x37, 0xca, 0x7b, 0xc3, 0xed, 0x75, 0x37, 0x12, 0x98, 0x0b, 0xf4, 0x43, 0x8e,
-	0x35, 0x3e, 0x04, 0xc5, 0xb7, 0xfd, 0x48, 0xa4, 0x85, 0x61, 0xac, 0xb7, 0xa7, 0x14, 0xc7, 0x6c,
-	0x03, 0x58, 0x4b, 0x44, 0x22, 0xf4, 0xaf, 0x5f, 0xff, 0x99, 0xd3, 0xee, 0x97, 0x79, 0xb2, 0xfc,
-	0xc2, 0x94, 0x3e, 0x50, 0x5c, 0x01, 0x7d, 0x44, 0x9a, 0x25, 0x97, 0x3c, 0x47, 0xe6, 0x74, 0x9c,
-	0xde, 0xd2, 0x0e, 0xf3, 0x6e, 0xb6, 0xe2, 0xbd, 0xd6, 0xf9, 0x60, 0xee, 0xf4, 0xbc, 0xdd, 0xe8,
-	0x5b, 0x34, 0xdd, 0x25, 0x8b, 0x12, 0x92, 0x14, 0x95, 0x3c, 0x61, 0x33, 0x9d, 0xd9, 0xde, 0xd2,
-	0xce, 0x9d, 0x69, 0xe6, 0xa1, 0x38, 0x82, 0xc2, 0x12, 0xc7, 0x70, 0xfa, 0x86, 0xac, 0xf2, 0xf8,
-	0x43, 0x85, 0x0a, 0xe2, 0x41, 0x28, 0xa4, 0x14, 0xc7, 0xc8, 0x66, 0xb5, 0x44, 0x67, 0x5a, 0xe2,
-	0x99, 0x45, 0x06, 0x1a, 0x68, 0xb5, 0xfe, 0xe7, 0x13, 0xa7, 0x48, 0x03, 0x42, 0x22, 0x91, 0x65,
-	0x5c, 0x81, 0xe4, 0x19, 0x9b, 0xd3, 0x62, 0x1b, 0xd3, 0x62, 0x7b, 0x63, 0x8c, 0x15, 0xba, 0xc6,
-	0xa2, 0x49, 0x7d, 0x23, 0x04, 0x39, 0x04, 0x64, 0xf3, 0x5a, 0xe1, 0xae, 0x67, 0x4c, 0xf0, 0x6a,
-	0x13, 0x3c, 0x6b, 0x82, 0xb7, 0x27, 0xd2, 0x22, 0xd8, 0xaa, 0xe9, 0x9f, 0xbf, 0xb7, 0x7b, 0x49,
-	0xaa, 0xde, 0x57, 0xa1, 0x17, 0x89, 0xdc, 0xb7, 0x8e, 0x99, 0xcf, 0x26, 0xc6, 0x47, 0xbe, 0x3a,
-	0x29, 0x01, 0x35, 0x01, 0xfb, 0x63, 0x71, 0x7a, 0x9f, 0xd0, 0x8c, 0xa3, 0x1a, 0xa4, 0x85, 0x02,
-	0x09, 0xa8, 0x06, 0x2a, 0xcd, 0x81, 0x35, 0x3b, 0x4e, 0x6f, 0xb6, 0xbf, 0x5a, 0x67, 0x5e, 0xda,
-	0xc4, 0x61, 0x9a, 0x03, 0x7d, 0x4a, 0x5a, 0x21, 0x8f, 0x07, 0x31, 0x84, 0x0a, 0xd9, 0x82, 0xed,
-	0x6b, 0xea, 0x66, 0x01, 0x8f, 0xf7, 0x21, 0x54, 0xa3, 0x59, 0x87, 0x26, 0xc4, 0x7a, 0xd6, 0xe3,
-	0x32, 0x18, 0xf1, 0x8c, 0x4b, 0x64, 0x8b, 0x7f, 0x9a, 0xf5, 0xa8, 0xee, 0x81, 0x06, 0x8e, 0x66,
-	0x9d, 0x4e, 0x9c, 0x22, 0x2d, 0xc9, 0x7f, 0x95, 0xaa, 0x8d, 0x1d, 0x60, 0x55, 0x96, 0xd9, 0x09,
-	0x6b, 0xfd, 0xfb, 0x61, 0x2d, 0x9b, 0x0a, 0x07, 0xba, 0x40, 0xf7, 0x1d, 0x59, 0x99, 0x5c, 0x03,
-	0xca, 0xc8, 0x02, 0x8f, 0x63, 0x09, 0x68, 0xd6, 0xb6, 0xd5, 0x1f, 0x85, 0xf4, 0x09, 0x69, 0xf2,
-	0x5c, 0x54, 0x85, 0x62, 0x33, 0x7a, 0x9f, 0x37, 0x7e, 0xdb, 0xd6, 0x3e, 0x44, 0xba, 0x33, 0xbb,
-	0xd3, 0x86, 0xd1, 0x1d, 0x10, 0x72, 0xb5, 0x21, 0xb7, 0xd4, 0x78, 0x7c, 0xa3, 0xc6, 0x2d, 0x57,
-	0x9f, 0x2c, 0xb0, 0x4b, 0x16, 0xac, 0x51, 0xb7, 0xa8, 0xaf, 0x91, 0xf9, 0x18, 0x0a, 0x91, 0x6b,
-	0xf1, 0x56, 0xdf, 0x04, 0xdd, 0x82, 0xac, 0x4c, 0xda, 0x73, 0x85, 0x73, 0xae, 0xe1, 0xe8, 0x73,
-	0xd2, 0x34, 0x3e, 0x1b, 0x7a, 0xe0, 0xd5, 0x0d, 0x7c, 0x3b, 0x6f, 0xdf, 0xfb, 0x8b, 0xd9, 0xef,
-	0x43, 0xd4, 0xb7, 0xec, 0xe0, 0xd5, 0xe9, 0x4f, 0xb7, 0x71, 0x7a, 0xe1, 0x3a, 0x67, 0x17, 0xae,
-	0xf3, 0xe3, 0xc2, 0x75, 0x3e, 0x5d, 0xba, 0x8d, 0xb3, 0x4b, 0xb7, 0xf1, 0xf5, 0xd2, 0x6d, 0xbc,
-	0xdd, 0xba, 0xa6, 0x56, 0xaf, 0xd1, 0x66, 0x01, 0xea, 0x58, 0xc8, 0x23, 0x1d, 0xf8, 0xc3, 0x87,
-	0xfe, 0xc7, 0xab, 0xa7, 0x49, 0x6b, 0x87, 0x4d, 0xfd, 0xfe, 0x3c, 0xf8, 0x15, 0x00, 0x00, 0xff,
-	0xff, 0xcd, 0x8e, 0xd7, 0xd1, 0x0a, 0x05, 0x00, 0x00,
+	// 697 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x4f, 0x13, 0x41,
+	0x14, 0x6f, 0x29, 0x14, 0x3a, 0x45, 0x68, 0x26, 0x24, 0x8e, 0x84, 0x6c, 0x9b, 0x1e, 0xb4, 0x07,
+	0xd9, 0x05, 0x8c, 0x1a, 0x8c, 0x17, 0x0a, 0xd1, 0x90, 0xa8, 0xd1, 0x96, 0x93, 0x97, 0xcd, 0xec,
+	0xee, 0xa3, 0xae, 0xec, 0xee, 0x6c, 0xf6, 0x4d, 0x8b, 0xe8, 0x97, 0xf0, 0x73, 0xf8, 0x49, 0x38,
+	0x72, 0x34, 0x1e, 0x50, 0xe1, 0xe4, 0xb7, 0x30, 0x3b, 0xb3, 0xdb, 0x52, 0x8a, 0x8d, 0x31, 0x9e,
+	0xda, 0x79, 0xf3, 0xfb, 0x33, 0xf3, 0xde, 0x6f, 0x96, 0x18, 0xfd, 0x10, 0xc0, 0x0a, 0x60, 0x00,
+	0x09, 0xef, 0x81, 0x35, 0xd8, 0xb4, 0x7a, 0x10, 0x01, 0xfa, 0x68, 0xc6, 0x89, 0x90, 0x82, 0xd6,
+	0xd2, 0x7d, 0x33, 0xdf, 0x37, 0x07, 0x9b, 0xab, 0x86, 0x2b, 0x30, 0x14, 0x68, 0x39, 0x1c, 0x53,
+	0xbc, 0x03, 0x92, 0x6f, 0x5a, 0xae, 0xf0, 0x23, 0xcd, 0x58, 0xad, 0x4f, 0x28, 0x0e, 0xd9, 0x1a,
+	0xb0, 0xd2, 0x13, 0x3d, 0xa1, 0xfe, 0x5a, 0xe9, 0x3f, 0x5d, 0x6d, 0xfe, 0x2a, 0x93, 0xc5, 0xe7,
+	0xda, 0xba, 0x2b, 0xb9, 0x04, 0xfa, 0x88, 0x94, 0x63, 0x9e, 0xf0, 0x10, 0x59, 0xb1, 0x51, 0x6c,
+	0x55, 0xb7, 0x98, 0x79, 0xfd, 0x28, 0xe6, 0x6b, 0xb5, 0xdf, 0x9e, 0x3d, 0x3d, 0xaf, 0x17, 0x3a,
+	0x19, 0x9a, 0x6e, 0x93, 0x85, 0x04, 0x7a, 0x3e, 0xca, 0xe4, 0x84, 0xcd, 0x34, 0x4a, 0xad, 0xea,
+	0xd6, 0xed, 0x49, 0xe6, 0x81, 0x38, 0x82, 0x28, 0x23, 0x0e, 0xe1, 0xf4, 0x0d, 0xa9, 0x71, 0xef,
+	0x7d, 0x1f, 0x25, 0x78, 0xb6, 0x23, 0x92, 0x44, 0x1c, 0x23, 0x2b, 0x29, 0x89, 0xc6, 0xa4, 0xc4,
+	0x4e, 0x86, 0x6c, 0x2b, 0x60, 0xa6, 0xb5, 0xcc, 0xc7, 0xaa, 0x48, 0xdb, 0x84, 0xb8, 0x22, 0x08,
+	0xb8, 0x84, 0x84, 0x07, 0x6c, 0x56, 0x89, 0xad, 0x4d, 0x8a, 0xed, 0x0e, 0x31, 0x99, 0xd0, 0x15,
+	0x16, 0xed, 0xa5, 0x37, 0x42, 0x48, 0x06, 0x80, 0x6c, 0x4e, 0x29, 0xdc, 0x31, 0xf5, 0x10, 0xcc,
+	0x74, 0x08, 0x66, 0x36, 0x04, 0x73, 0x57, 0xf8, 0x51, 0x7b, 0x23, 0xa5, 0x7f, 0xf9, 0x5e, 0x6f,
+	0xf5, 0x7c, 0xf9, 0xae, 0xef, 0x98, 0xae, 0x08, 0xad, 0x6c, 0x62, 0xfa, 0x67, 0x1d, 0xbd, 0x23,
+	0x4b, 0x9e, 0xc4, 0x80, 0x8a, 0x80, 0x9d, 0xa1, 0x38, 0xbd, 0x4f, 0x68, 0xc0, 0x51, 0xda, 0x7e,
+	0x24, 0x21, 0x01, 0x94, 0xb6, 0xf4, 0x43, 0x60, 0xe5, 0x46, 0xb1, 0x55, 0xea, 0xd4, 0xd2, 0x9d,
+	0xfd, 0x6c, 0xe3, 0xc0, 0x0f, 0x81, 0x3e, 0x25, 0x15, 0x87, 0x7b, 0xb6, 0x07, 0x8e, 0x44, 0x36,
+	0x9f, 0x9d, 0x6b, 0xe2, 0x66, 0x6d, 0xee, 0xed, 0x81, 0x23, 0xf3, 0x5e, 0x3b, 0x7a, 0x89, 0x69,
+	0xaf, 0x87, 0x36, 0xe8, 0xf2, 0x80, 0x27, 0xc8, 0x16, 0xfe, 0xd4, 0xeb, 0xdc, 0xb7, 0xab, 0x80,
+	0x79, 0xaf, 0xfd, 0xb1, 0x2a, 0xd2, 0x98, 0xdc, 0xea, 0xcb, 0x74, 0xb0, 0x36, 0xf6, 0xe3, 0x38,
+	0x38, 0x61, 0x95, 0xff, 0xdf, 0xac, 0x45, 0xed, 0xd0, 0x55, 0x06, 0xf4, 0x1e, 0x59, 0x3e, 0x4c,
+	0xc4, 0x47, 0x88, 0x6c, 0xee, 0xba, 0xa2, 0x1f, 0x49, 0x64, 0xa4, 0x51, 0x6a, 0x55, 0x3a, 0x4b,
+	0xba, 0xbc, 0x93, 0x55, 0xe9, 0x0e, 0xa9, 0xa2, 0x14, 0xb1, 0x1d, 0x08, 0x44, 0x40, 0x56, 0x55,
+	0x07, 0x5b, 0x9d, 0xbc, 0x68, 0x57, 0x8a, 0xf8, 0x85, 0xc0, 0x3c, 0xd3, 0x04, 0xb3, 0x35, 0x20,
+	0xdd, 0x27, 0x55, 0x9d, 0x49, 0xdb, 0xe5, 0x31, 0xb2, 0x45, 0x25, 0xd1, 0xbc, 0x21, 0x97, 0xda,
+	0x53, 0x07, 0x70, 0x97, 0xc7, 0xb9, 0x94, 0x93, 0x17, 0xb0, 0x79, 0x48, 0x96, 0xc6, 0xd3, 0x4b,
+	0x19, 0x99, 0xe7, 0x9e, 0x97, 0x00, 0xea, 0xd7, 0x56, 0xe9, 0xe4, 0x4b, 0xfa, 0x84, 0x94, 0x79,
+	0x98, 0x0a, 0xb2, 0x19, 0xf5, 0x0c, 0xd7, 0x6e, 0xec, 0xe6, 0x1e, 0xb8, 0xaa, 0xa1, 0xd9, 0x53,
+	0xd4, 0x8c, 0xa6, 0x4d, 0xc8, 0x28, 0xd8, 0x53, 0x3c, 0x1e, 0x5f, 0xf3, 0x98, 0x32, 0xb1, 0x71,
+	0x83, 0x6d, 0x32, 0x9f, 0xe5, 0x6b, 0x8a, 0xfa, 0x0a, 0x99, 0xf3, 0x20, 0x12, 0xa1, 0x12, 0xaf,
+	0x74, 0xf4, 0xa2, 0x19, 0x91, 0xa5, 0xf1, 0x54, 0x8d, 0x70, 0xc5, 0x2b, 0x38, 0xfa, 0x8c, 0x94,
+	0x75, 0x3c, 0x35, 0xbd, 0x6d, 0xa6, 0x07, 0xf8, 0x76, 0x5e, 0xbf, 0xfb, 0x17, 0x91, 0xd9, 0x03,
+	0xb7, 0x93, 0xb1, 0x9b, 0x9f, 0x48, 0xed, 0xfa, 0x64, 0xa6, 0x9c, 0xf9, 0x25, 0x21, 0xa3, 0x61,
+	0xff, 0xa3, 0x73, 0x65, 0x38, 0xf1, 0xf6, 0xab, 0xd3, 0x9f, 0x46, 0xe1, 0xf4, 0xc2, 0x28, 0x9e,
+	0x5d, 0x18, 0xc5, 0x1f, 0x17, 0x46, 0xf1, 0xf3, 0xa5, 0x51, 0x38, 0xbb, 0x34, 0x0a, 0x5f, 0x2f,
+	0x8d, 0xc2, 0xdb, 0x8d, 0x2b, 0x82, 0x69, 0x9c, 0xd6, 0x23, 0x90, 0xc7, 0x22, 0x39, 0x52, 0x0b,
+	0x6b, 0xf0, 0xd0, 0xfa, 0x30, 0xfa, 0x9c, 0x2b, 0x79, 0xa7, 0xac, 0xbe, 0xd9, 0x0f, 0x7e, 0x0f,
+	0x00, 0x0b, 0x7e, 0x87, 0x25, 0x3e, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -302,6 +354,43 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BorrowCaps) > 0 {
+		for iNdEx := len(m.BorrowCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BorrowCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.StopLosses) > 0 {
+		for iNdEx := len(m.StopLosses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StopLosses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.FrozenAccounts) > 0 {
+		for iNdEx := len(m.FrozenAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrozenAccounts[iNdEx])
+			copy(dAtA[i:], m.FrozenAccounts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.FrozenAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.UtokenSupply) > 0 {
 		for iNdEx := len(m.UtokenSupply) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -575,6 +664,46 @@ func (m *InterestScalar) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AccountBorrowCap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountBorrowCap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountBorrowCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BorrowCap.Size()
+		i -= size
+		if _, err := m.BorrowCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -639,6 +768,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.FrozenAccounts) > 0 {
+		for _, s := range m.FrozenAccounts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StopLosses) > 0 {
+		for _, e := range m.StopLosses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BorrowCaps) > 0 {
+		for _, e := range m.BorrowCaps {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -704,6 +851,21 @@ func (m *InterestScalar) Size() (n int) {
 	return n
 }
 
+func (m *AccountBorrowCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.BorrowCap.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1029,6 +1191,106 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAccounts = append(m.FrozenAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopLosses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StopLosses = append(m.StopLosses, StopLoss{})
+			if err := m.StopLosses[len(m.StopLosses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BorrowCaps = append(m.BorrowCaps, AccountBorrowCap{})
+			if err := m.BorrowCaps[len(m.BorrowCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1510,6 +1772,122 @@ func (m *InterestScalar) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AccountBorrowCap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountBorrowCap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountBorrowCap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BorrowCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
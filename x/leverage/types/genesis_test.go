@@ -24,7 +24,7 @@ func TestGenesisValidation(t *testing.T) {
 			*NewGenesisState(
 				Params{
 					CompleteLiquidationThreshold: sdk.MustNewDecFromStr("-0.4"),
-				}, nil, nil, nil, nil, 0, nil, nil, nil,
+				}, nil, nil, nil, nil, 0, nil, nil, nil, nil, nil, nil,
 			),
 			true,
 			"complete liquidation threshold must be positive",
@@ -39,6 +39,17 @@ func TestGenesisValidation(t *testing.T) {
 			true,
 			"invalid denom",
 		},
+		{
+			"colliding token registry", GenesisState{
+				Params: DefaultParams(),
+				Registry: []Token{
+					{BaseDenom: validDenom},
+					{BaseDenom: ToUTokenDenom(validDenom)},
+				},
+			},
+			true,
+			"base denom u/umee equals the uToken denom of umee",
+		},
 		{
 			"invalid adjusted borrows address", GenesisState{
 				Params: DefaultParams(),
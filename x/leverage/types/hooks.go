@@ -27,4 +27,17 @@ type BondHooks interface {
 	// Used when liquidating an account, and collateral must be unbonded instantly until bonded amount
 	// is no greater than the account's remaining collateral uTokens.
 	ForceUnbondTo(ctx sdk.Context, addr sdk.AccAddress, uToken sdk.Coin) error
+
+	// Used to display the rewards an account has pending on its bonded collateral of a given uToken denom.
+	// Must not modify state.
+	PendingRewards(ctx sdk.Context, addr sdk.AccAddress, uDenom string) sdk.Coins
+
+	// Used to display the annual rate of rewards earned by bonded collateral of a given uToken denom,
+	// as a fraction of the collateral's value. Must not modify state.
+	IncentiveAPY(ctx sdk.Context, uDenom string) sdk.Dec
+
+	// Used to display, for each ongoing incentive program, the amount an account has bonded to it
+	// and the estimated annual rewards that bond earns. Collateral and Eligible fields are filled
+	// in by the leverage module. Must not modify state.
+	IncentiveEligibility(ctx sdk.Context, addr sdk.AccAddress) []IncentiveEligibility
 }
@@ -12,6 +12,13 @@ const (
 
 	// StoreKey defines the primary module store key
 	StoreKey = ModuleName
+
+	// TStoreKey defines the transient store key, whose contents are discarded at the end of each block
+	TStoreKey = "transient_" + ModuleName
+
+	// ConsensusVersion is the module's current consensus version, which is incremented
+	// by each store migration
+	ConsensusVersion = 2
 )
 
 // KVStore key prefixes
@@ -25,6 +32,28 @@ var (
 	KeyPrefixInterestScalar      = []byte{0x08}
 	KeyPrefixAdjustedTotalBorrow = []byte{0x09}
 	KeyPrefixUtokenSupply        = []byte{0x0A}
+	KeyRegistryUpdateHeight      = []byte{0x0B}
+	KeyLastInterestHeight        = []byte{0x0C}
+	KeyPrefixCollateralChange    = []byte{0x0D}
+	KeyPrefixBlockWithdrawals    = []byte{0x0E}
+	KeyPrefixBorrowAPYSample     = []byte{0x0F}
+	KeyPrefixFrozenAccount       = []byte{0x10}
+	KeyPrefixLiquidationWatch    = []byte{0x11}
+	KeyPrefixExchangeRateCache   = []byte{0x12}
+	KeyBorrowerCount             = []byte{0x13}
+	KeyCollateralAccountCount    = []byte{0x14}
+	KeyPrefixLifetimeReserves    = []byte{0x15}
+	KeyLifetimeReservesHeight    = []byte{0x16}
+	KeyPrefixSmoothedUtilization = []byte{0x17}
+	KeyPrefixCircuitBreaker      = []byte{0x18}
+	KeyPrefixLiquidationRewards  = []byte{0x19}
+	KeyLiquidationRewardsHeight  = []byte{0x1A}
+	KeyPrefixStopLoss            = []byte{0x1B}
+	KeyPrefixUnderwaterHeight    = []byte{0x1C}
+	KeyPrefixBadDebtHistory      = []byte{0x1D}
+	KeyBadDebtHistorySequence    = []byte{0x1E}
+	KeyPrefixAccountBorrowCap    = []byte{0x1F}
+	KeyLiquidationWatchCursor    = []byte{0x20}
 )
 
 // KeyRegisteredToken returns a KVStore key for getting and setting a Token.
@@ -61,12 +90,113 @@ func KeyCollateralAmountNoDenom(addr sdk.AccAddress) []byte {
 	return util.ConcatBytes(0, KeyPrefixCollateralAmount, address.MustLengthPrefix(addr))
 }
 
+// KeyCollateralChange returns a KVStore key for getting and setting the block height
+// at which an address last changed its collateral.
+func KeyCollateralChange(addr sdk.AccAddress) []byte {
+	// collateralChangePrefix | lengthprefixed(addr)
+	return util.ConcatBytes(0, KeyPrefixCollateralChange, address.MustLengthPrefix(addr))
+}
+
+// KeyFrozenAccount returns a KVStore key for tracking an address frozen by governance.
+func KeyFrozenAccount(addr sdk.AccAddress) []byte {
+	// frozenAccountPrefix | lengthprefixed(addr)
+	return util.ConcatBytes(0, KeyPrefixFrozenAccount, address.MustLengthPrefix(addr))
+}
+
+// KeyLiquidationWatch returns a KVStore key for tracking an address on the liquidation watchlist.
+func KeyLiquidationWatch(addr sdk.AccAddress) []byte {
+	// liquidationWatchPrefix | lengthprefixed(addr)
+	return util.ConcatBytes(0, KeyPrefixLiquidationWatch, address.MustLengthPrefix(addr))
+}
+
+// KeyUnderwaterHeight returns a KVStore key for getting and setting the height at which an address was
+// first found eligible for liquidation by the liquidation watchlist.
+func KeyUnderwaterHeight(addr sdk.AccAddress) []byte {
+	// underwaterHeightPrefix | lengthprefixed(addr)
+	return util.ConcatBytes(0, KeyPrefixUnderwaterHeight, address.MustLengthPrefix(addr))
+}
+
+// KeyStopLoss returns a KVStore key for getting and setting the stop-loss recorded by an address.
+func KeyStopLoss(addr sdk.AccAddress) []byte {
+	// stopLossPrefix | lengthprefixed(addr)
+	return util.ConcatBytes(0, KeyPrefixStopLoss, address.MustLengthPrefix(addr))
+}
+
+// KeyAccountBorrowCap returns a KVStore key for getting and setting the borrow cap set by governance
+// for an address.
+func KeyAccountBorrowCap(addr sdk.AccAddress) []byte {
+	// accountBorrowCapPrefix | lengthprefixed(addr)
+	return util.ConcatBytes(0, KeyPrefixAccountBorrowCap, address.MustLengthPrefix(addr))
+}
+
+// KeyBadDebtHistory returns a KVStore key for getting and setting a bad debt record by its sequence number.
+func KeyBadDebtHistory(sequence uint64) []byte {
+	// badDebtHistoryPrefix | bigEndian(sequence)
+	return util.ConcatBytes(0, KeyPrefixBadDebtHistory, sdk.Uint64ToBigEndian(sequence))
+}
+
+// KeyBlockWithdrawals returns a transient store key for getting and setting the amount of a base token
+// withdrawn during the current block.
+func KeyBlockWithdrawals(tokenDenom string) []byte {
+	// blockwithdrawalsprefix | denom | 0x00 for null-termination
+	return util.ConcatBytes(1, KeyPrefixBlockWithdrawals, []byte(tokenDenom))
+}
+
+// KeyExchangeRateCache returns a transient store key for getting and setting a base token's uToken
+// exchange rate cached during the current block.
+func KeyExchangeRateCache(tokenDenom string) []byte {
+	// exchangeratecacheprefix | denom | 0x00 for null-termination
+	return util.ConcatBytes(1, KeyPrefixExchangeRateCache, []byte(tokenDenom))
+}
+
+// KeyBorrowAPYSample returns a KVStore key for getting and setting a token's borrow APY sample
+// in a given ring buffer slot.
+func KeyBorrowAPYSample(tokenDenom string, slot uint64) []byte {
+	// borrowapysampleprefix | denom | 0x00 | slot
+	return util.ConcatBytes(0, KeyBorrowAPYSampleNoSlot(tokenDenom), sdk.Uint64ToBigEndian(slot))
+}
+
+// KeyBorrowAPYSampleNoSlot returns the common prefix used by all borrow APY samples
+// associated with a given token denom.
+func KeyBorrowAPYSampleNoSlot(tokenDenom string) []byte {
+	// borrowapysampleprefix | denom | 0x00 for null-termination
+	return util.ConcatBytes(1, KeyPrefixBorrowAPYSample, []byte(tokenDenom))
+}
+
 // KeyReserveAmount returns a KVStore key for getting and setting the amount reserved of a a given token.
 func KeyReserveAmount(tokenDenom string) []byte {
 	// reserveamountprefix | denom | 0x00 for null-termination
 	return util.ConcatBytes(1, KeyPrefixReserveAmount, []byte(tokenDenom))
 }
 
+// KeyLifetimeReserves returns a KVStore key for getting and setting the cumulative amount of
+// reserves generated by a given token.
+func KeyLifetimeReserves(tokenDenom string) []byte {
+	// lifetimereservesprefix | denom | 0x00 for null-termination
+	return util.ConcatBytes(1, KeyPrefixLifetimeReserves, []byte(tokenDenom))
+}
+
+// KeySmoothedUtilization returns a KVStore key for getting and setting the smoothed supply utilization
+// of a given token.
+func KeySmoothedUtilization(tokenDenom string) []byte {
+	// smoothedutilizationprefix | denom | 0x00 for null-termination
+	return util.ConcatBytes(1, KeyPrefixSmoothedUtilization, []byte(tokenDenom))
+}
+
+// KeyLiquidationRewardsPaid returns a KVStore key for getting and setting the cumulative amount of
+// liquidation rewards paid to liquidators in a given token.
+func KeyLiquidationRewardsPaid(tokenDenom string) []byte {
+	// liquidationrewardsprefix | denom | 0x00 for null-termination
+	return util.ConcatBytes(1, KeyPrefixLiquidationRewards, []byte(tokenDenom))
+}
+
+// KeyCircuitBreaker returns a KVStore key for getting and setting the height at which a token's
+// price circuit breaker expires.
+func KeyCircuitBreaker(tokenDenom string) []byte {
+	// circuitbreakerprefix | denom | 0x00 for null-termination
+	return util.ConcatBytes(1, KeyPrefixCircuitBreaker, []byte(tokenDenom))
+}
+
 // KeyBadDebt returns a KVStore key for tracking an address with unpaid bad debt
 func KeyBadDebt(denom string, borrower sdk.AccAddress) []byte {
 	// badDebtAddrPrefix | lengthprefixed(borrowerAddr) | denom | 0x00 for null-termination
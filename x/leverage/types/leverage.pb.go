@@ -24,6 +24,73 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ZeroPricePolicy defines how the leverage module treats assets whose oracle price is zero or missing.
+type ZeroPricePolicy int32
+
+const (
+	// EXCLUDE: assets missing prices are skipped (valued at zero) by functions which can safely do so,
+	// such as queries and borrow limit calculations which only reduce borrowing ability. Other
+	// calculations, including liquidation eligibility, fail.
+	ZeroPricePolicy_ZERO_PRICE_POLICY_EXCLUDE ZeroPricePolicy = 0
+	// ERROR: any calculation involving an asset missing its price fails.
+	ZeroPricePolicy_ZERO_PRICE_POLICY_ERROR ZeroPricePolicy = 1
+	// TREAT AS UNBORROWABLE: assets missing prices count as zero collateral in all calculations,
+	// including liquidation eligibility, and cannot be borrowed.
+	ZeroPricePolicy_ZERO_PRICE_POLICY_TREAT_AS_UNBORROWABLE ZeroPricePolicy = 2
+)
+
+var ZeroPricePolicy_name = map[int32]string{
+	0: "ZERO_PRICE_POLICY_EXCLUDE",
+	1: "ZERO_PRICE_POLICY_ERROR",
+	2: "ZERO_PRICE_POLICY_TREAT_AS_UNBORROWABLE",
+}
+
+var ZeroPricePolicy_value = map[string]int32{
+	"ZERO_PRICE_POLICY_EXCLUDE":               0,
+	"ZERO_PRICE_POLICY_ERROR":                 1,
+	"ZERO_PRICE_POLICY_TREAT_AS_UNBORROWABLE": 2,
+}
+
+func (x ZeroPricePolicy) String() string {
+	return proto.EnumName(ZeroPricePolicy_name, int32(x))
+}
+
+func (ZeroPricePolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8cb1bf9ea641ecc6, []int{0}
+}
+
+// CompoundingMode defines how interest compounds over the time elapsed between interest accruals.
+type CompoundingMode int32
+
+const (
+	// CONTINUOUS: borrow APY is treated as a continuously compounded rate, so each accrual
+	// multiplies interest scalars by e^(APY * t), and a year at a constant borrow APY increases
+	// borrows by e^APY - 1.
+	CompoundingMode_COMPOUNDING_MODE_CONTINUOUS CompoundingMode = 0
+	// ANNUAL: borrow APY is treated as an annually compounded rate, so each accrual multiplies
+	// interest scalars by (1 + APY)^t, and a year at a constant borrow APY increases borrows by
+	// exactly that APY.
+	CompoundingMode_COMPOUNDING_MODE_ANNUAL CompoundingMode = 1
+)
+
+var CompoundingMode_name = map[int32]string{
+	0: "COMPOUNDING_MODE_CONTINUOUS",
+	1: "COMPOUNDING_MODE_ANNUAL",
+}
+
+var CompoundingMode_value = map[string]int32{
+	"COMPOUNDING_MODE_CONTINUOUS": 0,
+	"COMPOUNDING_MODE_ANNUAL":     1,
+}
+
+func (x CompoundingMode) String() string {
+	return proto.EnumName(CompoundingMode_name, int32(x))
+}
+
+func (CompoundingMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8cb1bf9ea641ecc6, []int{1}
+}
+
 // Params defines the parameters for the leverage module.
 // See https://github.com/umee-network/umee/blob/main/docs/design_docs/010-market-params.md
 // for more details.
@@ -67,6 +134,59 @@ type Params struct {
 	// uTokens as liquidation rewards.
 	// Valid values: 0-1.
 	DirectLiquidationFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=direct_liquidation_fee,json=directLiquidationFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"direct_liquidation_fee" yaml:"direct_liquidation_fee"`
+	// Zero Price Policy determines how assets with zero or missing oracle prices
+	// are treated when computing account values, borrow limits and liquidation thresholds.
+	ZeroPricePolicy ZeroPricePolicy `protobuf:"varint,7,opt,name=zero_price_policy,json=zeroPricePolicy,proto3,enum=umee.leverage.v1.ZeroPricePolicy" json:"zero_price_policy,omitempty" yaml:"zero_price_policy"`
+	// Borrow Cooldown Blocks is the number of blocks after an account last collateralizes,
+	// decollateralizes, or withdraws collateral during which that account cannot borrow.
+	// Other collateral changes, such as liquidations, do not restart it. Zero disables the cooldown.
+	BorrowCooldownBlocks uint64 `protobuf:"varint,8,opt,name=borrow_cooldown_blocks,json=borrowCooldownBlocks,proto3" json:"borrow_cooldown_blocks,omitempty" yaml:"borrow_cooldown_blocks"`
+	// Max Withdraw Rate Per Block is the maximum fraction of a token's total supply which
+	// can be withdrawn in a single block, across all suppliers. Zero disables the limit.
+	// Valid values: 0-1.
+	MaxWithdrawRatePerBlock github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=max_withdraw_rate_per_block,json=maxWithdrawRatePerBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_withdraw_rate_per_block" yaml:"max_withdraw_rate_per_block"`
+	// Borrow Paused is an emergency switch which rejects all new borrows while it is true.
+	// Repaying, withdrawing, and liquidating are unaffected.
+	BorrowPaused bool `protobuf:"varint,10,opt,name=borrow_paused,json=borrowPaused,proto3" json:"borrow_paused,omitempty" yaml:"borrow_paused"`
+	// Supply Paused is an emergency switch which rejects all new supplies while it is true.
+	// Repaying, withdrawing, and liquidating are unaffected.
+	SupplyPaused bool `protobuf:"varint,11,opt,name=supply_paused,json=supplyPaused,proto3" json:"supply_paused,omitempty" yaml:"supply_paused"`
+	// Liquidation Dust Threshold is the USD value below which a borrower's remaining debt
+	// in the repaid denom is considered dust. If a liquidation limited by close factor would
+	// leave less than this value, the full debt in that denom can be repaid instead.
+	// Zero disables this behavior.
+	LiquidationDustThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=liquidation_dust_threshold,json=liquidationDustThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_dust_threshold" yaml:"liquidation_dust_threshold"`
+	// Compounding Mode determines how each token's borrow APY is applied to its
+	// interest scalar over the time elapsed since interest was last accrued.
+	CompoundingMode CompoundingMode `protobuf:"varint,13,opt,name=compounding_mode,json=compoundingMode,proto3,enum=umee.leverage.v1.CompoundingMode" json:"compounding_mode,omitempty" yaml:"compounding_mode"`
+	// Protocol Liquidation Share is the portion of each liquidation incentive which is added to
+	// the reward token's reserves instead of being received by the liquidator. Valid values: 0-1.
+	ProtocolLiquidationShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=protocol_liquidation_share,json=protocolLiquidationShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"protocol_liquidation_share" yaml:"protocol_liquidation_share"`
+	// Max Account Leverage is the maximum ratio of an account's collateral value to its equity
+	// (collateral value minus borrowed value) which a borrow may result in. Zero means no limit.
+	// Valid values: zero, or at least 1.
+	MaxAccountLeverage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=max_account_leverage,json=maxAccountLeverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_account_leverage" yaml:"max_account_leverage"`
+	// Utilization Smoothing Factor is the weight of current supply utilization in each token's
+	// smoothed utilization, which is updated at every interest accrual and used to derive borrow APY:
+	// smoothed = factor * current + (1 - factor) * previous smoothed. A factor of 1 disables smoothing.
+	// Valid values: greater than zero, at most 1.
+	UtilizationSmoothingFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=utilization_smoothing_factor,json=utilizationSmoothingFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"utilization_smoothing_factor" yaml:"utilization_smoothing_factor"`
+	// Circuit Breaker Deviation is the relative difference between a token's spot price and its
+	// trailing median price, checked at the start of every block, above which borrowing and
+	// liquidations involving the token are paused. Tokens without historic medians are not checked.
+	// Zero disables the circuit breaker. Valid values: zero or greater.
+	CircuitBreakerDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,17,opt,name=circuit_breaker_deviation,json=circuitBreakerDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"circuit_breaker_deviation" yaml:"circuit_breaker_deviation"`
+	// Circuit Breaker Cooldown Blocks is the number of blocks, after the block in which a token's
+	// circuit breaker is triggered, for which the token stays paused. The pause is extended while
+	// the deviation persists.
+	CircuitBreakerCooldownBlocks uint64 `protobuf:"varint,18,opt,name=circuit_breaker_cooldown_blocks,json=circuitBreakerCooldownBlocks,proto3" json:"circuit_breaker_cooldown_blocks,omitempty" yaml:"circuit_breaker_cooldown_blocks"`
+	// Liquidation Grace Period is the number of blocks, after the liquidation watchlist first finds an
+	// account eligible for liquidation, during which the account cannot be liquidated. The grace period
+	// restarts if the account returns to health. Zero disables the grace period.
+	LiquidationGracePeriod uint64 `protobuf:"varint,19,opt,name=liquidation_grace_period,json=liquidationGracePeriod,proto3" json:"liquidation_grace_period,omitempty" yaml:"liquidation_grace_period"`
+	// Bad Debt History Size is the maximum number of bad debt records kept in the bad debt history.
+	// When a new record would exceed it, the oldest records are removed. Zero disables the history.
+	BadDebtHistorySize uint64 `protobuf:"varint,20,opt,name=bad_debt_history_size,json=badDebtHistorySize,proto3" json:"bad_debt_history_size,omitempty" yaml:"bad_debt_history_size"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -204,6 +324,19 @@ type Token struct {
 	// The time span covered by the historic median will be:
 	//     oracle.Params.median_stamp_period * oracle.Params.historic_stamp_period * historic_medians.
 	HistoricMedians uint32 `protobuf:"varint,19,opt,name=historic_medians,json=historicMedians,proto3" json:"historic_medians,omitempty" yaml:"historic_medians"`
+	// No Self Borrow prevents accounts from borrowing this token while they have its uToken
+	// collateralized, to avoid recursive leverage on a single asset. It only restricts new borrows:
+	// collateral added after borrowing is not rejected. The default value of false allows self borrowing.
+	NoSelfBorrow bool `protobuf:"varint,20,opt,name=no_self_borrow,json=noSelfBorrow,proto3" json:"no_self_borrow,omitempty" yaml:"no_self_borrow"`
+	// Withdraw Fee is the portion of base tokens received by MsgWithdraw and related messages which is
+	// instead added to reserves. The default value of zero applies no fee.
+	// Valid values: 0-1 (exclusive of 1).
+	WithdrawFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,21,opt,name=withdraw_fee,json=withdrawFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"withdraw_fee" yaml:"withdraw_fee"`
+	// Liquidity Haircut is the portion of this token's value which is discounted by risk-conservative
+	// valuations, such as AccountSummary with apply_liquidity_haircut, to account for exit slippage in
+	// thinly-traded markets. It does not affect borrow limits or liquidations enforced by the module.
+	// Valid values: 0-1.
+	LiquidityHaircut github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,22,opt,name=liquidity_haircut,json=liquidityHaircut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_haircut" yaml:"liquidity_haircut"`
 }
 
 func (m *Token) Reset()         { *m = Token{} }
@@ -239,73 +372,286 @@ func (m *Token) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Token proto.InternalMessageInfo
 
+// BorrowAPYSample accumulates the borrow and supply APY of a token over one sampling interval,
+// weighted by the time for which they applied. It is used to compute trailing average borrow APY
+// and historical APY series.
+type BorrowAPYSample struct {
+	// Interval is the index of the sampling interval: unix time divided by the interval length.
+	Interval int64 `protobuf:"varint,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Seconds is the total time within the interval over which interest was accrued.
+	Seconds int64 `protobuf:"varint,2,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	// APY seconds is the sum of borrow APY multiplied by the seconds for which it applied.
+	ApySeconds github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=apy_seconds,json=apySeconds,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"apy_seconds"`
+	// Supply APY seconds is the sum of supply APY multiplied by the seconds for which it applied.
+	// It is nil in samples recorded before supply APY was tracked.
+	SupplyApySeconds github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=supply_apy_seconds,json=supplyApySeconds,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"supply_apy_seconds"`
+	// Exchange rate is the token:uToken exchange rate after the latest interest accrual within the interval.
+	// It is nil in samples recorded before exchange rates were tracked.
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate"`
+	// Exchange rate time is the unix time at which the exchange rate, total borrowed and reserves were recorded.
+	ExchangeRateTime int64 `protobuf:"varint,6,opt,name=exchange_rate_time,json=exchangeRateTime,proto3" json:"exchange_rate_time,omitempty"`
+	// Total borrowed is the token's total borrowed amount, recorded alongside the exchange rate.
+	// It is nil in samples recorded before it was tracked.
+	TotalBorrowed github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=total_borrowed,json=totalBorrowed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_borrowed"`
+	// Reserves is the token's reserved amount, recorded alongside the exchange rate.
+	// It is nil in samples recorded before it was tracked.
+	Reserves github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=reserves,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"reserves"`
+}
+
+func (m *BorrowAPYSample) Reset()         { *m = BorrowAPYSample{} }
+func (m *BorrowAPYSample) String() string { return proto.CompactTextString(m) }
+func (*BorrowAPYSample) ProtoMessage()    {}
+func (*BorrowAPYSample) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cb1bf9ea641ecc6, []int{2}
+}
+func (m *BorrowAPYSample) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BorrowAPYSample) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BorrowAPYSample.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BorrowAPYSample) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BorrowAPYSample.Merge(m, src)
+}
+func (m *BorrowAPYSample) XXX_Size() int {
+	return m.Size()
+}
+func (m *BorrowAPYSample) XXX_DiscardUnknown() {
+	xxx_messageInfo_BorrowAPYSample.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BorrowAPYSample proto.InternalMessageInfo
+
+// StopLoss is a borrower's recorded intent allowing an executor account to repay the borrower's
+// borrows and withdraw its supplied assets on its behalf, once its health factor falls to a target.
+// Health factor is the borrower's liquidation threshold divided by their borrowed value, at spot prices.
+type StopLoss struct {
+	// Borrower is the account which set the stop-loss.
+	Borrower string `protobuf:"bytes,1,opt,name=borrower,proto3" json:"borrower,omitempty"`
+	// Executor is the only account allowed to execute the stop-loss.
+	Executor string `protobuf:"bytes,2,opt,name=executor,proto3" json:"executor,omitempty"`
+	// Target health factor is the health factor at or below which the stop-loss may be executed.
+	TargetHealthFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=target_health_factor,json=targetHealthFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_health_factor"`
+}
+
+func (m *StopLoss) Reset()         { *m = StopLoss{} }
+func (m *StopLoss) String() string { return proto.CompactTextString(m) }
+func (*StopLoss) ProtoMessage()    {}
+func (*StopLoss) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cb1bf9ea641ecc6, []int{3}
+}
+func (m *StopLoss) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StopLoss) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StopLoss.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StopLoss) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopLoss.Merge(m, src)
+}
+func (m *StopLoss) XXX_Size() int {
+	return m.Size()
+}
+func (m *StopLoss) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopLoss.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopLoss proto.InternalMessageInfo
+
+// BadDebtRecord records a borrowed denom of an account being marked as bad debt, because the account
+// had no collateral remaining.
+type BadDebtRecord struct {
+	// Height is the block height at which the bad debt was marked.
+	Height  int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Denom   string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Amount is the amount of the denom borrowed by the account when its bad debt was marked.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *BadDebtRecord) Reset()         { *m = BadDebtRecord{} }
+func (m *BadDebtRecord) String() string { return proto.CompactTextString(m) }
+func (*BadDebtRecord) ProtoMessage()    {}
+func (*BadDebtRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cb1bf9ea641ecc6, []int{4}
+}
+func (m *BadDebtRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BadDebtRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BadDebtRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BadDebtRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BadDebtRecord.Merge(m, src)
+}
+func (m *BadDebtRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *BadDebtRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_BadDebtRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BadDebtRecord proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("umee.leverage.v1.ZeroPricePolicy", ZeroPricePolicy_name, ZeroPricePolicy_value)
+	proto.RegisterEnum("umee.leverage.v1.CompoundingMode", CompoundingMode_name, CompoundingMode_value)
 	proto.RegisterType((*Params)(nil), "umee.leverage.v1.Params")
 	proto.RegisterType((*Token)(nil), "umee.leverage.v1.Token")
+	proto.RegisterType((*BorrowAPYSample)(nil), "umee.leverage.v1.BorrowAPYSample")
+	proto.RegisterType((*StopLoss)(nil), "umee.leverage.v1.StopLoss")
+	proto.RegisterType((*BadDebtRecord)(nil), "umee.leverage.v1.BadDebtRecord")
 }
 
 func init() { proto.RegisterFile("umee/leverage/v1/leverage.proto", fileDescriptor_8cb1bf9ea641ecc6) }
 
 var fileDescriptor_8cb1bf9ea641ecc6 = []byte{
-	// 926 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xbf, 0x6f, 0x1b, 0x37,
-	0x14, 0xd6, 0xb5, 0xb6, 0x6b, 0x33, 0xb1, 0x24, 0x9f, 0x65, 0xe7, 0xd0, 0xb8, 0x3a, 0x83, 0x40,
-	0x0b, 0x2f, 0xb1, 0x1a, 0xb4, 0x5d, 0x3c, 0xca, 0x81, 0x1b, 0x17, 0x71, 0xda, 0xd2, 0x29, 0x02,
-	0x74, 0x39, 0x50, 0xa7, 0x17, 0x89, 0x10, 0xef, 0xa8, 0x92, 0xd4, 0x2f, 0x2f, 0x1d, 0x8a, 0x4e,
-	0x5d, 0x3a, 0x76, 0x29, 0x90, 0x3f, 0xa5, 0xa3, 0xc7, 0x8c, 0x45, 0x07, 0xa1, 0xb5, 0x97, 0x0e,
-	0x9d, 0xfc, 0x17, 0x14, 0x47, 0x9e, 0x74, 0x27, 0x47, 0x09, 0x20, 0x28, 0x93, 0x8e, 0xdf, 0x7b,
-	0xfa, 0xbe, 0x8f, 0xe4, 0x7b, 0x24, 0x91, 0xdf, 0x8b, 0x00, 0x6a, 0x1c, 0xfa, 0x20, 0x69, 0x0b,
-	0x6a, 0xfd, 0x87, 0xd3, 0xef, 0xc3, 0xae, 0x14, 0x5a, 0xb8, 0xe5, 0x24, 0xe1, 0x70, 0x0a, 0xf6,
-	0x1f, 0x7e, 0x58, 0x69, 0x89, 0x96, 0x30, 0xc1, 0x5a, 0xf2, 0x65, 0xf3, 0xf0, 0x1f, 0xab, 0x68,
-	0xed, 0x1b, 0x2a, 0x69, 0xa4, 0xdc, 0xdf, 0x1d, 0x54, 0x0d, 0x45, 0xd4, 0xe5, 0xa0, 0x21, 0xe0,
-	0xec, 0x87, 0x1e, 0x6b, 0x52, 0xcd, 0x44, 0x1c, 0xe8, 0xb6, 0x04, 0xd5, 0x16, 0xbc, 0xe9, 0xbd,
-	0xb7, 0xef, 0x1c, 0x6c, 0xd4, 0x9f, 0x5f, 0x8e, 0xfd, 0xc2, 0x5f, 0x63, 0xff, 0x93, 0x16, 0xd3,
-	0xed, 0x5e, 0xe3, 0x30, 0x14, 0x51, 0x2d, 0x14, 0x2a, 0x12, 0x2a, 0xfd, 0x79, 0xa0, 0x9a, 0x9d,
-	0x9a, 0x1e, 0x75, 0x41, 0x1d, 0x3e, 0x82, 0xf0, 0x66, 0xec, 0x7f, 0x3c, 0xa2, 0x11, 0x3f, 0xc2,
-	0x6f, 0x67, 0xc7, 0x64, 0x6f, 0x92, 0xf0, 0x24, 0x8b, 0x3f, 0x9b, 0x84, 0xdd, 0x1f, 0x51, 0x25,
-	0x62, 0x31, 0x8b, 0x7a, 0x51, 0x10, 0x72, 0xa1, 0x20, 0x78, 0x41, 0x43, 0x2d, 0xa4, 0xf7, 0xbe,
-	0x31, 0x75, 0xb6, 0xb0, 0xa9, 0xfb, 0xd6, 0xd4, 0x3c, 0x4e, 0x4c, 0xdc, 0x14, 0x3e, 0x4e, 0xd0,
-	0x13, 0x03, 0x26, 0x06, 0x84, 0xa4, 0x21, 0x87, 0x40, 0xc2, 0x80, 0xca, 0xe6, 0xc4, 0xc0, 0xca,
-	0x72, 0x06, 0xe6, 0x71, 0x62, 0xe2, 0x5a, 0x98, 0x18, 0x34, 0x35, 0xf0, 0xb3, 0x83, 0x76, 0x55,
-	0x44, 0x39, 0x9f, 0x59, 0x40, 0xc5, 0x2e, 0xc0, 0x5b, 0x35, 0x1e, 0xbe, 0x5e, 0xd8, 0xc3, 0x47,
-	0xd6, 0xc3, 0x7c, 0x56, 0x4c, 0x2a, 0x26, 0x90, 0xdb, 0x8e, 0x73, 0x76, 0x01, 0xc6, 0x47, 0x93,
-	0x49, 0x08, 0xf5, 0xcc, 0x5f, 0x5e, 0x00, 0x78, 0x6b, 0xcb, 0xf9, 0x98, 0xcf, 0x8a, 0x49, 0xc5,
-	0x06, 0x72, 0x46, 0x4e, 0x00, 0x8e, 0x56, 0x7e, 0x7b, 0xe9, 0x17, 0xf0, 0x7f, 0x45, 0xb4, 0xfa,
-	0x4c, 0x74, 0x20, 0x76, 0x3f, 0x47, 0xa8, 0x41, 0x15, 0x04, 0x4d, 0x88, 0x45, 0xe4, 0x39, 0xc6,
-	0xca, 0xce, 0xcd, 0xd8, 0xdf, 0xb2, 0xe4, 0x59, 0x0c, 0x93, 0x8d, 0x64, 0xf0, 0x28, 0xf9, 0x76,
-	0x63, 0x54, 0x94, 0xa0, 0x40, 0xf6, 0xa7, 0x15, 0x65, 0xcb, 0xfc, 0xcb, 0x85, 0x27, 0xb1, 0x63,
-	0x75, 0x66, 0xd9, 0x30, 0xd9, 0x4c, 0x81, 0x74, 0x17, 0x07, 0x68, 0x2b, 0x14, 0x9c, 0x53, 0x0d,
-	0x92, 0xf2, 0x60, 0x00, 0xac, 0xd5, 0xd6, 0x69, 0x11, 0x7f, 0xb5, 0xb0, 0xa4, 0x37, 0xe9, 0xac,
-	0x5b, 0x84, 0x98, 0x94, 0x33, 0xec, 0xb9, 0x81, 0xdc, 0x9f, 0x1c, 0xb4, 0x33, 0xbf, 0xaf, 0x6d,
-	0x05, 0x3f, 0x5d, 0x58, 0x7d, 0xcf, 0xaa, 0xbf, 0xa1, 0x9d, 0x2b, 0x7c, 0x5e, 0x1b, 0x2b, 0x54,
-	0x36, 0x1b, 0xd1, 0x10, 0x52, 0x8a, 0x41, 0x20, 0xa9, 0x9e, 0x54, 0xef, 0xe9, 0xc2, 0xfa, 0xf7,
-	0x72, 0x1b, 0x9b, 0xe3, 0xc3, 0xa4, 0x98, 0x40, 0x75, 0x83, 0x10, 0xaa, 0x21, 0x11, 0xed, 0xb0,
-	0xb8, 0x33, 0x23, 0xba, 0xb6, 0x9c, 0xe8, 0x6d, 0x3e, 0x4c, 0x8a, 0x09, 0x94, 0x13, 0xed, 0xa2,
-	0x52, 0x44, 0x87, 0x33, 0x9a, 0x1f, 0x18, 0xcd, 0xc7, 0x0b, 0x6b, 0xee, 0xa6, 0x67, 0xd5, 0x2c,
-	0x1d, 0x26, 0x9b, 0x11, 0x1d, 0xe6, 0x14, 0x75, 0x3a, 0xcd, 0x9e, 0x66, 0x9c, 0x5d, 0x98, 0x85,
-	0xf7, 0xd6, 0xdf, 0xc1, 0x34, 0x73, 0x7c, 0x98, 0x94, 0x12, 0xe8, 0xbb, 0x0c, 0x79, 0xad, 0xae,
-	0x58, 0x1c, 0x42, 0xac, 0x59, 0x1f, 0xbc, 0x8d, 0x77, 0x57, 0x57, 0x53, 0xd2, 0xd9, 0xba, 0x3a,
-	0x9d, 0xc0, 0xee, 0x11, 0xba, 0xab, 0x46, 0x51, 0x43, 0xf0, 0xb4, 0xfd, 0x91, 0xd1, 0xbe, 0x77,
-	0x33, 0xf6, 0xb7, 0xd3, 0x33, 0x2e, 0x17, 0xc5, 0xe4, 0x8e, 0x1d, 0xda, 0x23, 0xa0, 0x86, 0xd6,
-	0x61, 0xd8, 0x15, 0x31, 0xc4, 0xda, 0xbb, 0xb3, 0xef, 0x1c, 0x6c, 0xd6, 0xb7, 0x6f, 0xc6, 0x7e,
-	0xc9, 0xfe, 0x6f, 0x12, 0xc1, 0x64, 0x9a, 0xe4, 0x3e, 0x46, 0x5b, 0x10, 0xd3, 0x06, 0x87, 0x20,
-	0x52, 0xad, 0x40, 0xf5, 0xba, 0x5d, 0x3e, 0xf2, 0xee, 0xee, 0x3b, 0x07, 0xeb, 0xf5, 0xbd, 0xac,
-	0x2b, 0x5f, 0x4b, 0xc1, 0xa4, 0x64, 0xb1, 0x33, 0xd5, 0x3a, 0x37, 0xc8, 0x2d, 0x26, 0xbb, 0xb9,
-	0xde, 0xe6, 0x5b, 0x98, 0x6c, 0x4a, 0x9e, 0xc9, 0x16, 0x80, 0xbb, 0x87, 0x36, 0x1a, 0x9c, 0x86,
-	0x1d, 0xce, 0x94, 0xf6, 0x8a, 0x09, 0x03, 0xc9, 0x00, 0x73, 0x7b, 0xd2, 0x61, 0x90, 0x3b, 0x28,
-	0x54, 0x9b, 0x4a, 0xf0, 0x4a, 0x4b, 0xde, 0x9e, 0x73, 0x38, 0x93, 0xdb, 0x93, 0x0e, 0x8f, 0xa7,
-	0xe8, 0x79, 0x02, 0x9a, 0x4b, 0x23, 0xc9, 0xb6, 0x2b, 0x31, 0x53, 0xa2, 0xe5, 0xe5, 0x2e, 0x8d,
-	0xf9, 0xac, 0x98, 0x24, 0x13, 0xb6, 0xab, 0x9c, 0xaf, 0xd6, 0x5f, 0x1c, 0xe4, 0x45, 0x2c, 0xce,
-	0xbb, 0xb6, 0xf5, 0xc4, 0xf4, 0xc8, 0xdb, 0x32, 0x4e, 0xbe, 0x5d, 0xd8, 0x89, 0x3f, 0x7d, 0x4b,
-	0xcc, 0xe5, 0xc5, 0x64, 0x37, 0x62, 0x71, 0xb6, 0x22, 0x4f, 0x26, 0x01, 0xb7, 0x81, 0x50, 0x66,
-	0xdf, 0x73, 0x8d, 0xfc, 0xf1, 0x02, 0xf2, 0xa7, 0xb1, 0xce, 0x2e, 0xb8, 0x8c, 0x09, 0x93, 0x8d,
-	0xe9, 0xe4, 0xdd, 0x13, 0x54, 0x6e, 0x33, 0xa5, 0x85, 0x64, 0x61, 0x10, 0x41, 0x93, 0xd1, 0x58,
-	0x79, 0xdb, 0xa6, 0xca, 0xef, 0x67, 0x7d, 0x7e, 0x3b, 0x03, 0x93, 0xd2, 0x04, 0x3a, 0xb3, 0xc8,
-	0xd1, 0xca, 0xbf, 0x2f, 0x7d, 0xa7, 0xfe, 0xf4, 0xf2, 0x9f, 0x6a, 0xe1, 0xf2, 0xaa, 0xea, 0xbc,
-	0xba, 0xaa, 0x3a, 0x7f, 0x5f, 0x55, 0x9d, 0x5f, 0xaf, 0xab, 0x85, 0x57, 0xd7, 0xd5, 0xc2, 0x9f,
-	0xd7, 0xd5, 0xc2, 0xf7, 0x9f, 0xe6, 0x3c, 0x27, 0x4f, 0xd0, 0x07, 0x31, 0xe8, 0x81, 0x90, 0x1d,
-	0x33, 0xa8, 0xf5, 0xbf, 0xa8, 0x0d, 0xb3, 0x57, 0xab, 0x99, 0x41, 0x63, 0xcd, 0x3c, 0x44, 0x3f,
-	0xfb, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x8b, 0xcb, 0x95, 0xa4, 0xd3, 0x0a, 0x00, 0x00,
+	// 1876 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4f, 0x6f, 0xdb, 0xc8,
+	0x15, 0x37, 0x37, 0x8e, 0x23, 0x4f, 0x2c, 0x4b, 0x9e, 0xc8, 0x36, 0x13, 0x7b, 0x4d, 0x67, 0x16,
+	0xdd, 0x06, 0x69, 0xd7, 0xee, 0xf6, 0xcf, 0x25, 0x40, 0x51, 0x58, 0xb2, 0x13, 0x7b, 0x61, 0x5b,
+	0xea, 0xc8, 0x82, 0xbb, 0x41, 0x8b, 0xe9, 0x88, 0x9c, 0x48, 0x84, 0x48, 0x8e, 0x96, 0x1c, 0x59,
+	0x92, 0x2f, 0x3d, 0x14, 0x3d, 0x15, 0x28, 0xb6, 0xbd, 0xb4, 0x87, 0x16, 0xdd, 0x43, 0x3f, 0x4c,
+	0x8e, 0x7b, 0x2c, 0x7a, 0x10, 0xda, 0xe4, 0xd2, 0xb3, 0xbe, 0x40, 0x0b, 0xce, 0x90, 0x12, 0xf5,
+	0xc7, 0x01, 0x18, 0xe7, 0x24, 0xcd, 0xfb, 0xbd, 0xf9, 0xbd, 0x37, 0x8f, 0x6f, 0xde, 0x7b, 0x24,
+	0x30, 0x3a, 0x2e, 0x63, 0xfb, 0x0e, 0xbb, 0x62, 0x3e, 0x6d, 0xb0, 0xfd, 0xab, 0xcf, 0x47, 0xff,
+	0xf7, 0xda, 0x3e, 0x17, 0x1c, 0xe6, 0x43, 0x85, 0xbd, 0x91, 0xf0, 0xea, 0xf3, 0x47, 0x85, 0x06,
+	0x6f, 0x70, 0x09, 0xee, 0x87, 0xff, 0x94, 0x1e, 0xfa, 0xdf, 0x1a, 0x58, 0xaa, 0x50, 0x9f, 0xba,
+	0x01, 0xfc, 0x9b, 0x06, 0x76, 0x4c, 0xee, 0xb6, 0x1d, 0x26, 0x18, 0x71, 0xec, 0xaf, 0x3a, 0xb6,
+	0x45, 0x85, 0xcd, 0x3d, 0x22, 0x9a, 0x3e, 0x0b, 0x9a, 0xdc, 0xb1, 0xf4, 0x8f, 0x76, 0xb5, 0x27,
+	0xcb, 0xc5, 0xcb, 0xd7, 0x03, 0x63, 0xe1, 0x5f, 0x03, 0xe3, 0xd3, 0x86, 0x2d, 0x9a, 0x9d, 0xfa,
+	0x9e, 0xc9, 0xdd, 0x7d, 0x93, 0x07, 0x2e, 0x0f, 0xa2, 0x9f, 0xcf, 0x02, 0xab, 0xb5, 0x2f, 0xfa,
+	0x6d, 0x16, 0xec, 0x1d, 0x32, 0x73, 0x38, 0x30, 0xbe, 0xd3, 0xa7, 0xae, 0xf3, 0x0c, 0xbd, 0x9b,
+	0x1d, 0xe1, 0xed, 0x58, 0xe1, 0x74, 0x8c, 0x5f, 0xc4, 0x30, 0xfc, 0x0d, 0x28, 0xb8, 0xb6, 0x67,
+	0xbb, 0x1d, 0x97, 0x98, 0x0e, 0x0f, 0x18, 0x79, 0x45, 0x4d, 0xc1, 0x7d, 0xfd, 0x8e, 0x74, 0xea,
+	0x2c, 0xb5, 0x53, 0x5b, 0xca, 0xa9, 0x79, 0x9c, 0x08, 0xc3, 0x48, 0x5c, 0x0a, 0xa5, 0xcf, 0xa5,
+	0x30, 0x74, 0x80, 0xfb, 0xd4, 0x74, 0x18, 0xf1, 0x59, 0x97, 0xfa, 0x56, 0xec, 0xc0, 0xe2, 0xed,
+	0x1c, 0x98, 0xc7, 0x89, 0x30, 0x54, 0x62, 0x2c, 0xa5, 0x91, 0x03, 0xbf, 0xd3, 0xc0, 0x46, 0xe0,
+	0x52, 0xc7, 0x99, 0x08, 0x60, 0x60, 0x5f, 0x33, 0xfd, 0xae, 0xf4, 0xa1, 0x9c, 0xda, 0x87, 0x8f,
+	0x95, 0x0f, 0xf3, 0x59, 0x11, 0x2e, 0x48, 0x20, 0xf1, 0x38, 0xaa, 0xf6, 0x35, 0x93, 0x7e, 0x58,
+	0xb6, 0xcf, 0x4c, 0x31, 0xb1, 0xe5, 0x15, 0x63, 0xfa, 0xd2, 0xed, 0xfc, 0x98, 0xcf, 0x8a, 0x70,
+	0x41, 0x01, 0x09, 0x47, 0x9e, 0x33, 0x06, 0x5b, 0x60, 0xed, 0x9a, 0xf9, 0x9c, 0xb4, 0x7d, 0xdb,
+	0x64, 0xa4, 0xcd, 0x1d, 0xdb, 0xec, 0xeb, 0xf7, 0x76, 0xb5, 0x27, 0xab, 0x3f, 0x7c, 0xbc, 0x37,
+	0x7d, 0x01, 0xf6, 0x5e, 0x32, 0x9f, 0x57, 0x42, 0xcd, 0x8a, 0x54, 0x2c, 0x6e, 0x0f, 0x07, 0x86,
+	0xae, 0xcc, 0xce, 0xb0, 0x20, 0x9c, 0xbb, 0x9e, 0x54, 0x87, 0x97, 0x60, 0xa3, 0xce, 0x7d, 0x9f,
+	0x77, 0x89, 0xc9, 0xb9, 0x63, 0xf1, 0xae, 0x47, 0xea, 0x0e, 0x37, 0x5b, 0x81, 0x9e, 0xd9, 0xd5,
+	0x9e, 0x2c, 0x16, 0x1f, 0x8f, 0x4f, 0x31, 0x5f, 0x0f, 0xe1, 0x82, 0x02, 0x4a, 0x91, 0xbc, 0x28,
+	0xc5, 0xf0, 0x4f, 0x1a, 0xd8, 0x72, 0x69, 0x8f, 0x74, 0x6d, 0xd1, 0xb4, 0x7c, 0xda, 0x25, 0x3e,
+	0x15, 0x8c, 0xb4, 0x99, 0xaf, 0xf6, 0xe9, 0xcb, 0x32, 0xa4, 0x17, 0xa9, 0x43, 0x8a, 0xa2, 0xfc,
+	0xbe, 0x99, 0x1a, 0xe1, 0x4d, 0x97, 0xf6, 0x2e, 0x23, 0x10, 0x53, 0xc1, 0x2a, 0xcc, 0x97, 0x5e,
+	0xc1, 0x9f, 0x82, 0x6c, 0x74, 0x8a, 0x36, 0xed, 0x04, 0xcc, 0xd2, 0xc1, 0xae, 0xf6, 0x24, 0x53,
+	0xd4, 0x87, 0x03, 0xa3, 0x30, 0x71, 0x48, 0x05, 0x23, 0xbc, 0xa2, 0xd6, 0x15, 0xb9, 0x0c, 0xb7,
+	0x07, 0x9d, 0x76, 0xdb, 0xe9, 0xc7, 0xdb, 0xef, 0x4f, 0x6f, 0x9f, 0x80, 0x11, 0x5e, 0x51, 0xeb,
+	0x68, 0xfb, 0x1f, 0x35, 0xf0, 0x28, 0x99, 0x03, 0x56, 0x27, 0x10, 0x89, 0x32, 0xb4, 0x22, 0x23,
+	0x52, 0x4d, 0x1d, 0x91, 0xc7, 0xca, 0xf4, 0xcd, 0xcc, 0x08, 0xeb, 0x09, 0xf0, 0xb0, 0x13, 0x88,
+	0x71, 0xf9, 0xb1, 0x41, 0x3e, 0x2c, 0x4f, 0xbc, 0xe3, 0x59, 0xb6, 0xd7, 0x20, 0x2e, 0xb7, 0x98,
+	0x9e, 0xbd, 0x29, 0xd7, 0x4a, 0x63, 0xcd, 0x33, 0x6e, 0xb1, 0xe2, 0xd6, 0x70, 0x60, 0x6c, 0x8e,
+	0x8b, 0x60, 0x92, 0x04, 0xe1, 0x9c, 0x39, 0xa9, 0x2d, 0x8f, 0x2f, 0xcb, 0xb3, 0xc9, 0xa7, 0x2e,
+	0x65, 0x93, 0xfa, 0x4c, 0x5f, 0xbd, 0xdd, 0xf1, 0x6f, 0x66, 0x46, 0x58, 0x8f, 0xc1, 0xe4, 0x95,
+	0x0f, 0x21, 0x59, 0x7d, 0x69, 0x8f, 0x50, 0xd3, 0xe4, 0x1d, 0x4f, 0x90, 0xf8, 0xb0, 0x7a, 0xee,
+	0x96, 0xd5, 0x77, 0x0e, 0x67, 0x58, 0x7d, 0x69, 0xef, 0x40, 0x49, 0x4f, 0x23, 0x21, 0xfc, 0xb3,
+	0x06, 0xb6, 0x3b, 0xc2, 0x76, 0xec, 0xeb, 0xc8, 0x63, 0x97, 0x73, 0xd1, 0x0c, 0xa3, 0x18, 0x95,
+	0xe1, 0xbc, 0xf4, 0xa4, 0x96, 0xda, 0x93, 0x4f, 0x94, 0x27, 0xef, 0xe2, 0x46, 0xf8, 0x51, 0x02,
+	0xae, 0xc6, 0x68, 0x54, 0x96, 0xff, 0xa0, 0x81, 0x87, 0xa6, 0xed, 0x9b, 0x1d, 0x5b, 0x90, 0xba,
+	0xcf, 0x68, 0x8b, 0xf9, 0xc4, 0x62, 0x57, 0xb6, 0x54, 0xd6, 0xd7, 0xa4, 0x5b, 0x38, 0xb5, 0x5b,
+	0xbb, 0x51, 0xba, 0xdc, 0x44, 0x8c, 0xf0, 0x66, 0x84, 0x15, 0x15, 0x74, 0x18, 0x23, 0xf0, 0x2b,
+	0x60, 0x4c, 0x6f, 0x9b, 0xae, 0x59, 0x50, 0xd6, 0xac, 0xa7, 0xc3, 0x81, 0xf1, 0xe9, 0x7c, 0x3b,
+	0x33, 0xc5, 0x6b, 0x7b, 0xd2, 0xda, 0x54, 0x11, 0xfb, 0x15, 0x48, 0xde, 0x1c, 0xd2, 0xf0, 0xa9,
+	0x29, 0x0b, 0x8d, 0xcd, 0x2d, 0xfd, 0x81, 0xb4, 0xf5, 0xc9, 0x70, 0x60, 0x18, 0xb3, 0x17, 0x30,
+	0xa9, 0x89, 0xf0, 0x46, 0x02, 0x7a, 0x11, 0x22, 0x15, 0x09, 0xc0, 0x2a, 0x58, 0xaf, 0x53, 0x8b,
+	0x58, 0xac, 0x2e, 0x48, 0xd3, 0x0e, 0x04, 0xf7, 0xfb, 0xaa, 0xef, 0x15, 0x24, 0xf7, 0xee, 0x70,
+	0x60, 0x6c, 0x47, 0x65, 0x69, 0x9e, 0x1a, 0xc2, 0xb0, 0x4e, 0xad, 0x43, 0x56, 0x17, 0xc7, 0x4a,
+	0x1a, 0xb6, 0xb1, 0x67, 0x8b, 0x7f, 0xf9, 0xc6, 0x58, 0x40, 0x5f, 0xaf, 0x81, 0xbb, 0x17, 0xbc,
+	0xc5, 0x3c, 0xf8, 0x63, 0x00, 0xea, 0x34, 0x60, 0xc4, 0x62, 0x1e, 0x77, 0x75, 0x4d, 0x3e, 0xb7,
+	0xf5, 0xe1, 0xc0, 0x58, 0x8b, 0x99, 0x63, 0x0c, 0xe1, 0xe5, 0x70, 0x71, 0x18, 0xfe, 0x87, 0x1e,
+	0x58, 0xf5, 0x59, 0xc0, 0xfc, 0xab, 0xd1, 0x40, 0xa2, 0xa6, 0xa4, 0x17, 0xa9, 0x9f, 0xf8, 0xba,
+	0xb2, 0x33, 0xc9, 0x86, 0x70, 0x36, 0x12, 0x44, 0xd9, 0xd6, 0x05, 0x6b, 0x26, 0x77, 0x1c, 0x2a,
+	0x98, 0x4f, 0x1d, 0xd2, 0x65, 0x76, 0xa3, 0x29, 0xa2, 0x19, 0xe8, 0x8b, 0xd4, 0x26, 0xf5, 0xb8,
+	0x26, 0x4d, 0x11, 0x22, 0x9c, 0x1f, 0xcb, 0x2e, 0xa5, 0x08, 0xfe, 0x56, 0x03, 0xeb, 0xf3, 0xc7,
+	0x42, 0x35, 0x00, 0x9d, 0xa7, 0xb6, 0xbe, 0x3d, 0x9b, 0x0e, 0x89, 0x52, 0x5c, 0x70, 0xe6, 0x4d,
+	0x81, 0x01, 0xc8, 0xcb, 0x07, 0x11, 0xb5, 0x9f, 0xb0, 0xa1, 0x45, 0xc3, 0xcf, 0x49, 0x6a, 0xfb,
+	0x9b, 0x89, 0x07, 0x9b, 0xe0, 0x43, 0x78, 0x35, 0x14, 0x15, 0xa5, 0x24, 0xec, 0x8a, 0xa1, 0xd1,
+	0x96, 0xed, 0xb5, 0x26, 0x8c, 0x2e, 0xdd, 0xce, 0xe8, 0x34, 0x1f, 0xc2, 0xab, 0xa1, 0x28, 0x61,
+	0xb4, 0x0d, 0x72, 0x61, 0x75, 0x4c, 0xda, 0xbc, 0x27, 0x6d, 0x1e, 0xa7, 0xb6, 0xb9, 0x31, 0x2e,
+	0xb6, 0x13, 0x26, 0xb3, 0x2e, 0xed, 0x25, 0x2c, 0x8a, 0xe8, 0x98, 0x89, 0x5a, 0xa7, 0x67, 0x3e,
+	0xc0, 0x31, 0x13, 0x7c, 0x08, 0xe7, 0x42, 0x51, 0x6d, 0x2c, 0x99, 0xc9, 0x2b, 0xdb, 0x33, 0x99,
+	0x27, 0xec, 0x2b, 0xa6, 0x2f, 0x7f, 0xb8, 0xbc, 0x1a, 0x91, 0x4e, 0xe6, 0xd5, 0x49, 0x2c, 0x86,
+	0xcf, 0xc0, 0x4a, 0xd0, 0x77, 0xeb, 0xdc, 0x89, 0xae, 0x3f, 0x90, 0xb6, 0x37, 0x87, 0x03, 0xe3,
+	0x81, 0x62, 0x4b, 0xa2, 0x08, 0xdf, 0x57, 0x4b, 0x55, 0x02, 0xf6, 0x41, 0x86, 0xf5, 0xda, 0xdc,
+	0x63, 0x9e, 0x90, 0x83, 0x4e, 0xb6, 0xf8, 0x60, 0x38, 0x30, 0x72, 0x6a, 0x5f, 0x8c, 0x20, 0x3c,
+	0x52, 0x82, 0xc7, 0x60, 0x8d, 0x79, 0xb4, 0xee, 0x30, 0xe2, 0x06, 0x0d, 0xa2, 0x46, 0x1f, 0x39,
+	0xd5, 0x64, 0x92, 0x53, 0xe9, 0x8c, 0x0a, 0xc2, 0x39, 0x25, 0x3b, 0x0b, 0x1a, 0x55, 0x29, 0x99,
+	0x62, 0x52, 0x0f, 0x57, 0xcf, 0xbe, 0x83, 0x49, 0xa9, 0x24, 0x99, 0x54, 0x02, 0xc0, 0x6d, 0xb0,
+	0x5c, 0x77, 0xa8, 0xd9, 0x72, 0xec, 0x40, 0xc8, 0x11, 0x23, 0x83, 0xc7, 0x82, 0xb8, 0xfd, 0x27,
+	0x0a, 0x85, 0x9a, 0x45, 0x3e, 0x40, 0xfb, 0x9f, 0xe6, 0x54, 0xed, 0xbf, 0x34, 0x92, 0xaa, 0xf9,
+	0x23, 0x7c, 0xe7, 0x08, 0xb5, 0xa3, 0xb9, 0x31, 0x99, 0xa2, 0xf9, 0xdb, 0xbd, 0x73, 0xcc, 0x67,
+	0x45, 0x38, 0x3c, 0xb0, 0x8a, 0x72, 0x32, 0x5b, 0x7f, 0xaf, 0x01, 0xdd, 0xb5, 0xbd, 0xa4, 0xd7,
+	0x2a, 0x9f, 0x6c, 0xd1, 0x8f, 0x7a, 0xfd, 0xcf, 0x53, 0x7b, 0x62, 0x8c, 0x5e, 0x45, 0xe7, 0xf2,
+	0x22, 0xbc, 0xe1, 0xda, 0xde, 0x38, 0x22, 0xa7, 0x31, 0x00, 0xeb, 0x00, 0x8c, 0xdd, 0x97, 0x4d,
+	0x7d, 0xb9, 0x58, 0x4a, 0x61, 0xfe, 0xc4, 0x13, 0xe3, 0x06, 0x37, 0x66, 0x42, 0x78, 0x79, 0x74,
+	0x78, 0xf8, 0x1c, 0xe4, 0x55, 0x2f, 0xb5, 0x4d, 0xe2, 0x32, 0xcb, 0xa6, 0x5e, 0x20, 0x5b, 0x7a,
+	0x36, 0x39, 0xd5, 0x4e, 0x6b, 0x20, 0x9c, 0x8b, 0x45, 0x67, 0x4a, 0x02, 0x7f, 0x06, 0x56, 0x3d,
+	0x4e, 0x02, 0xe6, 0xbc, 0x8a, 0xf3, 0xb4, 0x20, 0xf3, 0xf4, 0xe1, 0xb8, 0xf5, 0x4d, 0xe2, 0x08,
+	0xaf, 0x78, 0xbc, 0xca, 0x9c, 0x57, 0x51, 0x86, 0x36, 0xc1, 0xca, 0xe8, 0x45, 0x26, 0x7c, 0xd7,
+	0x5c, 0x97, 0xc7, 0x3d, 0x4a, 0x1d, 0xed, 0xe8, 0x42, 0x27, 0xb9, 0x10, 0xbe, 0x1f, 0x2f, 0xc3,
+	0x17, 0xcb, 0x2e, 0x58, 0x1b, 0x05, 0x9f, 0x34, 0x69, 0x38, 0xf8, 0x08, 0x7d, 0xe3, 0x76, 0x3d,
+	0x76, 0x86, 0x10, 0xe1, 0xfc, 0x48, 0x76, 0xac, 0x44, 0xcf, 0x16, 0xff, 0xfb, 0x8d, 0xa1, 0xa1,
+	0xbf, 0x2e, 0x82, 0x9c, 0x3a, 0xf3, 0x41, 0xe5, 0xcb, 0x2a, 0x0d, 0xbf, 0x89, 0xc0, 0x47, 0x20,
+	0x63, 0x7b, 0x82, 0xf9, 0x57, 0xd4, 0x91, 0xa3, 0xc9, 0x1d, 0x3c, 0x5a, 0x43, 0x1d, 0xdc, 0x0b,
+	0x98, 0xc9, 0x3d, 0x2b, 0x90, 0xb3, 0xc7, 0x1d, 0x1c, 0x2f, 0x61, 0x19, 0xdc, 0xa7, 0xed, 0x3e,
+	0x89, 0x51, 0x35, 0x26, 0xec, 0xa5, 0x3b, 0x02, 0x06, 0xb4, 0xdd, 0xaf, 0x46, 0x84, 0xbf, 0x04,
+	0x30, 0xba, 0x2b, 0x49, 0xde, 0xc5, 0xf7, 0xe2, 0xcd, 0x2b, 0xa6, 0x83, 0x31, 0x7b, 0x15, 0x64,
+	0x59, 0xcf, 0x6c, 0x52, 0xaf, 0xc1, 0x92, 0x9d, 0x3d, 0x2d, 0xf1, 0x4a, 0x4c, 0x22, 0xbb, 0xda,
+	0xf7, 0x01, 0x9c, 0x20, 0x25, 0xc2, 0x76, 0x55, 0xfb, 0xbe, 0x83, 0xf3, 0x49, 0xcd, 0x0b, 0xdb,
+	0x65, 0xb0, 0x06, 0x56, 0x05, 0x17, 0xd4, 0x89, 0x72, 0x90, 0x59, 0xfa, 0xbd, 0xd4, 0x3e, 0x9c,
+	0x78, 0x02, 0x67, 0x25, 0x4b, 0x31, 0x22, 0x81, 0x5f, 0x80, 0x4c, 0x34, 0xc6, 0x05, 0x7a, 0xe6,
+	0xbd, 0x08, 0x47, 0xfb, 0xd1, 0x3f, 0x34, 0x90, 0xa9, 0x0a, 0xde, 0x3e, 0xe5, 0x41, 0x10, 0xe6,
+	0x45, 0xe4, 0xa9, 0xaf, 0x46, 0x56, 0x3c, 0x5a, 0x87, 0x18, 0xeb, 0x31, 0xb3, 0x33, 0x1a, 0x4a,
+	0xf1, 0x68, 0x0d, 0x7f, 0x0d, 0x0a, 0x82, 0xfa, 0x0d, 0x26, 0x48, 0x93, 0x51, 0x47, 0x34, 0x27,
+	0xbf, 0xa6, 0xa5, 0x8d, 0x38, 0x54, 0x5c, 0xc7, 0x92, 0x4a, 0x0d, 0xaa, 0xe8, 0xef, 0x1a, 0xc8,
+	0x16, 0xd5, 0xd4, 0x8d, 0x99, 0xc9, 0x7d, 0x0b, 0x6e, 0x80, 0xa5, 0xa6, 0x9a, 0x57, 0x55, 0x06,
+	0x47, 0xab, 0x30, 0x7f, 0xa9, 0x65, 0xf9, 0x2c, 0x08, 0x22, 0x37, 0xe3, 0x25, 0x2c, 0x80, 0xbb,
+	0xaa, 0x1d, 0x4b, 0xb7, 0xb0, 0x5a, 0xc0, 0xe7, 0x60, 0x89, 0xba, 0xe1, 0xcb, 0xa1, 0xbe, 0xf8,
+	0x5e, 0xa1, 0x8c, 0x76, 0x3f, 0xbd, 0x06, 0xb9, 0xa9, 0x8f, 0x42, 0xf0, 0x63, 0xf0, 0xf0, 0xe5,
+	0x11, 0x2e, 0x93, 0x0a, 0x3e, 0x29, 0x1d, 0x91, 0x4a, 0xf9, 0xf4, 0xa4, 0xf4, 0x25, 0x39, 0xfa,
+	0x45, 0xe9, 0xb4, 0x76, 0x78, 0x94, 0x5f, 0x80, 0x5b, 0x60, 0x73, 0x0e, 0x8c, 0x71, 0x19, 0xe7,
+	0x35, 0xf8, 0x3d, 0xf0, 0xdd, 0x59, 0xf0, 0x02, 0x1f, 0x1d, 0x5c, 0x90, 0x83, 0x2a, 0xa9, 0x9d,
+	0x17, 0xcb, 0x18, 0x97, 0x2f, 0x0f, 0x8a, 0xa7, 0x47, 0xf9, 0x8f, 0x9e, 0x96, 0x41, 0x6e, 0xea,
+	0x23, 0x01, 0x34, 0xc0, 0x56, 0xa9, 0x7c, 0x56, 0x29, 0xd7, 0xce, 0x0f, 0x4f, 0xce, 0x5f, 0x90,
+	0xb3, 0xf2, 0xe1, 0x11, 0x29, 0x95, 0xcf, 0x2f, 0x4e, 0xce, 0x6b, 0xe5, 0x5a, 0x55, 0x59, 0x9f,
+	0x51, 0x38, 0x38, 0x3f, 0xaf, 0x1d, 0x9c, 0xe6, 0xb5, 0xe2, 0xf9, 0xeb, 0xff, 0xec, 0x2c, 0xbc,
+	0x7e, 0xb3, 0xa3, 0x7d, 0xfb, 0x66, 0x47, 0xfb, 0xf7, 0x9b, 0x1d, 0xed, 0xeb, 0xb7, 0x3b, 0x0b,
+	0xdf, 0xbe, 0xdd, 0x59, 0xf8, 0xe7, 0xdb, 0x9d, 0x85, 0x97, 0x3f, 0x48, 0x84, 0x26, 0xfc, 0x5a,
+	0xf1, 0x99, 0xc7, 0x44, 0x97, 0xfb, 0x2d, 0xb9, 0xd8, 0xbf, 0xfa, 0xc9, 0x7e, 0x6f, 0xfc, 0x35,
+	0x59, 0x06, 0xaa, 0xbe, 0x24, 0x3f, 0x05, 0xfc, 0xe8, 0xff, 0x03, 0x00, 0xe7, 0xfa, 0x0f, 0x89,
+	0x6b, 0x16, 0x00, 0x00,
 }
 
 func (this *Token) Equal(that interface{}) bool {
@@ -384,6 +730,15 @@ func (this *Token) Equal(that interface{}) bool {
 	if this.HistoricMedians != that1.HistoricMedians {
 		return false
 	}
+	if this.NoSelfBorrow != that1.NoSelfBorrow {
+		return false
+	}
+	if !this.WithdrawFee.Equal(that1.WithdrawFee) {
+		return false
+	}
+	if !this.LiquidityHaircut.Equal(that1.LiquidityHaircut) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -406,6 +761,126 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BadDebtHistorySize != 0 {
+		i = encodeVarintLeverage(dAtA, i, uint64(m.BadDebtHistorySize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.LiquidationGracePeriod != 0 {
+		i = encodeVarintLeverage(dAtA, i, uint64(m.LiquidationGracePeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.CircuitBreakerCooldownBlocks != 0 {
+		i = encodeVarintLeverage(dAtA, i, uint64(m.CircuitBreakerCooldownBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	{
+		size := m.CircuitBreakerDeviation.Size()
+		i -= size
+		if _, err := m.CircuitBreakerDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLeverage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.UtilizationSmoothingFactor.Size()
+		i -= size
+		if _, err := m.UtilizationSmoothingFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLeverage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.MaxAccountLeverage.Size()
+		i -= size
+		if _, err := m.MaxAccountLeverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLeverage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.ProtocolLiquidationShare.Size()
+		i -= size
+		if _, err := m.ProtocolLiquidationShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLeverage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.CompoundingMode != 0 {
+		i = encodeVarintLeverage(dAtA, i, uint64(m.CompoundingMode))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.LiquidationDustThreshold.Size()
+		i -= size
+		if _, err := m.LiquidationDustThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLeverage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.SupplyPaused {
+		i--
+		if m.SupplyPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.BorrowPaused {
+		i--
+		if m.BorrowPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.MaxWithdrawRatePerBlock.Size()
+		i -= size
+		if _, err := m.MaxWithdrawRatePerBlock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLeverage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.BorrowCooldownBlocks != 0 {
+		i = encodeVarintLeverage(dAtA, i, uint64(m.BorrowCooldownBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ZeroPricePolicy != 0 {
+		i = encodeVarintLeverage(dAtA, i, uint64(m.ZeroPricePolicy))
+		i--
+		dAtA[i] = 0x38
+	}
 	{
 		size := m.DirectLiquidationFee.Size()
 		i -= size
@@ -479,6 +954,42 @@ func (m *Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.LiquidityHaircut.Size()
+		i -= size
+		if _, err := m.LiquidityHaircut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLeverage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	{
+		size := m.WithdrawFee.Size()
+		i -= size
+		if _, err := m.WithdrawFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLeverage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if m.NoSelfBorrow {
+		i--
+		if m.NoSelfBorrow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
 	if m.HistoricMedians != 0 {
 		i = encodeVarintLeverage(dAtA, i, uint64(m.HistoricMedians))
 		i--
@@ -664,45 +1175,268 @@ func (m *Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintLeverage(dAtA []byte, offset int, v uint64) int {
-	offset -= sovLeverage(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BorrowAPYSample) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CompleteLiquidationThreshold.Size()
-	n += 1 + l + sovLeverage(uint64(l))
-	l = m.MinimumCloseFactor.Size()
-	n += 1 + l + sovLeverage(uint64(l))
-	l = m.OracleRewardFactor.Size()
-	n += 1 + l + sovLeverage(uint64(l))
-	l = m.SmallLiquidationSize.Size()
-	n += 1 + l + sovLeverage(uint64(l))
-	l = m.DirectLiquidationFee.Size()
-	n += 1 + l + sovLeverage(uint64(l))
-	return n
+
+func (m *BorrowAPYSample) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Token) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *BorrowAPYSample) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovLeverage(uint64(l))
+	{
+		size := m.Reserves.Size()
+		i -= size
+		if _, err := m.Reserves.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLeverage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.TotalBorrowed.Size()
+		i -= size
+		if _, err := m.TotalBorrowed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLeverage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.ExchangeRateTime != 0 {
+		i = encodeVarintLeverage(dAtA, i, uint64(m.ExchangeRateTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLeverage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SupplyApySeconds.Size()
+		i -= size
+		if _, err := m.SupplyApySeconds.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLeverage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ApySeconds.Size()
+		i -= size
+		if _, err := m.ApySeconds.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLeverage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Seconds != 0 {
+		i = encodeVarintLeverage(dAtA, i, uint64(m.Seconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Interval != 0 {
+		i = encodeVarintLeverage(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StopLoss) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopLoss) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StopLoss) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TargetHealthFactor.Size()
+		i -= size
+		if _, err := m.TargetHealthFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLeverage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintLeverage(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintLeverage(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BadDebtRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BadDebtRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BadDebtRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLeverage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintLeverage(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintLeverage(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintLeverage(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLeverage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLeverage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CompleteLiquidationThreshold.Size()
+	n += 1 + l + sovLeverage(uint64(l))
+	l = m.MinimumCloseFactor.Size()
+	n += 1 + l + sovLeverage(uint64(l))
+	l = m.OracleRewardFactor.Size()
+	n += 1 + l + sovLeverage(uint64(l))
+	l = m.SmallLiquidationSize.Size()
+	n += 1 + l + sovLeverage(uint64(l))
+	l = m.DirectLiquidationFee.Size()
+	n += 1 + l + sovLeverage(uint64(l))
+	if m.ZeroPricePolicy != 0 {
+		n += 1 + sovLeverage(uint64(m.ZeroPricePolicy))
+	}
+	if m.BorrowCooldownBlocks != 0 {
+		n += 1 + sovLeverage(uint64(m.BorrowCooldownBlocks))
+	}
+	l = m.MaxWithdrawRatePerBlock.Size()
+	n += 1 + l + sovLeverage(uint64(l))
+	if m.BorrowPaused {
+		n += 2
+	}
+	if m.SupplyPaused {
+		n += 2
+	}
+	l = m.LiquidationDustThreshold.Size()
+	n += 1 + l + sovLeverage(uint64(l))
+	if m.CompoundingMode != 0 {
+		n += 1 + sovLeverage(uint64(m.CompoundingMode))
+	}
+	l = m.ProtocolLiquidationShare.Size()
+	n += 1 + l + sovLeverage(uint64(l))
+	l = m.MaxAccountLeverage.Size()
+	n += 1 + l + sovLeverage(uint64(l))
+	l = m.UtilizationSmoothingFactor.Size()
+	n += 2 + l + sovLeverage(uint64(l))
+	l = m.CircuitBreakerDeviation.Size()
+	n += 2 + l + sovLeverage(uint64(l))
+	if m.CircuitBreakerCooldownBlocks != 0 {
+		n += 2 + sovLeverage(uint64(m.CircuitBreakerCooldownBlocks))
+	}
+	if m.LiquidationGracePeriod != 0 {
+		n += 2 + sovLeverage(uint64(m.LiquidationGracePeriod))
+	}
+	if m.BadDebtHistorySize != 0 {
+		n += 2 + sovLeverage(uint64(m.BadDebtHistorySize))
+	}
+	return n
+}
+
+func (m *Token) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovLeverage(uint64(l))
 	}
 	l = m.ReserveFactor.Size()
 	n += 1 + l + sovLeverage(uint64(l))
@@ -747,6 +1481,82 @@ func (m *Token) Size() (n int) {
 	if m.HistoricMedians != 0 {
 		n += 2 + sovLeverage(uint64(m.HistoricMedians))
 	}
+	if m.NoSelfBorrow {
+		n += 3
+	}
+	l = m.WithdrawFee.Size()
+	n += 2 + l + sovLeverage(uint64(l))
+	l = m.LiquidityHaircut.Size()
+	n += 2 + l + sovLeverage(uint64(l))
+	return n
+}
+
+func (m *BorrowAPYSample) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Interval != 0 {
+		n += 1 + sovLeverage(uint64(m.Interval))
+	}
+	if m.Seconds != 0 {
+		n += 1 + sovLeverage(uint64(m.Seconds))
+	}
+	l = m.ApySeconds.Size()
+	n += 1 + l + sovLeverage(uint64(l))
+	l = m.SupplyApySeconds.Size()
+	n += 1 + l + sovLeverage(uint64(l))
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovLeverage(uint64(l))
+	if m.ExchangeRateTime != 0 {
+		n += 1 + sovLeverage(uint64(m.ExchangeRateTime))
+	}
+	l = m.TotalBorrowed.Size()
+	n += 1 + l + sovLeverage(uint64(l))
+	l = m.Reserves.Size()
+	n += 1 + l + sovLeverage(uint64(l))
+	return n
+}
+
+func (m *StopLoss) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovLeverage(uint64(l))
+	}
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovLeverage(uint64(l))
+	}
+	l = m.TargetHealthFactor.Size()
+	n += 1 + l + sovLeverage(uint64(l))
+	return n
+}
+
+func (m *BadDebtRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovLeverage(uint64(m.Height))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovLeverage(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovLeverage(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLeverage(uint64(l))
 	return n
 }
 
@@ -818,12 +1628,931 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if err := m.CompleteLiquidationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinimumCloseFactor", wireType)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumCloseFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinimumCloseFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleRewardFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleRewardFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmallLiquidationSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SmallLiquidationSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DirectLiquidationFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DirectLiquidationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZeroPricePolicy", wireType)
+			}
+			m.ZeroPricePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ZeroPricePolicy |= ZeroPricePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowCooldownBlocks", wireType)
+			}
+			m.BorrowCooldownBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BorrowCooldownBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWithdrawRatePerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxWithdrawRatePerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BorrowPaused = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SupplyPaused = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationDustThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationDustThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompoundingMode", wireType)
+			}
+			m.CompoundingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompoundingMode |= CompoundingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolLiquidationShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolLiquidationShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAccountLeverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAccountLeverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UtilizationSmoothingFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UtilizationSmoothingFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CircuitBreakerDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerCooldownBlocks", wireType)
+			}
+			m.CircuitBreakerCooldownBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CircuitBreakerCooldownBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationGracePeriod", wireType)
+			}
+			m.LiquidationGracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiquidationGracePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebtHistorySize", wireType)
+			}
+			m.BadDebtHistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BadDebtHistorySize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLeverage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Token) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLeverage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Token: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Token: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReserveFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollateralWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseBorrowRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseBorrowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KinkBorrowRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KinkBorrowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBorrowRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBorrowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KinkUtilization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KinkUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationIncentive", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationIncentive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbolDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			m.Exponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableMsgSupply", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableMsgSupply = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableMsgBorrow", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLeverage
@@ -833,31 +2562,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLeverage
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLeverage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MinimumCloseFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleRewardFactor", wireType)
+			m.EnableMsgBorrow = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blacklist", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLeverage
@@ -867,29 +2582,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLeverage
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLeverage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.OracleRewardFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+			m.Blacklist = bool(v != 0)
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SmallLiquidationSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCollateralShare", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -917,13 +2618,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SmallLiquidationSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxCollateralShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DirectLiquidationFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupplyUtilization", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -951,63 +2652,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DirectLiquidationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxSupplyUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLeverage(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLeverage
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Token) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLeverage
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Token: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Token: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCollateralLiquidity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1035,11 +2686,13 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.MinCollateralLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReserveFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1067,15 +2720,15 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReserveFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralWeight", wireType)
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricMedians", wireType)
 			}
-			var stringLen uint64
+			m.HistoricMedians = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLeverage
@@ -1085,31 +2738,16 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.HistoricMedians |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLeverage
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLeverage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CollateralWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationThreshold", wireType)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoSelfBorrow", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLeverage
@@ -1119,29 +2757,15 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLeverage
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLeverage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.LiquidationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+			m.NoSelfBorrow = bool(v != 0)
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseBorrowRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1169,13 +2793,13 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseBorrowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WithdrawFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KinkBorrowRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityHaircut", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1203,15 +2827,65 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.KinkBorrowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidityHaircut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxBorrowRate", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLeverage(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BorrowAPYSample) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLeverage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BorrowAPYSample: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BorrowAPYSample: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLeverage
@@ -1221,29 +2895,33 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Interval |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLeverage
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLeverage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seconds", wireType)
 			}
-			if err := m.MaxBorrowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Seconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KinkUtilization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApySeconds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1271,13 +2949,13 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.KinkUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApySeconds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationIncentive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyApySeconds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1305,13 +2983,13 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidationIncentive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SupplyApySeconds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SymbolDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1339,13 +3017,15 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SymbolDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 11:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateTime", wireType)
 			}
-			m.Exponent = 0
+			m.ExchangeRateTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLeverage
@@ -1355,16 +3035,16 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Exponent |= uint32(b&0x7F) << shift
+				m.ExchangeRateTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableMsgSupply", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBorrowed", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLeverage
@@ -1374,17 +3054,31 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableMsgSupply = bool(v != 0)
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableMsgBorrow", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeverage
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBorrowed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserves", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLeverage
@@ -1394,17 +3088,81 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableMsgBorrow = bool(v != 0)
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blacklist", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeverage
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reserves.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLeverage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StopLoss) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLeverage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopLoss: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopLoss: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLeverage
@@ -1414,15 +3172,27 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Blacklist = bool(v != 0)
-		case 15:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxCollateralShare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1450,13 +3220,11 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxCollateralShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Executor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupplyUtilization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetHealthFactor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1484,13 +3252,82 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxSupplyUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TargetHealthFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLeverage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BadDebtRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLeverage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BadDebtRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BadDebtRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeverage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCollateralLiquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1518,13 +3355,11 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinCollateralLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1552,15 +3387,13 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricMedians", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.HistoricMedians = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLeverage
@@ -1570,11 +3403,26 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HistoricMedians |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeverage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLeverage(dAtA[iNdEx:])
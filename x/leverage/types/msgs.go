@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -43,6 +45,12 @@ func (msg MsgGovUpdateRegistry) ValidateBasic() error {
 	if err := validateRegistryTokenDenoms(msg.AddTokens); err != nil {
 		return err
 	}
+	if err := validateRegistryTokenDenoms(msg.UpdateTokens); err != nil {
+		return err
+	}
+	if err := ValidateUTokenDenoms(nil, msg.ProposedTokens()); err != nil {
+		return err
+	}
 
 	for _, token := range msg.AddTokens {
 		if err := token.Validate(); err != nil {
@@ -50,10 +58,6 @@ func (msg MsgGovUpdateRegistry) ValidateBasic() error {
 		}
 	}
 
-	if err := validateRegistryTokenDenoms(msg.UpdateTokens); err != nil {
-		return err
-	}
-
 	for _, token := range msg.UpdateTokens {
 		if err := token.Validate(); err != nil {
 			return errors.Wrap(err, "token")
@@ -63,6 +67,13 @@ func (msg MsgGovUpdateRegistry) ValidateBasic() error {
 	return nil
 }
 
+// ProposedTokens returns the tokens to be updated, followed by the tokens to be added.
+func (msg MsgGovUpdateRegistry) ProposedTokens() []Token {
+	tokens := make([]Token, 0, len(msg.UpdateTokens)+len(msg.AddTokens))
+	tokens = append(tokens, msg.UpdateTokens...)
+	return append(tokens, msg.AddTokens...)
+}
+
 // GetSignBytes implements Msg
 func (msg MsgGovUpdateRegistry) GetSignBytes() []byte {
 	bz := ModuleCdc.MustMarshalJSON(&msg)
@@ -74,6 +85,209 @@ func (msg MsgGovUpdateRegistry) GetSigners() []sdk.AccAddress {
 	return checkers.Signers(msg.Authority)
 }
 
+var (
+	_ sdk.Msg = &MsgFreezeAccount{}
+	_ sdk.Msg = &MsgUnfreezeAccount{}
+)
+
+// NewMsgFreezeAccount will create a new MsgFreezeAccount instance
+func NewMsgFreezeAccount(authority, title, description, address string) *MsgFreezeAccount {
+	return &MsgFreezeAccount{
+		Authority:   authority,
+		Title:       title,
+		Description: description,
+		Address:     address,
+	}
+}
+
+// Type implements Msg interface
+func (msg MsgFreezeAccount) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements Msg
+func (msg MsgFreezeAccount) ValidateBasic() error {
+	if err := checkers.ValidateProposal(msg.Title, msg.Description, msg.Authority); err != nil {
+		return err
+	}
+	return checkers.ValidateAddr(msg.Address, "account")
+}
+
+// GetSignBytes implements Msg
+func (msg MsgFreezeAccount) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgFreezeAccount) GetSigners() []sdk.AccAddress {
+	return checkers.Signers(msg.Authority)
+}
+
+// NewMsgUnfreezeAccount will create a new MsgUnfreezeAccount instance
+func NewMsgUnfreezeAccount(authority, title, description, address string) *MsgUnfreezeAccount {
+	return &MsgUnfreezeAccount{
+		Authority:   authority,
+		Title:       title,
+		Description: description,
+		Address:     address,
+	}
+}
+
+// Type implements Msg interface
+func (msg MsgUnfreezeAccount) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements Msg
+func (msg MsgUnfreezeAccount) ValidateBasic() error {
+	if err := checkers.ValidateProposal(msg.Title, msg.Description, msg.Authority); err != nil {
+		return err
+	}
+	return checkers.ValidateAddr(msg.Address, "account")
+}
+
+// GetSignBytes implements Msg
+func (msg MsgUnfreezeAccount) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgUnfreezeAccount) GetSigners() []sdk.AccAddress {
+	return checkers.Signers(msg.Authority)
+}
+
+var _ sdk.Msg = &MsgWithdrawReserves{}
+
+// NewMsgWithdrawReserves will create a new MsgWithdrawReserves instance
+func NewMsgWithdrawReserves(authority, title, description, recipient string, asset sdk.Coin) *MsgWithdrawReserves {
+	return &MsgWithdrawReserves{
+		Authority:   authority,
+		Title:       title,
+		Description: description,
+		Recipient:   recipient,
+		Asset:       asset,
+	}
+}
+
+// Type implements Msg interface
+func (msg MsgWithdrawReserves) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements Msg
+func (msg MsgWithdrawReserves) ValidateBasic() error {
+	if err := checkers.ValidateProposal(msg.Title, msg.Description, msg.Authority); err != nil {
+		return err
+	}
+	if err := checkers.ValidateAddr(msg.Recipient, "recipient"); err != nil {
+		return err
+	}
+	if err := msg.Asset.Validate(); err != nil {
+		return err
+	}
+	if HasUTokenPrefix(msg.Asset.Denom) {
+		return ErrUToken.Wrap(msg.Asset.Denom)
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (msg MsgWithdrawReserves) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgWithdrawReserves) GetSigners() []sdk.AccAddress {
+	return checkers.Signers(msg.Authority)
+}
+
+var _ sdk.Msg = &MsgSetOracleSymbol{}
+
+// NewMsgSetOracleSymbol will create a new MsgSetOracleSymbol instance
+func NewMsgSetOracleSymbol(authority, title, description, denom, symbolDenom string) *MsgSetOracleSymbol {
+	return &MsgSetOracleSymbol{
+		Authority:   authority,
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+		SymbolDenom: symbolDenom,
+	}
+}
+
+// Type implements Msg interface
+func (msg MsgSetOracleSymbol) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements Msg
+func (msg MsgSetOracleSymbol) ValidateBasic() error {
+	if err := checkers.ValidateProposal(msg.Title, msg.Description, msg.Authority); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if HasUTokenPrefix(msg.Denom) {
+		return ErrUToken.Wrap(msg.Denom)
+	}
+	if err := sdk.ValidateDenom(msg.SymbolDenom); err != nil {
+		return err
+	}
+	if HasUTokenPrefix(msg.SymbolDenom) {
+		// prevent symbol denoms that start with "u/"
+		return ErrUToken.Wrap(msg.SymbolDenom)
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (msg MsgSetOracleSymbol) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgSetOracleSymbol) GetSigners() []sdk.AccAddress {
+	return checkers.Signers(msg.Authority)
+}
+
+var _ sdk.Msg = &MsgSetAccountBorrowCap{}
+
+// NewMsgSetAccountBorrowCap will create a new MsgSetAccountBorrowCap instance
+func NewMsgSetAccountBorrowCap(authority, title, description, address string, borrowCap sdk.Dec,
+) *MsgSetAccountBorrowCap {
+	return &MsgSetAccountBorrowCap{
+		Authority:   authority,
+		Title:       title,
+		Description: description,
+		Address:     address,
+		BorrowCap:   borrowCap,
+	}
+}
+
+// Type implements Msg interface
+func (msg MsgSetAccountBorrowCap) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements Msg
+func (msg MsgSetAccountBorrowCap) ValidateBasic() error {
+	if err := checkers.ValidateProposal(msg.Title, msg.Description, msg.Authority); err != nil {
+		return err
+	}
+	if err := checkers.ValidateAddr(msg.Address, "account"); err != nil {
+		return err
+	}
+	if msg.BorrowCap.IsNil() || msg.BorrowCap.IsNegative() {
+		return fmt.Errorf("borrow cap must not be negative: %s", msg.BorrowCap)
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (msg MsgSetAccountBorrowCap) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgSetAccountBorrowCap) GetSigners() []sdk.AccAddress {
+	return checkers.Signers(msg.Authority)
+}
+
 // validateRegistryTokenDenoms returns error if duplicate baseDenom exists.
 func validateRegistryTokenDenoms(tokens []Token) error {
 	tokenDenoms := map[string]bool{}
@@ -63,6 +63,26 @@ func TestMsgGovUpdateRegistryValidateBasic(t *testing.T) {
 				},
 			}, "invalid denom",
 		},
+		{
+			"add and update same token", types.MsgGovUpdateRegistry{
+				Authority:    authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Title:        "Title",
+				Description:  "Description",
+				AddTokens:    []types.Token{{BaseDenom: "uumee"}},
+				UpdateTokens: []types.Token{{BaseDenom: "uumee"}},
+			}, "uToken denom u/uumee of uumee equals the uToken denom of uumee",
+		},
+		{
+			"add token whose base is another uToken", types.MsgGovUpdateRegistry{
+				Authority:   authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Title:       "Title",
+				Description: "Description",
+				AddTokens: []types.Token{
+					{BaseDenom: "uumee"},
+					{BaseDenom: "u/uumee"},
+				},
+			}, "base denom u/uumee equals the uToken denom of uumee",
+		},
 		{
 			"empty add and update tokens", types.MsgGovUpdateRegistry{
 				Authority:   authtypes.NewModuleAddress(govtypes.ModuleName).String(),
@@ -96,6 +116,8 @@ func TestMsgGovUpdateRegistryValidateBasic(t *testing.T) {
 						MinCollateralLiquidity: sdk.MustNewDecFromStr("0"),
 						MaxSupply:              sdk.NewInt(100_000_000000),
 						HistoricMedians:        24,
+						WithdrawFee:            sdk.ZeroDec(),
+						LiquidityHaircut:       sdk.ZeroDec(),
 					},
 				},
 			}, "",
@@ -116,6 +138,137 @@ func TestMsgGovUpdateRegistryValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgFreezeAccountValidateBasic(t *testing.T) {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	addr := "umee1s84d29zk3k20xk9f0hvczkax90l9t94g72n6wm"
+
+	tcs := []struct {
+		name string
+		q    sdk.Msg
+		err  string
+	}{
+		{"no authority", types.NewMsgFreezeAccount("", "Title", "Description", addr), "expected gov account"},
+		{"no title", types.NewMsgFreezeAccount(govAddr, "", "Description", addr), "proposal title"},
+		{"invalid address", types.NewMsgFreezeAccount(govAddr, "Title", "Description", "xyz"), "invalid account address"},
+		{"valid", types.NewMsgFreezeAccount(govAddr, "Title", "Description", addr), ""},
+		{"unfreeze no authority", types.NewMsgUnfreezeAccount("", "Title", "Description", addr), "expected gov account"},
+		{"unfreeze invalid address", types.NewMsgUnfreezeAccount(govAddr, "Title", "Description", ""), "invalid account address"},
+		{"unfreeze valid", types.NewMsgUnfreezeAccount(govAddr, "Title", "Description", addr), ""},
+	}
+
+	for _, tc := range tcs {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				err := tc.q.ValidateBasic()
+				if tc.err == "" {
+					assert.NilError(t, err)
+				} else {
+					assert.ErrorContains(t, err, tc.err)
+				}
+			},
+		)
+	}
+}
+
+func TestMsgWithdrawReservesValidateBasic(t *testing.T) {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	addr := "umee1s84d29zk3k20xk9f0hvczkax90l9t94g72n6wm"
+	umee := sdk.NewInt64Coin("uumee", 100)
+
+	tcs := []struct {
+		name string
+		q    sdk.Msg
+		err  string
+	}{
+		{"no authority", types.NewMsgWithdrawReserves("", "Title", "Description", addr, umee), "expected gov account"},
+		{"invalid recipient", types.NewMsgWithdrawReserves(govAddr, "Title", "Description", "xyz", umee), "invalid recipient address"},
+		{"uToken", types.NewMsgWithdrawReserves(govAddr, "Title", "Description", addr,
+			sdk.NewInt64Coin("u/uumee", 100)), "uToken"},
+		{"invalid asset", types.NewMsgWithdrawReserves(govAddr, "Title", "Description", addr, sdk.Coin{}), "invalid denom"},
+		{"valid", types.NewMsgWithdrawReserves(govAddr, "Title", "Description", addr, umee), ""},
+		{"valid zero", types.NewMsgWithdrawReserves(govAddr, "Title", "Description", addr, sdk.NewInt64Coin("uumee", 0)), ""},
+	}
+
+	for _, tc := range tcs {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				err := tc.q.ValidateBasic()
+				if tc.err == "" {
+					assert.NilError(t, err)
+				} else {
+					assert.ErrorContains(t, err, tc.err)
+				}
+			},
+		)
+	}
+}
+
+func TestMsgSetOracleSymbolValidateBasic(t *testing.T) {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	tcs := []struct {
+		name string
+		q    sdk.Msg
+		err  string
+	}{
+		{"no authority", types.NewMsgSetOracleSymbol("", "Title", "Description", "uumee", "UMEE"), "expected gov account"},
+		{"invalid denom", types.NewMsgSetOracleSymbol(govAddr, "Title", "Description", "", "UMEE"), "invalid denom"},
+		{"uToken denom", types.NewMsgSetOracleSymbol(govAddr, "Title", "Description", "u/uumee", "UMEE"), "uToken"},
+		{"invalid symbol", types.NewMsgSetOracleSymbol(govAddr, "Title", "Description", "uumee", ""), "invalid denom"},
+		{"uToken symbol", types.NewMsgSetOracleSymbol(govAddr, "Title", "Description", "uumee", "u/UMEE"), "uToken"},
+		{"valid", types.NewMsgSetOracleSymbol(govAddr, "Title", "Description", "uumee", "UMEEX"), ""},
+	}
+
+	for _, tc := range tcs {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				err := tc.q.ValidateBasic()
+				if tc.err == "" {
+					assert.NilError(t, err)
+				} else {
+					assert.ErrorContains(t, err, tc.err)
+				}
+			},
+		)
+	}
+}
+
+func TestMsgSetAccountBorrowCapValidateBasic(t *testing.T) {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	addr := "umee1s84d29zk3k20xk9f0hvczkax90l9t94g72n6wm"
+	borrowCap := sdk.NewDec(1000)
+
+	tcs := []struct {
+		name string
+		q    sdk.Msg
+		err  string
+	}{
+		{"no authority", types.NewMsgSetAccountBorrowCap("", "Title", "Description", addr, borrowCap),
+			"expected gov account"},
+		{"invalid address", types.NewMsgSetAccountBorrowCap(govAddr, "Title", "Description", "xyz", borrowCap),
+			"invalid account address"},
+		{"nil cap", types.NewMsgSetAccountBorrowCap(govAddr, "Title", "Description", addr, sdk.Dec{}),
+			"must not be negative"},
+		{"negative cap", types.NewMsgSetAccountBorrowCap(govAddr, "Title", "Description", addr, sdk.NewDec(-1)),
+			"must not be negative"},
+		{"valid", types.NewMsgSetAccountBorrowCap(govAddr, "Title", "Description", addr, borrowCap), ""},
+		{"valid clear", types.NewMsgSetAccountBorrowCap(govAddr, "Title", "Description", addr, sdk.ZeroDec()), ""},
+	}
+
+	for _, tc := range tcs {
+		t.Run(
+			tc.name, func(t *testing.T) {
+				err := tc.q.ValidateBasic()
+				if tc.err == "" {
+					assert.NilError(t, err)
+				} else {
+					assert.ErrorContains(t, err, tc.err)
+				}
+			},
+		)
+	}
+}
+
 func TestMsgGovUpdateRegistryOtherFunctionality(t *testing.T) {
 	umee := types.Token{
 		BaseDenom:              "uumee",
@@ -137,6 +290,8 @@ func TestMsgGovUpdateRegistryOtherFunctionality(t *testing.T) {
 		MinCollateralLiquidity: sdk.MustNewDecFromStr("0"),
 		MaxSupply:              sdk.NewInt(100_000_000000),
 		HistoricMedians:        24,
+		WithdrawFee:            sdk.ZeroDec(),
+		LiquidityHaircut:       sdk.ZeroDec(),
 	}
 	msg := types.NewMsgUpdateRegistry(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(), "title", "description",
@@ -167,6 +322,9 @@ updatetokens:
       min_collateral_liquidity: "0.000000000000000000"
       max_supply: "100000000000"
       historic_medians: 24
+      no_self_borrow: false
+      withdraw_fee: "0.000000000000000000"
+      liquidity_haircut: "0.000000000000000000"
 `
 	assert.Equal(t, expResult, msg.String())
 	tassert.NotNil(t, msg.GetSignBytes(), "sign byte shouldn't be nil")
@@ -16,6 +16,20 @@ var (
 	KeyOracleRewardFactor           = []byte("OracleRewardFactor")
 	KeySmallLiquidationSize         = []byte("SmallLiquidationSize")
 	KeyDirectLiquidationFee         = []byte("DirectLiquidationFee")
+	KeyZeroPricePolicy              = []byte("ZeroPricePolicy")
+	KeyBorrowCooldownBlocks         = []byte("BorrowCooldownBlocks")
+	KeyMaxWithdrawRatePerBlock      = []byte("MaxWithdrawRatePerBlock")
+	KeyBorrowPaused                 = []byte("BorrowPaused")
+	KeySupplyPaused                 = []byte("SupplyPaused")
+	KeyLiquidationDustThreshold     = []byte("LiquidationDustThreshold")
+	KeyCompoundingMode              = []byte("CompoundingMode")
+	KeyProtocolLiquidationShare     = []byte("ProtocolLiquidationShare")
+	KeyMaxAccountLeverage           = []byte("MaxAccountLeverage")
+	KeyUtilizationSmoothingFactor   = []byte("UtilizationSmoothingFactor")
+	KeyCircuitBreakerDeviation      = []byte("CircuitBreakerDeviation")
+	KeyCircuitBreakerCooldownBlocks = []byte("CircuitBreakerCooldownBlocks")
+	KeyLiquidationGracePeriod       = []byte("LiquidationGracePeriod")
+	KeyBadDebtHistorySize           = []byte("BadDebtHistorySize")
 )
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value
@@ -47,6 +61,76 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 			&p.DirectLiquidationFee,
 			validateDirectLiquidationFee,
 		),
+		paramtypes.NewParamSetPair(
+			KeyZeroPricePolicy,
+			&p.ZeroPricePolicy,
+			validateZeroPricePolicy,
+		),
+		paramtypes.NewParamSetPair(
+			KeyBorrowCooldownBlocks,
+			&p.BorrowCooldownBlocks,
+			validateBorrowCooldownBlocks,
+		),
+		paramtypes.NewParamSetPair(
+			KeyMaxWithdrawRatePerBlock,
+			&p.MaxWithdrawRatePerBlock,
+			validateMaxWithdrawRatePerBlock,
+		),
+		paramtypes.NewParamSetPair(
+			KeyBorrowPaused,
+			&p.BorrowPaused,
+			validatePaused,
+		),
+		paramtypes.NewParamSetPair(
+			KeySupplyPaused,
+			&p.SupplyPaused,
+			validatePaused,
+		),
+		paramtypes.NewParamSetPair(
+			KeyLiquidationDustThreshold,
+			&p.LiquidationDustThreshold,
+			validateLiquidationDustThreshold,
+		),
+		paramtypes.NewParamSetPair(
+			KeyCompoundingMode,
+			&p.CompoundingMode,
+			validateCompoundingMode,
+		),
+		paramtypes.NewParamSetPair(
+			KeyProtocolLiquidationShare,
+			&p.ProtocolLiquidationShare,
+			validateProtocolLiquidationShare,
+		),
+		paramtypes.NewParamSetPair(
+			KeyMaxAccountLeverage,
+			&p.MaxAccountLeverage,
+			validateMaxAccountLeverage,
+		),
+		paramtypes.NewParamSetPair(
+			KeyUtilizationSmoothingFactor,
+			&p.UtilizationSmoothingFactor,
+			validateUtilizationSmoothingFactor,
+		),
+		paramtypes.NewParamSetPair(
+			KeyCircuitBreakerDeviation,
+			&p.CircuitBreakerDeviation,
+			validateCircuitBreakerDeviation,
+		),
+		paramtypes.NewParamSetPair(
+			KeyCircuitBreakerCooldownBlocks,
+			&p.CircuitBreakerCooldownBlocks,
+			validateCircuitBreakerCooldownBlocks,
+		),
+		paramtypes.NewParamSetPair(
+			KeyLiquidationGracePeriod,
+			&p.LiquidationGracePeriod,
+			validateLiquidationGracePeriod,
+		),
+		paramtypes.NewParamSetPair(
+			KeyBadDebtHistorySize,
+			&p.BadDebtHistorySize,
+			validateBadDebtHistorySize,
+		),
 	}
 }
 
@@ -70,6 +154,35 @@ func DefaultParams() Params {
 		OracleRewardFactor:           sdk.MustNewDecFromStr("0.01"),
 		SmallLiquidationSize:         sdk.MustNewDecFromStr("500.00"),
 		DirectLiquidationFee:         sdk.MustNewDecFromStr("0.05"),
+		ZeroPricePolicy:              ZeroPricePolicy_ZERO_PRICE_POLICY_EXCLUDE,
+		BorrowCooldownBlocks:         0,
+		MaxWithdrawRatePerBlock:      sdk.ZeroDec(),
+		BorrowPaused:                 false,
+		SupplyPaused:                 false,
+		LiquidationDustThreshold:     sdk.ZeroDec(),
+		CompoundingMode:              CompoundingMode_COMPOUNDING_MODE_CONTINUOUS,
+		ProtocolLiquidationShare:     sdk.ZeroDec(),
+		MaxAccountLeverage:           sdk.ZeroDec(),
+		UtilizationSmoothingFactor:   sdk.OneDec(),
+		CircuitBreakerDeviation:      sdk.ZeroDec(),
+		CircuitBreakerCooldownBlocks: 0,
+		LiquidationGracePeriod:       0,
+		BadDebtHistorySize:           1000,
+	}
+}
+
+// FeatureFlags returns the enabled state of each optional feature controlled by params, in a fixed order.
+// Features which are disabled by a zero value are enabled when that param is positive.
+func (p Params) FeatureFlags() []FeatureFlag {
+	return []FeatureFlag{
+		{Name: "borrow_paused", Enabled: p.BorrowPaused},
+		{Name: "supply_paused", Enabled: p.SupplyPaused},
+		{Name: "borrow_cooldown", Enabled: p.BorrowCooldownBlocks > 0},
+		{Name: "max_withdraw_rate", Enabled: p.MaxWithdrawRatePerBlock.IsPositive()},
+		{Name: "liquidation_dust_repay", Enabled: p.LiquidationDustThreshold.IsPositive()},
+		{Name: "annual_compounding", Enabled: p.CompoundingMode == CompoundingMode_COMPOUNDING_MODE_ANNUAL},
+		{Name: "price_circuit_breaker", Enabled: p.CircuitBreakerDeviation.IsPositive()},
+		{Name: "liquidation_grace_period", Enabled: p.LiquidationGracePeriod > 0},
 	}
 }
 
@@ -87,7 +200,49 @@ func (p Params) Validate() error {
 	if err := validateSmallLiquidationSize(p.SmallLiquidationSize); err != nil {
 		return err
 	}
-	return validateDirectLiquidationFee(p.DirectLiquidationFee)
+	if err := validateDirectLiquidationFee(p.DirectLiquidationFee); err != nil {
+		return err
+	}
+	if err := validateZeroPricePolicy(p.ZeroPricePolicy); err != nil {
+		return err
+	}
+	if err := validateBorrowCooldownBlocks(p.BorrowCooldownBlocks); err != nil {
+		return err
+	}
+	if err := validateMaxWithdrawRatePerBlock(p.MaxWithdrawRatePerBlock); err != nil {
+		return err
+	}
+	if err := validatePaused(p.BorrowPaused); err != nil {
+		return err
+	}
+	if err := validatePaused(p.SupplyPaused); err != nil {
+		return err
+	}
+	if err := validateLiquidationDustThreshold(p.LiquidationDustThreshold); err != nil {
+		return err
+	}
+	if err := validateCompoundingMode(p.CompoundingMode); err != nil {
+		return err
+	}
+	if err := validateProtocolLiquidationShare(p.ProtocolLiquidationShare); err != nil {
+		return err
+	}
+	if err := validateMaxAccountLeverage(p.MaxAccountLeverage); err != nil {
+		return err
+	}
+	if err := validateUtilizationSmoothingFactor(p.UtilizationSmoothingFactor); err != nil {
+		return err
+	}
+	if err := validateCircuitBreakerDeviation(p.CircuitBreakerDeviation); err != nil {
+		return err
+	}
+	if err := validateCircuitBreakerCooldownBlocks(p.CircuitBreakerCooldownBlocks); err != nil {
+		return err
+	}
+	if err := validateLiquidationGracePeriod(p.LiquidationGracePeriod); err != nil {
+		return err
+	}
+	return validateBadDebtHistorySize(p.BadDebtHistorySize)
 }
 
 func validateLiquidationThreshold(i interface{}) error {
@@ -167,3 +322,159 @@ func validateDirectLiquidationFee(i interface{}) error {
 
 	return nil
 }
+
+func validateZeroPricePolicy(i interface{}) error {
+	v, ok := i.(ZeroPricePolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := ZeroPricePolicy_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid zero price policy: %d", v)
+	}
+
+	return nil
+}
+
+func validateBorrowCooldownBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxWithdrawRatePerBlock(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("max withdraw rate per block cannot be negative: %d", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("max withdraw rate per block cannot exceed 1: %d", v)
+	}
+
+	return nil
+}
+
+func validatePaused(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateLiquidationDustThreshold(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("liquidation dust threshold cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateCompoundingMode(i interface{}) error {
+	v, ok := i.(CompoundingMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := CompoundingMode_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid compounding mode: %d", v)
+	}
+
+	return nil
+}
+
+func validateProtocolLiquidationShare(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("protocol liquidation share cannot be negative: %d", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("protocol liquidation share cannot exceed 1: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxAccountLeverage(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("max account leverage cannot be negative: %d", v)
+	}
+	if v.IsPositive() && v.LT(sdk.OneDec()) {
+		return fmt.Errorf("max account leverage must be zero or at least 1: %d", v)
+	}
+
+	return nil
+}
+
+func validateUtilizationSmoothingFactor(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !v.IsPositive() {
+		return fmt.Errorf("utilization smoothing factor must be positive: %d", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("utilization smoothing factor cannot exceed 1: %d", v)
+	}
+
+	return nil
+}
+
+func validateCircuitBreakerDeviation(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("circuit breaker deviation cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateCircuitBreakerCooldownBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateLiquidationGracePeriod(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateBadDebtHistorySize(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -100,6 +100,106 @@ func TestParams_Validate(t *testing.T) {
 			},
 			"direct liquidation fee must be less than 1",
 		},
+		{
+			"invalid zero price policy",
+			Params{
+				CompleteLiquidationThreshold: sdk.MustNewDecFromStr("0.4"),
+				MinimumCloseFactor:           sdk.MustNewDecFromStr("0.05"),
+				OracleRewardFactor:           sdk.MustNewDecFromStr("0.01"),
+				SmallLiquidationSize:         sdk.MustNewDecFromStr("500.00"),
+				DirectLiquidationFee:         sdk.MustNewDecFromStr("0.05"),
+				ZeroPricePolicy:              ZeroPricePolicy(3),
+			},
+			"invalid zero price policy",
+		},
+		{
+			"invalid compounding mode",
+			Params{
+				CompleteLiquidationThreshold: sdk.MustNewDecFromStr("0.4"),
+				MinimumCloseFactor:           sdk.MustNewDecFromStr("0.05"),
+				OracleRewardFactor:           sdk.MustNewDecFromStr("0.01"),
+				SmallLiquidationSize:         sdk.MustNewDecFromStr("500.00"),
+				DirectLiquidationFee:         sdk.MustNewDecFromStr("0.05"),
+				MaxWithdrawRatePerBlock:      sdk.ZeroDec(),
+				LiquidationDustThreshold:     sdk.ZeroDec(),
+				CompoundingMode:              CompoundingMode(2),
+			},
+			"invalid compounding mode",
+		},
+		{
+			"exceeded max withdraw rate per block",
+			Params{
+				CompleteLiquidationThreshold: sdk.MustNewDecFromStr("0.4"),
+				MinimumCloseFactor:           sdk.MustNewDecFromStr("0.05"),
+				OracleRewardFactor:           sdk.MustNewDecFromStr("0.01"),
+				SmallLiquidationSize:         sdk.MustNewDecFromStr("500.00"),
+				DirectLiquidationFee:         sdk.MustNewDecFromStr("0.05"),
+				MaxWithdrawRatePerBlock:      exceededDec,
+			},
+			"max withdraw rate per block cannot exceed 1",
+		},
+		{
+			"exceeded protocol liquidation share",
+			Params{
+				CompleteLiquidationThreshold: sdk.MustNewDecFromStr("0.4"),
+				MinimumCloseFactor:           sdk.MustNewDecFromStr("0.05"),
+				OracleRewardFactor:           sdk.MustNewDecFromStr("0.01"),
+				SmallLiquidationSize:         sdk.MustNewDecFromStr("500.00"),
+				DirectLiquidationFee:         sdk.MustNewDecFromStr("0.05"),
+				MaxWithdrawRatePerBlock:      sdk.ZeroDec(),
+				LiquidationDustThreshold:     sdk.ZeroDec(),
+				ProtocolLiquidationShare:     exceededDec,
+			},
+			"protocol liquidation share cannot exceed 1",
+		},
+		{
+			"max account leverage below 1",
+			Params{
+				CompleteLiquidationThreshold: sdk.MustNewDecFromStr("0.4"),
+				MinimumCloseFactor:           sdk.MustNewDecFromStr("0.05"),
+				OracleRewardFactor:           sdk.MustNewDecFromStr("0.01"),
+				SmallLiquidationSize:         sdk.MustNewDecFromStr("500.00"),
+				DirectLiquidationFee:         sdk.MustNewDecFromStr("0.05"),
+				MaxWithdrawRatePerBlock:      sdk.ZeroDec(),
+				LiquidationDustThreshold:     sdk.ZeroDec(),
+				ProtocolLiquidationShare:     sdk.ZeroDec(),
+				MaxAccountLeverage:           sdk.MustNewDecFromStr("0.5"),
+			},
+			"max account leverage must be zero or at least 1",
+		},
+		{
+			"zero utilization smoothing factor",
+			Params{
+				CompleteLiquidationThreshold: sdk.MustNewDecFromStr("0.4"),
+				MinimumCloseFactor:           sdk.MustNewDecFromStr("0.05"),
+				OracleRewardFactor:           sdk.MustNewDecFromStr("0.01"),
+				SmallLiquidationSize:         sdk.MustNewDecFromStr("500.00"),
+				DirectLiquidationFee:         sdk.MustNewDecFromStr("0.05"),
+				MaxWithdrawRatePerBlock:      sdk.ZeroDec(),
+				LiquidationDustThreshold:     sdk.ZeroDec(),
+				ProtocolLiquidationShare:     sdk.ZeroDec(),
+				MaxAccountLeverage:           sdk.ZeroDec(),
+				UtilizationSmoothingFactor:   sdk.ZeroDec(),
+			},
+			"utilization smoothing factor must be positive",
+		},
+		{
+			"negative circuit breaker deviation",
+			Params{
+				CompleteLiquidationThreshold: sdk.MustNewDecFromStr("0.4"),
+				MinimumCloseFactor:           sdk.MustNewDecFromStr("0.05"),
+				OracleRewardFactor:           sdk.MustNewDecFromStr("0.01"),
+				SmallLiquidationSize:         sdk.MustNewDecFromStr("500.00"),
+				DirectLiquidationFee:         sdk.MustNewDecFromStr("0.05"),
+				MaxWithdrawRatePerBlock:      sdk.ZeroDec(),
+				LiquidationDustThreshold:     sdk.ZeroDec(),
+				ProtocolLiquidationShare:     sdk.ZeroDec(),
+				MaxAccountLeverage:           sdk.ZeroDec(),
+				UtilizationSmoothingFactor:   sdk.OneDec(),
+				CircuitBreakerDeviation:      sdk.MustNewDecFromStr("-0.1"),
+			},
+			"circuit breaker deviation cannot be negative",
+		},
 	}
 
 	for _, tc := range tcs {
@@ -129,6 +229,32 @@ func TestParams_IfaceValidations(t *testing.T) {
 	assert.ErrorContains(t, err, expErr)
 	err = validateDirectLiquidationFee(invalidIface)
 	assert.ErrorContains(t, err, expErr)
+	err = validateZeroPricePolicy(invalidIface)
+	assert.ErrorContains(t, err, expErr)
+	err = validateBorrowCooldownBlocks(invalidIface)
+	assert.ErrorContains(t, err, expErr)
+	err = validateMaxWithdrawRatePerBlock(invalidIface)
+	assert.ErrorContains(t, err, expErr)
+	err = validatePaused(invalidIface)
+	assert.ErrorContains(t, err, expErr)
+	err = validateLiquidationDustThreshold(invalidIface)
+	assert.ErrorContains(t, err, expErr)
+	err = validateCompoundingMode(invalidIface)
+	assert.ErrorContains(t, err, expErr)
+	err = validateProtocolLiquidationShare(invalidIface)
+	assert.ErrorContains(t, err, expErr)
+	err = validateMaxAccountLeverage(invalidIface)
+	assert.ErrorContains(t, err, expErr)
+	err = validateUtilizationSmoothingFactor(invalidIface)
+	assert.ErrorContains(t, err, expErr)
+	err = validateCircuitBreakerDeviation(invalidIface)
+	assert.ErrorContains(t, err, expErr)
+	err = validateCircuitBreakerCooldownBlocks(invalidIface)
+	assert.ErrorContains(t, err, expErr)
+	err = validateLiquidationGracePeriod(invalidIface)
+	assert.ErrorContains(t, err, expErr)
+	err = validateBadDebtHistorySize(invalidIface)
+	assert.ErrorContains(t, err, expErr)
 }
 
 func TestParams_Additional(t *testing.T) {
@@ -138,9 +264,23 @@ minimum_close_factor: "0.050000000000000000"
 oracle_reward_factor: "0.010000000000000000"
 small_liquidation_size: "500.000000000000000000"
 direct_liquidation_fee: "0.050000000000000000"
+zero_price_policy: 0
+borrow_cooldown_blocks: 0
+max_withdraw_rate_per_block: "0.000000000000000000"
+borrow_paused: false
+supply_paused: false
+liquidation_dust_threshold: "0.000000000000000000"
+compounding_mode: 0
+protocol_liquidation_share: "0.000000000000000000"
+max_account_leverage: "0.000000000000000000"
+utilization_smoothing_factor: "1.000000000000000000"
+circuit_breaker_deviation: "0.000000000000000000"
+circuit_breaker_cooldown_blocks: 0
+liquidation_grace_period: 0
+bad_debt_history_size: 1000
 `
 	assert.Equal(t, expResult, params.String())
 
 	paramSetPairs := params.ParamSetPairs()
-	assert.Equal(t, 5, len(paramSetPairs))
+	assert.Equal(t, 19, len(paramSetPairs))
 }
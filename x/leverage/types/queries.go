@@ -1,10 +1,77 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// InfiniteLeverageRatio is the leverage ratio returned by the AccountEquity query for
+// accounts whose equity is zero or negative.
+var InfiniteLeverageRatio = sdk.MaxSortableDec
+
+// MaxBorrowUtilization is the borrow utilization returned by the BorrowUtilization query for
+// accounts which are over their borrow limit.
+var MaxBorrowUtilization = sdk.OneDec()
+
+// NoBorrowReserveCoverage is the coverage returned by the ReserveCoverage query for
+// tokens with nothing borrowed.
+var NoBorrowReserveCoverage = sdk.NewDec(-1)
+
+// MaxTopBorrowers is the largest number of borrowers returned by the TopBorrowers query.
+const MaxTopBorrowers = 100
+
+// MaxTopSuppliers is the largest number of suppliers returned by the TopSuppliers query.
+const MaxTopSuppliers = 100
+
+// MaxAccountSummaries is the largest number of addresses accepted by the AccountSummaries query.
+const MaxAccountSummaries = 100
+
+// UnknownHealthFactor is the health factor returned by the TopBorrowers query for borrowers
+// whose liquidation threshold cannot be computed.
+var UnknownHealthFactor = sdk.NewDec(-1)
+
+// MaxHealthBounds is the largest number of bucket bounds accepted by the HealthDistribution query.
+const MaxHealthBounds = 20
+
+// DefaultHealthBounds returns the bucket bounds used by the HealthDistribution query when none are given.
+func DefaultHealthBounds() []sdk.Dec {
+	return []sdk.Dec{sdk.OneDec(), sdk.MustNewDecFromStr("1.1"), sdk.MustNewDecFromStr("1.5")}
+}
+
+// Reasons returned by the CanWithdraw query when a withdrawal is not allowed.
+const (
+	WithdrawReasonInsufficientBalance    = "insufficient_balance"
+	WithdrawReasonBondedCollateral       = "bonded_collateral"
+	WithdrawReasonInsufficientLiquidity  = "insufficient_liquidity"
+	WithdrawReasonMaxWithdrawRate        = "max_withdraw_rate"
+	WithdrawReasonOverBorrowLimit        = "over_borrow_limit"
+	WithdrawReasonMinCollateralLiquidity = "min_collateral_liquidity"
+	WithdrawReasonAccountFrozen          = "account_frozen"
+	WithdrawReasonOther                  = "other"
+)
+
+// Matches returns true if a token passes all of the capability and collateral weight filters set in a
+// RegisteredTokens query. Collateral weight bounds are inclusive.
+func (q QueryRegisteredTokens) Matches(t Token) bool {
+	if q.BorrowEnabled && !t.EnableMsgBorrow {
+		return false
+	}
+	if q.CollateralEnabled && !t.CollateralWeight.IsPositive() {
+		return false
+	}
+	if q.SupplyEnabled && !t.EnableMsgSupply {
+		return false
+	}
+	if q.MinCollateralWeight != nil && t.CollateralWeight.LT(*q.MinCollateralWeight) {
+		return false
+	}
+	if q.MaxCollateralWeight != nil && t.CollateralWeight.GT(*q.MaxCollateralWeight) {
+		return false
+	}
+	return true
+}
+
 func (q QueryMaxWithdraw) ValidateBasic() error {
 	if q.Address == "" {
 		return status.Error(codes.InvalidArgument, "empty address")
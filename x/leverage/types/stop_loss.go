@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/v5/util/checkers"
+)
+
+// NewStopLoss creates the StopLoss struct used in GenesisState
+func NewStopLoss(borrower, executor string, target sdk.Dec) StopLoss {
+	return StopLoss{
+		Borrower:           borrower,
+		Executor:           executor,
+		TargetHealthFactor: target,
+	}
+}
+
+// Validate performs validation on a StopLoss type returning an error if the
+// borrower or executor address is invalid, if they are the same address,
+// or if the target health factor is not greater than one.
+func (sl StopLoss) Validate() error {
+	if err := checkers.ValidateAddr(sl.Borrower, "borrower"); err != nil {
+		return err
+	}
+	if err := checkers.ValidateAddr(sl.Executor, "executor"); err != nil {
+		return err
+	}
+	if sl.Borrower == sl.Executor {
+		return fmt.Errorf("stop-loss executor cannot be the borrower: %s", sl.Executor)
+	}
+	if sl.TargetHealthFactor.IsNil() || sl.TargetHealthFactor.LTE(sdk.OneDec()) {
+		return fmt.Errorf("stop-loss target health factor must be greater than one: %s", sl.TargetHealthFactor)
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -40,6 +41,42 @@ func ValidateBaseDenom(denom string) error {
 	return nil
 }
 
+// ValidateUTokenDenoms returns an error if the uToken denom of any proposed token equals the base or uToken
+// denom of any other existing or proposed token, or if a proposed base denom equals any such uToken denom.
+// Existing tokens which share a base denom with a proposed token are treated as being replaced by it.
+func ValidateUTokenDenoms(existing, proposed []Token) error {
+	// owners maps each base and uToken denom to a description of the token denom it belongs to
+	owners := map[string]string{}
+	add := func(t Token) {
+		owners[t.BaseDenom] = "base denom of " + t.BaseDenom
+		if uDenom := ToUTokenDenom(t.BaseDenom); uDenom != "" {
+			owners[uDenom] = "uToken denom of " + t.BaseDenom
+		}
+	}
+
+	replaced := map[string]bool{}
+	for _, t := range proposed {
+		replaced[t.BaseDenom] = true
+	}
+	for _, t := range existing {
+		if !replaced[t.BaseDenom] {
+			add(t)
+		}
+	}
+
+	for _, t := range proposed {
+		uDenom := ToUTokenDenom(t.BaseDenom)
+		if owner, ok := owners[uDenom]; ok && uDenom != "" {
+			return ErrDenomCollision.Wrapf("uToken denom %s of %s equals the %s", uDenom, t.BaseDenom, owner)
+		}
+		if owner, ok := owners[t.BaseDenom]; ok {
+			return ErrDenomCollision.Wrapf("base denom %s equals the %s", t.BaseDenom, owner)
+		}
+		add(t)
+	}
+	return nil
+}
+
 // ToTokenDenom strips the uToken prefix from a denom, or returns an empty
 // string if it was not present. Also returns an empty string if the prefix
 // was repeated multiple times.
@@ -140,6 +177,14 @@ func (t Token) Validate() error {
 		return sdkerrors.ErrInvalidRequest.Wrap("Token.MaxSupply must not be negative")
 	}
 
+	if t.WithdrawFee.IsNegative() || t.WithdrawFee.GTE(one) {
+		return sdkerrors.ErrInvalidRequest.Wrap("Token.WithdrawFee must be at least 0 and less than 1")
+	}
+
+	if t.LiquidityHaircut.IsNegative() || t.LiquidityHaircut.GT(one) {
+		return sdkerrors.ErrInvalidRequest.Wrap("Token.LiquidityHaircut must be between 0 and 1")
+	}
+
 	return nil
 }
 
@@ -167,6 +212,21 @@ func (t Token) AssertNotBlacklisted() error {
 	return nil
 }
 
+// DisplayToBaseAmount converts an amount of the Token's display denom (e.g. UMEE) into an amount of its
+// base denom (e.g. uumee) using the Token's exponent. It returns an error if the amount is negative, or
+// if it has more decimal places than the exponent, so amounts are never silently rounded.
+func (t Token) DisplayToBaseAmount(amount sdk.Dec) (sdkmath.Int, error) {
+	if amount.IsNegative() {
+		return sdkmath.Int{}, fmt.Errorf("negative amount: %s", amount)
+	}
+	base := amount.MulInt(sdkmath.NewIntWithDecimal(1, int(t.Exponent)))
+	if !base.IsInteger() {
+		return sdkmath.Int{}, fmt.Errorf("amount %s of %s has more than %d decimal places", amount, t.SymbolDenom,
+			t.Exponent)
+	}
+	return base.TruncateInt(), nil
+}
+
 func defaultUmeeToken() Token {
 	return Token{
 		BaseDenom:       appparams.BondDenom,
@@ -192,6 +252,9 @@ func defaultUmeeToken() Token {
 		MaxSupplyUtilization:   sdk.MustNewDecFromStr("0.90"),
 		MinCollateralLiquidity: sdk.MustNewDecFromStr("0.3"),
 		MaxSupply:              sdk.NewInt(1000_000000_000000),
+		// Fees
+		WithdrawFee:      sdk.ZeroDec(),
+		LiquidityHaircut: sdk.ZeroDec(),
 	}
 }
 
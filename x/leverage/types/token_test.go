@@ -39,6 +39,53 @@ func TestToUTokenDenom(t *testing.T) {
 	assert.Equal(t, "u/", types.ToUTokenDenom(""))
 }
 
+func TestValidateUTokenDenoms(t *testing.T) {
+	token := func(denom string) types.Token {
+		return types.Token{BaseDenom: denom}
+	}
+	existing := []types.Token{token("uumee"), token("u/uatom")}
+
+	tcs := []struct {
+		name     string
+		existing []types.Token
+		proposed []types.Token
+		err      string
+	}{
+		{"no collision", existing, []types.Token{token("uosmo")}, ""},
+		{"update of existing token", existing, []types.Token{token("uumee")}, ""},
+		{
+			"uToken equals existing base", existing, []types.Token{token("uatom")},
+			"uToken denom u/uatom of uatom equals the base denom of u/uatom",
+		},
+		{
+			"uToken equals proposed base", nil, []types.Token{token("u/uosmo"), token("uosmo")},
+			"uToken denom u/uosmo of uosmo equals the base denom of u/uosmo",
+		},
+		{
+			"uToken equals proposed uToken", nil, []types.Token{token("uosmo"), token("uosmo")},
+			"uToken denom u/uosmo of uosmo equals the uToken denom of uosmo",
+		},
+		{
+			"base equals existing uToken", existing, []types.Token{token("u/uumee")},
+			"base denom u/uumee equals the uToken denom of uumee",
+		},
+		{
+			"base equals proposed uToken", nil, []types.Token{token("uosmo"), token("u/uosmo")},
+			"base denom u/uosmo equals the uToken denom of uosmo",
+		},
+	}
+
+	for _, tc := range tcs {
+		err := types.ValidateUTokenDenoms(tc.existing, tc.proposed)
+		if tc.err == "" {
+			assert.NilError(t, err, tc.name)
+		} else {
+			assert.ErrorIs(t, err, types.ErrDenomCollision, tc.name)
+			assert.ErrorContains(t, err, tc.err, tc.name)
+		}
+	}
+}
+
 func validToken() types.Token {
 	return types.Token{
 		BaseDenom:              "uumee",
@@ -60,6 +107,8 @@ func validToken() types.Token {
 		MinCollateralLiquidity: sdk.MustNewDecFromStr("1"),
 		MaxSupply:              sdk.NewInt(1000),
 		HistoricMedians:        24,
+		WithdrawFee:            sdk.ZeroDec(),
+		LiquidityHaircut:       sdk.ZeroDec(),
 	}
 }
 
@@ -95,6 +144,9 @@ addtokens:
       min_collateral_liquidity: "1.000000000000000000"
       max_supply: "1000"
       historic_medians: 24
+      no_self_borrow: false
+      withdraw_fee: "0.000000000000000000"
+      liquidity_haircut: "0.000000000000000000"
 updatetokens: []
 `
 	assert.Equal(t, expected, p.String())
@@ -260,3 +312,48 @@ func TestToken_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestDisplayToBaseAmount(t *testing.T) {
+	token := types.Token{BaseDenom: "uumee", SymbolDenom: "UMEE", Exponent: 6}
+
+	tcs := []struct {
+		amount string
+		expect int64
+		errMsg string
+	}{
+		{"0", 0, ""},
+		{"1", 1_000000, ""},
+		{"1.5", 1_500000, ""},
+		// smallest base unit, exactly at the exponent boundary
+		{"0.000001", 1, ""},
+		{"123.456789", 123_456789, ""},
+		// one decimal place beyond the exponent cannot be represented in base units
+		{"0.0000001", 0, "more than 6 decimal places"},
+		{"1.0000015", 0, "more than 6 decimal places"},
+		{"-1", 0, "negative amount"},
+	}
+
+	for _, tc := range tcs {
+		amount, err := token.DisplayToBaseAmount(sdk.MustNewDecFromStr(tc.amount))
+		if tc.errMsg != "" {
+			assert.ErrorContains(t, err, tc.errMsg, tc.amount)
+		} else {
+			assert.NilError(t, err, tc.amount)
+			assert.Equal(t, sdk.NewInt(tc.expect).String(), amount.String(), tc.amount)
+		}
+	}
+
+	// zero exponent accepts only whole amounts
+	token.Exponent = 0
+	amount, err := token.DisplayToBaseAmount(sdk.MustNewDecFromStr("7"))
+	assert.NilError(t, err)
+	assert.Equal(t, "7", amount.String())
+	_, err = token.DisplayToBaseAmount(sdk.MustNewDecFromStr("7.5"))
+	assert.ErrorContains(t, err, "more than 0 decimal places")
+
+	// 18 decimal places is the smallest amount representable in display units
+	token.Exponent = 18
+	amount, err = token.DisplayToBaseAmount(sdk.MustNewDecFromStr("0.000000000000000001"))
+	assert.NilError(t, err)
+	assert.Equal(t, "1", amount.String())
+}
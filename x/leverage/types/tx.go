@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/umee-network/umee/v5/util/checkers"
@@ -37,10 +39,25 @@ func NewMsgWithdraw(supplier sdk.AccAddress, asset sdk.Coin) *MsgWithdraw {
 	}
 }
 
+// NewMsgWithdrawTo creates a MsgWithdraw which sends the withdrawn base tokens to a recipient
+// other than the supplier.
+func NewMsgWithdrawTo(supplier, recipient sdk.AccAddress, asset sdk.Coin) *MsgWithdraw {
+	return &MsgWithdraw{
+		Supplier:  supplier.String(),
+		Asset:     asset,
+		Recipient: recipient.String(),
+	}
+}
+
 func (msg MsgWithdraw) Route() string { return sdk.MsgTypeURL(&msg) }
 func (msg MsgWithdraw) Type() string  { return sdk.MsgTypeURL(&msg) }
 
 func (msg *MsgWithdraw) ValidateBasic() error {
+	if msg.Recipient != "" {
+		if err := checkers.ValidateAddr(msg.Recipient, "recipient"); err != nil {
+			return err
+		}
+	}
 	return validateSenderAndAsset(msg.Supplier, &msg.Asset)
 }
 
@@ -205,10 +222,25 @@ func NewMsgRepay(borrower sdk.AccAddress, asset sdk.Coin) *MsgRepay {
 	}
 }
 
+// NewMsgRepayFor creates a MsgRepay which uses the borrower's funds to repay the borrow
+// position of a recipient.
+func NewMsgRepayFor(borrower, recipient sdk.AccAddress, asset sdk.Coin) *MsgRepay {
+	return &MsgRepay{
+		Borrower:  borrower.String(),
+		Asset:     asset,
+		Recipient: recipient.String(),
+	}
+}
+
 func (msg MsgRepay) Route() string { return sdk.MsgTypeURL(&msg) }
 func (msg MsgRepay) Type() string  { return sdk.MsgTypeURL(&msg) }
 
 func (msg *MsgRepay) ValidateBasic() error {
+	if msg.Recipient != "" {
+		if err := checkers.ValidateAddr(msg.Recipient, "recipient"); err != nil {
+			return err
+		}
+	}
 	return validateSenderAndAsset(msg.Borrower, &msg.Asset)
 }
 
@@ -222,6 +254,214 @@ func (msg *MsgRepay) GetSignBytes() []byte {
 	return sdk.MustSortJSON(bz)
 }
 
+func NewMsgRepayWithdraw(borrower sdk.AccAddress, repay, withdraw sdk.Coin) *MsgRepayWithdraw {
+	return &MsgRepayWithdraw{
+		Borrower: borrower.String(),
+		Repay:    repay,
+		Withdraw: withdraw,
+	}
+}
+
+func (msg MsgRepayWithdraw) Route() string { return sdk.MsgTypeURL(&msg) }
+func (msg MsgRepayWithdraw) Type() string  { return sdk.MsgTypeURL(&msg) }
+
+func (msg *MsgRepayWithdraw) ValidateBasic() error {
+	if err := validateSenderAndAsset(msg.Borrower, &msg.Repay); err != nil {
+		return err
+	}
+	return validateSenderAndAsset(msg.Borrower, &msg.Withdraw)
+}
+
+func (msg *MsgRepayWithdraw) GetSigners() []sdk.AccAddress {
+	return checkers.Signers(msg.Borrower)
+}
+
+// GetSignBytes get the bytes for the message signer to sign on
+func (msg *MsgRepayWithdraw) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func NewMsgBorrowWithTopUp(borrower sdk.AccAddress, asset, collateral sdk.Coin, target sdk.Dec,
+) *MsgBorrowWithTopUp {
+	return &MsgBorrowWithTopUp{
+		Borrower:           borrower.String(),
+		Asset:              asset,
+		Collateral:         collateral,
+		TargetHealthFactor: target,
+	}
+}
+
+func (msg MsgBorrowWithTopUp) Route() string { return sdk.MsgTypeURL(&msg) }
+func (msg MsgBorrowWithTopUp) Type() string  { return sdk.MsgTypeURL(&msg) }
+
+func (msg *MsgBorrowWithTopUp) ValidateBasic() error {
+	if err := validateSenderAndAsset(msg.Borrower, &msg.Asset); err != nil {
+		return err
+	}
+	if err := validateSenderAndAsset(msg.Borrower, &msg.Collateral); err != nil {
+		return err
+	}
+	if msg.TargetHealthFactor.IsNil() || !msg.TargetHealthFactor.IsPositive() {
+		return fmt.Errorf("target health factor must be positive: %s", msg.TargetHealthFactor)
+	}
+	return nil
+}
+
+func (msg *MsgBorrowWithTopUp) GetSigners() []sdk.AccAddress {
+	return checkers.Signers(msg.Borrower)
+}
+
+// GetSignBytes get the bytes for the message signer to sign on
+func (msg *MsgBorrowWithTopUp) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func NewMsgLeverageLoop(supplier sdk.AccAddress, asset sdk.Coin, target sdk.Dec) *MsgLeverageLoop {
+	return &MsgLeverageLoop{
+		Supplier:       supplier.String(),
+		Asset:          asset,
+		TargetLeverage: target,
+	}
+}
+
+func (msg MsgLeverageLoop) Route() string { return sdk.MsgTypeURL(&msg) }
+func (msg MsgLeverageLoop) Type() string  { return sdk.MsgTypeURL(&msg) }
+
+func (msg *MsgLeverageLoop) ValidateBasic() error {
+	if err := validateSenderAndAsset(msg.Supplier, &msg.Asset); err != nil {
+		return err
+	}
+	if !msg.Asset.IsPositive() {
+		return fmt.Errorf("asset must be positive: %s", msg.Asset)
+	}
+	if msg.TargetLeverage.IsNil() || msg.TargetLeverage.LT(sdk.OneDec()) {
+		return fmt.Errorf("target leverage must be at least 1: %s", msg.TargetLeverage)
+	}
+	return nil
+}
+
+func (msg *MsgLeverageLoop) GetSigners() []sdk.AccAddress {
+	return checkers.Signers(msg.Supplier)
+}
+
+// GetSignBytes get the bytes for the message signer to sign on
+func (msg *MsgLeverageLoop) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func NewMsgSwapCollateral(borrower sdk.AccAddress, withdraw, collateral sdk.Coin) *MsgSwapCollateral {
+	return &MsgSwapCollateral{
+		Borrower:   borrower.String(),
+		Withdraw:   withdraw,
+		Collateral: collateral,
+	}
+}
+
+func (msg MsgSwapCollateral) Route() string { return sdk.MsgTypeURL(&msg) }
+func (msg MsgSwapCollateral) Type() string  { return sdk.MsgTypeURL(&msg) }
+
+func (msg *MsgSwapCollateral) ValidateBasic() error {
+	if err := validateSenderAndAsset(msg.Borrower, &msg.Withdraw); err != nil {
+		return err
+	}
+	if err := validateSenderAndAsset(msg.Borrower, &msg.Collateral); err != nil {
+		return err
+	}
+	if !HasUTokenPrefix(msg.Withdraw.Denom) {
+		return ErrNotUToken.Wrap(msg.Withdraw.Denom)
+	}
+	if err := ValidateBaseDenom(msg.Collateral.Denom); err != nil {
+		return err
+	}
+	if ToTokenDenom(msg.Withdraw.Denom) == msg.Collateral.Denom {
+		return fmt.Errorf("cannot swap collateral to the same denom: %s", msg.Collateral.Denom)
+	}
+	if !msg.Withdraw.IsPositive() || !msg.Collateral.IsPositive() {
+		return fmt.Errorf("withdraw and collateral must be positive: %s, %s", msg.Withdraw, msg.Collateral)
+	}
+	return nil
+}
+
+func (msg *MsgSwapCollateral) GetSigners() []sdk.AccAddress {
+	return checkers.Signers(msg.Borrower)
+}
+
+// GetSignBytes get the bytes for the message signer to sign on
+func (msg *MsgSwapCollateral) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func NewMsgSetStopLoss(borrower, executor sdk.AccAddress, target sdk.Dec) *MsgSetStopLoss {
+	return &MsgSetStopLoss{
+		Borrower:           borrower.String(),
+		Executor:           executor.String(),
+		TargetHealthFactor: target,
+	}
+}
+
+func (msg MsgSetStopLoss) Route() string { return sdk.MsgTypeURL(&msg) }
+func (msg MsgSetStopLoss) Type() string  { return sdk.MsgTypeURL(&msg) }
+
+func (msg *MsgSetStopLoss) ValidateBasic() error {
+	if !msg.TargetHealthFactor.IsNil() && msg.TargetHealthFactor.IsZero() {
+		// removes the stop-loss
+		if msg.Executor != "" {
+			return fmt.Errorf("executor must be empty when removing a stop-loss: %s", msg.Executor)
+		}
+		return checkers.ValidateAddr(msg.Borrower, "borrower")
+	}
+	return NewStopLoss(msg.Borrower, msg.Executor, msg.TargetHealthFactor).Validate()
+}
+
+func (msg *MsgSetStopLoss) GetSigners() []sdk.AccAddress {
+	return checkers.Signers(msg.Borrower)
+}
+
+// GetSignBytes get the bytes for the message signer to sign on
+func (msg *MsgSetStopLoss) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func NewMsgExecuteStopLoss(executor, borrower sdk.AccAddress, withdraw, repay sdk.Coin) *MsgExecuteStopLoss {
+	return &MsgExecuteStopLoss{
+		Executor: executor.String(),
+		Borrower: borrower.String(),
+		Withdraw: withdraw,
+		Repay:    repay,
+	}
+}
+
+func (msg MsgExecuteStopLoss) Route() string { return sdk.MsgTypeURL(&msg) }
+func (msg MsgExecuteStopLoss) Type() string  { return sdk.MsgTypeURL(&msg) }
+
+func (msg *MsgExecuteStopLoss) ValidateBasic() error {
+	if err := validateSenderAndAsset(msg.Executor, &msg.Withdraw); err != nil {
+		return err
+	}
+	if err := validateSenderAndAsset(msg.Borrower, &msg.Repay); err != nil {
+		return err
+	}
+	if !HasUTokenPrefix(msg.Withdraw.Denom) {
+		return ErrNotUToken.Wrap(msg.Withdraw.Denom)
+	}
+	return ValidateBaseDenom(msg.Repay.Denom)
+}
+
+func (msg *MsgExecuteStopLoss) GetSigners() []sdk.AccAddress {
+	return checkers.Signers(msg.Executor)
+}
+
+// GetSignBytes get the bytes for the message signer to sign on
+func (msg *MsgExecuteStopLoss) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
 func NewMsgLiquidate(liquidator, borrower sdk.AccAddress, repayment sdk.Coin, rewardDenom string) *MsgLiquidate {
 	return &MsgLiquidate{
 		Liquidator:  liquidator.String(),
@@ -241,6 +481,14 @@ func (msg *MsgLiquidate) ValidateBasic() error {
 	if err := sdk.ValidateDenom(msg.RewardDenom); err != nil {
 		return err
 	}
+	if msg.GuardDenom != "" || msg.GuardPrice != nil {
+		if err := ValidateBaseDenom(msg.GuardDenom); err != nil {
+			return err
+		}
+		if msg.GuardPrice == nil || msg.GuardPrice.IsNil() || !msg.GuardPrice.IsPositive() {
+			return ErrInvalidGuardPrice.Wrapf("guard price of %s must be positive", msg.GuardDenom)
+		}
+	}
 	_, err := sdk.AccAddressFromBech32(msg.Liquidator)
 	return err
 }
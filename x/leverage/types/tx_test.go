@@ -18,6 +18,7 @@ const (
 
 var (
 	testAddr, _ = sdk.AccAddressFromBech32("umee1s84d29zk3k20xk9f0hvczkax90l9t94g72n6wm")
+	otherAddr   = sdk.AccAddress([]byte("otherAddr___________"))
 	uToken      = sdk.NewInt64Coin(uDenom, 10)
 	token       = sdk.NewInt64Coin(denom, 10)
 )
@@ -26,6 +27,7 @@ func TestTxs(t *testing.T) {
 	txs := []sdk.Msg{
 		types.NewMsgSupply(testAddr, token),
 		types.NewMsgWithdraw(testAddr, uToken),
+		types.NewMsgWithdrawTo(testAddr, testAddr, uToken),
 		types.NewMsgMaxWithdraw(testAddr, denom),
 		types.NewMsgCollateralize(testAddr, uToken),
 		types.NewMsgSupplyCollateral(testAddr, token),
@@ -33,7 +35,15 @@ func TestTxs(t *testing.T) {
 		types.NewMsgBorrow(testAddr, token),
 		types.NewMsgMaxBorrow(testAddr, denom),
 		types.NewMsgRepay(testAddr, token),
+		types.NewMsgRepayFor(testAddr, testAddr, token),
 		types.NewMsgLiquidate(testAddr, testAddr, token, uDenom),
+		types.NewMsgRepayWithdraw(testAddr, token, uToken),
+		types.NewMsgBorrowWithTopUp(testAddr, token, token, sdk.MustNewDecFromStr("1.5")),
+		types.NewMsgSetStopLoss(testAddr, otherAddr, sdk.MustNewDecFromStr("1.5")),
+		types.NewMsgSetStopLoss(testAddr, nil, sdk.ZeroDec()),
+		types.NewMsgExecuteStopLoss(testAddr, otherAddr, uToken, token),
+		types.NewMsgLeverageLoop(testAddr, token, sdk.MustNewDecFromStr("1.5")),
+		types.NewMsgSwapCollateral(testAddr, uToken, sdk.NewInt64Coin("uatom", 10)),
 	}
 
 	for _, tx := range txs {
@@ -56,6 +66,7 @@ func TestRoutes(t *testing.T) {
 	txs := []sdkmsg{
 		types.NewMsgSupply(testAddr, token),
 		types.NewMsgWithdraw(testAddr, uToken),
+		types.NewMsgWithdrawTo(testAddr, testAddr, uToken),
 		types.NewMsgMaxWithdraw(testAddr, denom),
 		types.NewMsgCollateralize(testAddr, uToken),
 		types.NewMsgSupplyCollateral(testAddr, token),
@@ -63,7 +74,15 @@ func TestRoutes(t *testing.T) {
 		types.NewMsgBorrow(testAddr, token),
 		types.NewMsgMaxBorrow(testAddr, denom),
 		types.NewMsgRepay(testAddr, token),
+		types.NewMsgRepayFor(testAddr, testAddr, token),
 		types.NewMsgLiquidate(testAddr, testAddr, token, uDenom),
+		types.NewMsgRepayWithdraw(testAddr, token, uToken),
+		types.NewMsgBorrowWithTopUp(testAddr, token, token, sdk.MustNewDecFromStr("1.5")),
+		types.NewMsgSetStopLoss(testAddr, otherAddr, sdk.MustNewDecFromStr("1.5")),
+		types.NewMsgSetStopLoss(testAddr, nil, sdk.ZeroDec()),
+		types.NewMsgExecuteStopLoss(testAddr, otherAddr, uToken, token),
+		types.NewMsgLeverageLoop(testAddr, token, sdk.MustNewDecFromStr("1.5")),
+		types.NewMsgSwapCollateral(testAddr, uToken, sdk.NewInt64Coin("uatom", 10)),
 	}
 
 	for _, tx := range txs {
@@ -89,3 +108,26 @@ func TestRoutes(t *testing.T) {
 func addV1ToType(s string) string {
 	return strings.Replace(s, "*types", "leverage.v1", 1)
 }
+
+func TestMsgLiquidateGuardPrice(t *testing.T) {
+	price := sdk.MustNewDecFromStr("1.5")
+	zero := sdk.ZeroDec()
+
+	msg := types.NewMsgLiquidate(testAddr, testAddr, token, uDenom)
+	msg.GuardDenom = denom
+	msg.GuardPrice = &price
+	assert.NilError(t, msg.ValidateBasic())
+
+	msg.GuardPrice = nil
+	assert.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidGuardPrice)
+
+	msg.GuardPrice = &zero
+	assert.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidGuardPrice)
+
+	msg.GuardDenom = uDenom
+	msg.GuardPrice = &price
+	assert.ErrorIs(t, msg.ValidateBasic(), types.ErrUToken)
+
+	msg.GuardDenom = ""
+	assert.ErrorContains(t, msg.ValidateBasic(), "invalid denom")
+}